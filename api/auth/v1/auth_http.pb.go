@@ -19,15 +19,137 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthServiceBeginPasskeyLogin = "/auth.v1.AuthService/BeginPasskeyLogin"
+const OperationAuthServiceBeginPasskeyRegistration = "/auth.v1.AuthService/BeginPasskeyRegistration"
+const OperationAuthServiceChangePassword = "/auth.v1.AuthService/ChangePassword"
+const OperationAuthServiceCompleteFederatedLogin = "/auth.v1.AuthService/CompleteFederatedLogin"
+const OperationAuthServiceConfirmMFA = "/auth.v1.AuthService/ConfirmMFA"
+const OperationAuthServiceConsumeMagicLink = "/auth.v1.AuthService/ConsumeMagicLink"
+const OperationAuthServiceCreateAPIKey = "/auth.v1.AuthService/CreateAPIKey"
+const OperationAuthServiceDeletePasskey = "/auth.v1.AuthService/DeletePasskey"
+const OperationAuthServiceDisableMFA = "/auth.v1.AuthService/DisableMFA"
+const OperationAuthServiceEnrollMFA = "/auth.v1.AuthService/EnrollMFA"
+const OperationAuthServiceFinishPasskeyLogin = "/auth.v1.AuthService/FinishPasskeyLogin"
+const OperationAuthServiceFinishPasskeyRegistration = "/auth.v1.AuthService/FinishPasskeyRegistration"
+const OperationAuthServiceImpersonate = "/auth.v1.AuthService/Impersonate"
+const OperationAuthServiceIntrospect = "/auth.v1.AuthService/Introspect"
+const OperationAuthServiceListAPIKeys = "/auth.v1.AuthService/ListAPIKeys"
+const OperationAuthServiceListIdentityProviders = "/auth.v1.AuthService/ListIdentityProviders"
+const OperationAuthServiceListMySessions = "/auth.v1.AuthService/ListMySessions"
+const OperationAuthServiceListPasskeys = "/auth.v1.AuthService/ListPasskeys"
+const OperationAuthServiceListUserSessions = "/auth.v1.AuthService/ListUserSessions"
 const OperationAuthServiceLogin = "/auth.v1.AuthService/Login"
+const OperationAuthServiceLogout = "/auth.v1.AuthService/Logout"
+const OperationAuthServiceLogoutAll = "/auth.v1.AuthService/LogoutAll"
+const OperationAuthServiceRefreshToken = "/auth.v1.AuthService/RefreshToken"
+const OperationAuthServiceRequestMagicLink = "/auth.v1.AuthService/RequestMagicLink"
+const OperationAuthServiceRequestPasswordReset = "/auth.v1.AuthService/RequestPasswordReset"
+const OperationAuthServiceResendVerification = "/auth.v1.AuthService/ResendVerification"
+const OperationAuthServiceResetPassword = "/auth.v1.AuthService/ResetPassword"
+const OperationAuthServiceRevokeAPIKey = "/auth.v1.AuthService/RevokeAPIKey"
+const OperationAuthServiceRevokeMySession = "/auth.v1.AuthService/RevokeMySession"
+const OperationAuthServiceRevokeUserSession = "/auth.v1.AuthService/RevokeUserSession"
+const OperationAuthServiceSwitchTenant = "/auth.v1.AuthService/SwitchTenant"
+const OperationAuthServiceUserInfo = "/auth.v1.AuthService/UserInfo"
+const OperationAuthServiceVerifyEmail = "/auth.v1.AuthService/VerifyEmail"
+const OperationAuthServiceVerifyMFA = "/auth.v1.AuthService/VerifyMFA"
 
 type AuthServiceHTTPServer interface {
+	// BeginPasskeyLogin BeginPasskeyLogin returns the options to pass to
+	// navigator.credentials.get().
+	BeginPasskeyLogin(context.Context, *BeginPasskeyLoginRequest) (*BeginPasskeyReply, error)
+	// BeginPasskeyRegistration BeginPasskeyRegistration returns the options to pass to
+	// navigator.credentials.create().
+	BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationRequest) (*BeginPasskeyReply, error)
+	// ChangePassword ChangePassword sets a new password for the caller and signs them out of
+	// every other session. The caller's OAuth grants are revoked, and their API
+	// keys if the server is configured to.
+	// Wrong current passwords count towards the login throttle.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	// CompleteFederatedLogin CompleteFederatedLogin exchanges the code the web app receives after a
+	// login through /auth/oidc/{provider}/begin.
+	CompleteFederatedLogin(context.Context, *CompleteFederatedLoginRequest) (*LoginReply, error)
+	ConfirmMFA(context.Context, *ConfirmMFARequest) (*ConfirmMFAReply, error)
+	// ConsumeMagicLink ConsumeMagicLink signs in with the token of a magic link.
+	ConsumeMagicLink(context.Context, *ConsumeMagicLinkRequest) (*LoginReply, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error)
+	DeletePasskey(context.Context, *DeletePasskeyRequest) (*DeletePasskeyReply, error)
+	DisableMFA(context.Context, *DisableMFARequest) (*DisableMFAReply, error)
+	EnrollMFA(context.Context, *EnrollMFARequest) (*EnrollMFAReply, error)
+	FinishPasskeyLogin(context.Context, *FinishPasskeyLoginRequest) (*LoginReply, error)
+	FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationRequest) (*FinishPasskeyRegistrationReply, error)
+	// Impersonate Impersonate issues a short-lived access token for acting as another
+	// user. The token names the caller in its act claim and cannot be
+	// refreshed.
+	Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateReply, error)
+	// Introspect Introspect reports whether a token is active, in the style of RFC 7662.
+	Introspect(context.Context, *IntrospectRequest) (*IntrospectReply, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysReply, error)
+	ListIdentityProviders(context.Context, *ListIdentityProvidersRequest) (*ListIdentityProvidersReply, error)
+	ListMySessions(context.Context, *ListMySessionsRequest) (*ListSessionsReply, error)
+	ListPasskeys(context.Context, *ListPasskeysRequest) (*ListPasskeysReply, error)
+	ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListSessionsReply, error)
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutAllReply, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
+	// RequestMagicLink RequestMagicLink emails a sign-in link if the email belongs to a user.
+	RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*RequestMagicLinkReply, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetReply, error)
+	ResendVerification(context.Context, *ResendVerificationRequest) (*ResendVerificationReply, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyReply, error)
+	// RevokeMySession RevokeMySession signs one of the caller's sessions out, for example on
+	// a lost device.
+	RevokeMySession(context.Context, *RevokeMySessionRequest) (*RevokeSessionReply, error)
+	RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*RevokeSessionReply, error)
+	// SwitchTenant SwitchTenant issues a new token pair for the caller's session, bound to
+	// the tenant. Tenant-scoped methods called with it are checked in that
+	// tenant, and refreshing it keeps the tenant. An empty tenant issues
+	// unbound tokens.
+	SwitchTenant(context.Context, *SwitchTenantRequest) (*SwitchTenantReply, error)
+	// UserInfo UserInfo returns the profile of the bearer token's subject.
+	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailReply, error)
+	VerifyMFA(context.Context, *VerifyMFARequest) (*VerifyMFAReply, error)
 }
 
 func RegisterAuthServiceHTTPServer(s *http.Server, srv AuthServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/api/v1/auth/login", _AuthService_Login0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/refresh", _AuthService_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/tenant", _AuthService_SwitchTenant0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/logout", _AuthService_Logout0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/logout-all", _AuthService_LogoutAll0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/sessions", _AuthService_ListMySessions0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/auth/sessions/{session_id}", _AuthService_RevokeMySession0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/users/{user_id}/sessions", _AuthService_ListUserSessions0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/auth/users/{user_id}/sessions/{session_id}", _AuthService_RevokeUserSession0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/mfa/verify", _AuthService_VerifyMFA0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/mfa/enroll", _AuthService_EnrollMFA0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/mfa/confirm", _AuthService_ConfirmMFA0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/mfa/disable", _AuthService_DisableMFA0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/password/forgot", _AuthService_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/password/reset", _AuthService_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/magic-link", _AuthService_RequestMagicLink0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/magic-link/consume", _AuthService_ConsumeMagicLink0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/password/change", _AuthService_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/email/verify", _AuthService_VerifyEmail0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/email/resend", _AuthService_ResendVerification0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/api-keys", _AuthService_CreateAPIKey0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/api-keys", _AuthService_ListAPIKeys0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/auth/api-keys/{id}", _AuthService_RevokeAPIKey0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/identity-providers", _AuthService_ListIdentityProviders0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/federated/complete", _AuthService_CompleteFederatedLogin0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/passkeys/register/begin", _AuthService_BeginPasskeyRegistration0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/passkeys/register/finish", _AuthService_FinishPasskeyRegistration0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/passkeys", _AuthService_ListPasskeys0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/auth/passkeys/{id}", _AuthService_DeletePasskey0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/passkeys/login/begin", _AuthService_BeginPasskeyLogin0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/passkeys/login/finish", _AuthService_FinishPasskeyLogin0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/impersonate", _AuthService_Impersonate0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/introspect", _AuthService_Introspect0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/userinfo", _AuthService_UserInfo0_HTTP_Handler(srv))
 }
 
 func _AuthService_Login0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
@@ -52,16 +174,1045 @@ func _AuthService_Login0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.C
 	}
 }
 
+func _AuthService_RefreshToken0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_SwitchTenant0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SwitchTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceSwitchTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SwitchTenant(ctx, req.(*SwitchTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SwitchTenantReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_Logout0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LogoutAll0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutAllRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLogoutAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAll(ctx, req.(*LogoutAllRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutAllReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListMySessions0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMySessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListMySessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMySessions(ctx, req.(*ListMySessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RevokeMySession0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeMySessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRevokeMySession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeMySession(ctx, req.(*RevokeMySessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListUserSessions0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListUserSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RevokeUserSession0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeUserSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRevokeUserSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeUserSession(ctx, req.(*RevokeUserSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_VerifyMFA0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceVerifyMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyMFA(ctx, req.(*VerifyMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_EnrollMFA0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceEnrollMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollMFA(ctx, req.(*EnrollMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ConfirmMFA0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceConfirmMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmMFA(ctx, req.(*ConfirmMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConfirmMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_DisableMFA0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceDisableMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableMFA(ctx, req.(*DisableMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DisableMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RequestPasswordReset0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestPasswordResetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ResetPassword0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetPasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RequestMagicLink0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestMagicLinkRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRequestMagicLink)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestMagicLink(ctx, req.(*RequestMagicLinkRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestMagicLinkReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ConsumeMagicLink0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConsumeMagicLinkRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceConsumeMagicLink)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConsumeMagicLink(ctx, req.(*ConsumeMagicLinkRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ChangePassword0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangePasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_VerifyEmail0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceVerifyEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyEmailReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ResendVerification0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResendVerificationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceResendVerification)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResendVerification(ctx, req.(*ResendVerificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResendVerificationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_CreateAPIKey0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAPIKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceCreateAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListAPIKeys0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAPIKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListAPIKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAPIKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RevokeAPIKey0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAPIKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRevokeAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListIdentityProviders0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListIdentityProvidersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListIdentityProviders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListIdentityProviders(ctx, req.(*ListIdentityProvidersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListIdentityProvidersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_CompleteFederatedLogin0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompleteFederatedLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceCompleteFederatedLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompleteFederatedLogin(ctx, req.(*CompleteFederatedLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_BeginPasskeyRegistration0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BeginPasskeyRegistrationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceBeginPasskeyRegistration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BeginPasskeyRegistration(ctx, req.(*BeginPasskeyRegistrationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BeginPasskeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_FinishPasskeyRegistration0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FinishPasskeyRegistrationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceFinishPasskeyRegistration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FinishPasskeyRegistration(ctx, req.(*FinishPasskeyRegistrationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FinishPasskeyRegistrationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListPasskeys0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPasskeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListPasskeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPasskeys(ctx, req.(*ListPasskeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPasskeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_DeletePasskey0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePasskeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceDeletePasskey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeletePasskey(ctx, req.(*DeletePasskeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeletePasskeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_BeginPasskeyLogin0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BeginPasskeyLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceBeginPasskeyLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BeginPasskeyLogin(ctx, req.(*BeginPasskeyLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BeginPasskeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_FinishPasskeyLogin0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FinishPasskeyLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceFinishPasskeyLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FinishPasskeyLogin(ctx, req.(*FinishPasskeyLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_Impersonate0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImpersonateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceImpersonate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Impersonate(ctx, req.(*ImpersonateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImpersonateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_Introspect0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in IntrospectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceIntrospect)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Introspect(ctx, req.(*IntrospectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*IntrospectReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_UserInfo0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UserInfoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceUserInfo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UserInfo(ctx, req.(*UserInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserInfoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthServiceHTTPClient interface {
+	// BeginPasskeyLogin BeginPasskeyLogin returns the options to pass to
+	// navigator.credentials.get().
+	BeginPasskeyLogin(ctx context.Context, req *BeginPasskeyLoginRequest, opts ...http.CallOption) (rsp *BeginPasskeyReply, err error)
+	// BeginPasskeyRegistration BeginPasskeyRegistration returns the options to pass to
+	// navigator.credentials.create().
+	BeginPasskeyRegistration(ctx context.Context, req *BeginPasskeyRegistrationRequest, opts ...http.CallOption) (rsp *BeginPasskeyReply, err error)
+	// ChangePassword ChangePassword sets a new password for the caller and signs them out of
+	// every other session. The caller's OAuth grants are revoked, and their API
+	// keys if the server is configured to.
+	// Wrong current passwords count towards the login throttle.
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *ChangePasswordReply, err error)
+	// CompleteFederatedLogin CompleteFederatedLogin exchanges the code the web app receives after a
+	// login through /auth/oidc/{provider}/begin.
+	CompleteFederatedLogin(ctx context.Context, req *CompleteFederatedLoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	ConfirmMFA(ctx context.Context, req *ConfirmMFARequest, opts ...http.CallOption) (rsp *ConfirmMFAReply, err error)
+	// ConsumeMagicLink ConsumeMagicLink signs in with the token of a magic link.
+	ConsumeMagicLink(ctx context.Context, req *ConsumeMagicLinkRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest, opts ...http.CallOption) (rsp *CreateAPIKeyReply, err error)
+	DeletePasskey(ctx context.Context, req *DeletePasskeyRequest, opts ...http.CallOption) (rsp *DeletePasskeyReply, err error)
+	DisableMFA(ctx context.Context, req *DisableMFARequest, opts ...http.CallOption) (rsp *DisableMFAReply, err error)
+	EnrollMFA(ctx context.Context, req *EnrollMFARequest, opts ...http.CallOption) (rsp *EnrollMFAReply, err error)
+	FinishPasskeyLogin(ctx context.Context, req *FinishPasskeyLoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	FinishPasskeyRegistration(ctx context.Context, req *FinishPasskeyRegistrationRequest, opts ...http.CallOption) (rsp *FinishPasskeyRegistrationReply, err error)
+	// Impersonate Impersonate issues a short-lived access token for acting as another
+	// user. The token names the caller in its act claim and cannot be
+	// refreshed.
+	Impersonate(ctx context.Context, req *ImpersonateRequest, opts ...http.CallOption) (rsp *ImpersonateReply, err error)
+	// Introspect Introspect reports whether a token is active, in the style of RFC 7662.
+	Introspect(ctx context.Context, req *IntrospectRequest, opts ...http.CallOption) (rsp *IntrospectReply, err error)
+	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest, opts ...http.CallOption) (rsp *ListAPIKeysReply, err error)
+	ListIdentityProviders(ctx context.Context, req *ListIdentityProvidersRequest, opts ...http.CallOption) (rsp *ListIdentityProvidersReply, err error)
+	ListMySessions(ctx context.Context, req *ListMySessionsRequest, opts ...http.CallOption) (rsp *ListSessionsReply, err error)
+	ListPasskeys(ctx context.Context, req *ListPasskeysRequest, opts ...http.CallOption) (rsp *ListPasskeysReply, err error)
+	ListUserSessions(ctx context.Context, req *ListUserSessionsRequest, opts ...http.CallOption) (rsp *ListSessionsReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	LogoutAll(ctx context.Context, req *LogoutAllRequest, opts ...http.CallOption) (rsp *LogoutAllReply, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
+	// RequestMagicLink RequestMagicLink emails a sign-in link if the email belongs to a user.
+	RequestMagicLink(ctx context.Context, req *RequestMagicLinkRequest, opts ...http.CallOption) (rsp *RequestMagicLinkReply, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *RequestPasswordResetReply, err error)
+	ResendVerification(ctx context.Context, req *ResendVerificationRequest, opts ...http.CallOption) (rsp *ResendVerificationReply, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordReply, err error)
+	RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest, opts ...http.CallOption) (rsp *RevokeAPIKeyReply, err error)
+	// RevokeMySession RevokeMySession signs one of the caller's sessions out, for example on
+	// a lost device.
+	RevokeMySession(ctx context.Context, req *RevokeMySessionRequest, opts ...http.CallOption) (rsp *RevokeSessionReply, err error)
+	RevokeUserSession(ctx context.Context, req *RevokeUserSessionRequest, opts ...http.CallOption) (rsp *RevokeSessionReply, err error)
+	// SwitchTenant SwitchTenant issues a new token pair for the caller's session, bound to
+	// the tenant. Tenant-scoped methods called with it are checked in that
+	// tenant, and refreshing it keeps the tenant. An empty tenant issues
+	// unbound tokens.
+	SwitchTenant(ctx context.Context, req *SwitchTenantRequest, opts ...http.CallOption) (rsp *SwitchTenantReply, err error)
+	// UserInfo UserInfo returns the profile of the bearer token's subject.
+	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest, opts ...http.CallOption) (rsp *VerifyEmailReply, err error)
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest, opts ...http.CallOption) (rsp *VerifyMFAReply, err error)
+}
+
+type AuthServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewAuthServiceHTTPClient(client *http.Client) AuthServiceHTTPClient {
+	return &AuthServiceHTTPClientImpl{client}
+}
+
+// BeginPasskeyLogin BeginPasskeyLogin returns the options to pass to
+// navigator.credentials.get().
+func (c *AuthServiceHTTPClientImpl) BeginPasskeyLogin(ctx context.Context, in *BeginPasskeyLoginRequest, opts ...http.CallOption) (*BeginPasskeyReply, error) {
+	var out BeginPasskeyReply
+	pattern := "/api/v1/auth/passkeys/login/begin"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceBeginPasskeyLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BeginPasskeyRegistration BeginPasskeyRegistration returns the options to pass to
+// navigator.credentials.create().
+func (c *AuthServiceHTTPClientImpl) BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationRequest, opts ...http.CallOption) (*BeginPasskeyReply, error) {
+	var out BeginPasskeyReply
+	pattern := "/api/v1/auth/passkeys/register/begin"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceBeginPasskeyRegistration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ChangePassword ChangePassword sets a new password for the caller and signs them out of
+// every other session. The caller's OAuth grants are revoked, and their API
+// keys if the server is configured to.
+// Wrong current passwords count towards the login throttle.
+func (c *AuthServiceHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*ChangePasswordReply, error) {
+	var out ChangePasswordReply
+	pattern := "/api/v1/auth/password/change"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-type AuthServiceHTTPClientImpl struct {
-	cc *http.Client
+// CompleteFederatedLogin CompleteFederatedLogin exchanges the code the web app receives after a
+// login through /auth/oidc/{provider}/begin.
+func (c *AuthServiceHTTPClientImpl) CompleteFederatedLogin(ctx context.Context, in *CompleteFederatedLoginRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/api/v1/auth/federated/complete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceCompleteFederatedLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func NewAuthServiceHTTPClient(client *http.Client) AuthServiceHTTPClient {
-	return &AuthServiceHTTPClientImpl{client}
+func (c *AuthServiceHTTPClientImpl) ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...http.CallOption) (*ConfirmMFAReply, error) {
+	var out ConfirmMFAReply
+	pattern := "/api/v1/auth/mfa/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceConfirmMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConsumeMagicLink ConsumeMagicLink signs in with the token of a magic link.
+func (c *AuthServiceHTTPClientImpl) ConsumeMagicLink(ctx context.Context, in *ConsumeMagicLinkRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/api/v1/auth/magic-link/consume"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceConsumeMagicLink))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...http.CallOption) (*CreateAPIKeyReply, error) {
+	var out CreateAPIKeyReply
+	pattern := "/api/v1/auth/api-keys"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceCreateAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) DeletePasskey(ctx context.Context, in *DeletePasskeyRequest, opts ...http.CallOption) (*DeletePasskeyReply, error) {
+	var out DeletePasskeyReply
+	pattern := "/api/v1/auth/passkeys/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceDeletePasskey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...http.CallOption) (*DisableMFAReply, error) {
+	var out DisableMFAReply
+	pattern := "/api/v1/auth/mfa/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceDisableMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...http.CallOption) (*EnrollMFAReply, error) {
+	var out EnrollMFAReply
+	pattern := "/api/v1/auth/mfa/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceEnrollMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) FinishPasskeyLogin(ctx context.Context, in *FinishPasskeyLoginRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/api/v1/auth/passkeys/login/finish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceFinishPasskeyLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationRequest, opts ...http.CallOption) (*FinishPasskeyRegistrationReply, error) {
+	var out FinishPasskeyRegistrationReply
+	pattern := "/api/v1/auth/passkeys/register/finish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceFinishPasskeyRegistration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Impersonate Impersonate issues a short-lived access token for acting as another
+// user. The token names the caller in its act claim and cannot be
+// refreshed.
+func (c *AuthServiceHTTPClientImpl) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...http.CallOption) (*ImpersonateReply, error) {
+	var out ImpersonateReply
+	pattern := "/api/v1/auth/impersonate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceImpersonate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Introspect Introspect reports whether a token is active, in the style of RFC 7662.
+func (c *AuthServiceHTTPClientImpl) Introspect(ctx context.Context, in *IntrospectRequest, opts ...http.CallOption) (*IntrospectReply, error) {
+	var out IntrospectReply
+	pattern := "/api/v1/auth/introspect"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceIntrospect))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...http.CallOption) (*ListAPIKeysReply, error) {
+	var out ListAPIKeysReply
+	pattern := "/api/v1/auth/api-keys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListAPIKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListIdentityProviders(ctx context.Context, in *ListIdentityProvidersRequest, opts ...http.CallOption) (*ListIdentityProvidersReply, error) {
+	var out ListIdentityProvidersReply
+	pattern := "/api/v1/auth/identity-providers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListIdentityProviders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...http.CallOption) (*ListSessionsReply, error) {
+	var out ListSessionsReply
+	pattern := "/api/v1/auth/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListMySessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListPasskeys(ctx context.Context, in *ListPasskeysRequest, opts ...http.CallOption) (*ListPasskeysReply, error) {
+	var out ListPasskeysReply
+	pattern := "/api/v1/auth/passkeys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListPasskeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...http.CallOption) (*ListSessionsReply, error) {
+	var out ListSessionsReply
+	pattern := "/api/v1/auth/users/{user_id}/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListUserSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
 func (c *AuthServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginReply, error) {
@@ -76,3 +1227,193 @@ func (c *AuthServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest,
 	}
 	return &out, nil
 }
+
+func (c *AuthServiceHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/api/v1/auth/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...http.CallOption) (*LogoutAllReply, error) {
+	var out LogoutAllReply
+	pattern := "/api/v1/auth/logout-all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLogoutAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenReply, error) {
+	var out RefreshTokenReply
+	pattern := "/api/v1/auth/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestMagicLink RequestMagicLink emails a sign-in link if the email belongs to a user.
+func (c *AuthServiceHTTPClientImpl) RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...http.CallOption) (*RequestMagicLinkReply, error) {
+	var out RequestMagicLinkReply
+	pattern := "/api/v1/auth/magic-link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRequestMagicLink))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*RequestPasswordResetReply, error) {
+	var out RequestPasswordResetReply
+	pattern := "/api/v1/auth/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...http.CallOption) (*ResendVerificationReply, error) {
+	var out ResendVerificationReply
+	pattern := "/api/v1/auth/email/resend"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceResendVerification))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordReply, error) {
+	var out ResetPasswordReply
+	pattern := "/api/v1/auth/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...http.CallOption) (*RevokeAPIKeyReply, error) {
+	var out RevokeAPIKeyReply
+	pattern := "/api/v1/auth/api-keys/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceRevokeAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeMySession RevokeMySession signs one of the caller's sessions out, for example on
+// a lost device.
+func (c *AuthServiceHTTPClientImpl) RevokeMySession(ctx context.Context, in *RevokeMySessionRequest, opts ...http.CallOption) (*RevokeSessionReply, error) {
+	var out RevokeSessionReply
+	pattern := "/api/v1/auth/sessions/{session_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceRevokeMySession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) RevokeUserSession(ctx context.Context, in *RevokeUserSessionRequest, opts ...http.CallOption) (*RevokeSessionReply, error) {
+	var out RevokeSessionReply
+	pattern := "/api/v1/auth/users/{user_id}/sessions/{session_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceRevokeUserSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SwitchTenant SwitchTenant issues a new token pair for the caller's session, bound to
+// the tenant. Tenant-scoped methods called with it are checked in that
+// tenant, and refreshing it keeps the tenant. An empty tenant issues
+// unbound tokens.
+func (c *AuthServiceHTTPClientImpl) SwitchTenant(ctx context.Context, in *SwitchTenantRequest, opts ...http.CallOption) (*SwitchTenantReply, error) {
+	var out SwitchTenantReply
+	pattern := "/api/v1/auth/tenant"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceSwitchTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UserInfo UserInfo returns the profile of the bearer token's subject.
+func (c *AuthServiceHTTPClientImpl) UserInfo(ctx context.Context, in *UserInfoRequest, opts ...http.CallOption) (*UserInfoReply, error) {
+	var out UserInfoReply
+	pattern := "/api/v1/auth/userinfo"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceUserInfo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...http.CallOption) (*VerifyEmailReply, error) {
+	var out VerifyEmailReply
+	pattern := "/api/v1/auth/email/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceVerifyEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...http.CallOption) (*VerifyMFAReply, error) {
+	var out VerifyMFAReply
+	pattern := "/api/v1/auth/mfa/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceVerifyMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,10 +25,13 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// tenant is the ID of the tenant a grant applies in. Grants without a
+// tenant are global and apply in every tenant.
 type GrantRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Tenant        string                 `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,10 +80,18 @@ func (x *GrantRoleRequest) GetRole() string {
 	return ""
 }
 
+func (x *GrantRoleRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
 type RevokeRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Tenant        string                 `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -128,11 +140,19 @@ func (x *RevokeRoleRequest) GetRole() string {
 	return ""
 }
 
+func (x *RevokeRoleRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
 type GrantPermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"` // user_id hoặc role
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"` // only role
 	Object        string                 `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`   // resource
 	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`   // action
+	Tenant        string                 `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -188,32 +208,975 @@ func (x *GrantPermissionRequest) GetAction() string {
 	return ""
 }
 
-var File_authz_v1_authz_proto protoreflect.FileDescriptor
+func (x *GrantPermissionRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
 
-const file_authz_v1_authz_proto_rawDesc = "" +
-	"\n" +
-	"\x14authz/v1/authz.proto\x12\bauthz.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1bbuf/validate/validate.proto\x1a\x19authz/v1/permission.proto\"I\n" +
-	"\x10GrantRoleRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1b\n" +
-	"\x04role\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04role\"J\n" +
-	"\x11RevokeRoleRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1b\n" +
-	"\x04role\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04role\"z\n" +
-	"\x16GrantPermissionRequest\x12 \n" +
-	"\asubject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\asubject\x12\x1e\n" +
-	"\x06object\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06object\x12\x1e\n" +
-	"\x06action\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06action2\x9d\x03\n" +
-	"\fAuthzService\x12w\n" +
-	"\tGrantRole\x12\x1a.authz.v1.GrantRoleRequest\x1a\x16.google.protobuf.Empty\"6\x8a\xb5\x18\x14\n" +
-	"\x04role\x12\x05grant\x1a\x05admin\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/authz/roles\x12\x81\x01\n" +
-	"\n" +
-	"RevokeRole\x12\x1b.authz.v1.RevokeRoleRequest\x1a\x16.google.protobuf.Empty\">\x8a\xb5\x18\x15\n" +
-	"\x04role\x12\x06revoke\x1a\x05admin\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/authz/roles/revoke\x12\x8f\x01\n" +
-	"\x0fGrantPermission\x12 .authz.v1.GrantPermissionRequest\x1a\x16.google.protobuf.Empty\"B\x8a\xb5\x18\x1a\n" +
+type RevokePermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Object        string                 `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Tenant        string                 `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePermissionRequest) Reset() {
+	*x = RevokePermissionRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePermissionRequest) ProtoMessage() {}
+
+func (x *RevokePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePermissionRequest.ProtoReflect.Descriptor instead.
+func (*RevokePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RevokePermissionRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RevokePermissionRequest) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *RevokePermissionRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RevokePermissionRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// Lists are sorted and paginated. Pass next_page_token as page_token to get
+// the following page; it is empty on the last one. page_size defaults to 50.
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        string                 `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRolesRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRolesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRolesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesReply) Reset() {
+	*x = ListRolesReply{}
+	mi := &file_authz_v1_authz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesReply) ProtoMessage() {}
+
+func (x *ListRolesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesReply.ProtoReflect.Descriptor instead.
+func (*ListRolesReply) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRolesReply) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListRolesReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetRolesForUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tenant        string                 `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRolesForUserRequest) Reset() {
+	*x = GetRolesForUserRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRolesForUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesForUserRequest) ProtoMessage() {}
+
+func (x *GetRolesForUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRolesForUserRequest.ProtoReflect.Descriptor instead.
+func (*GetRolesForUserRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRolesForUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRolesForUserRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetRolesForUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRolesForUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetUsersForRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Tenant        string                 `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersForRoleRequest) Reset() {
+	*x = GetUsersForRoleRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersForRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersForRoleRequest) ProtoMessage() {}
+
+func (x *GetUsersForRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersForRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersForRoleRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUsersForRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetUsersForRoleRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetUsersForRoleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUsersForRoleRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetUsersForRoleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersForRoleReply) Reset() {
+	*x = GetUsersForRoleReply{}
+	mi := &file_authz_v1_authz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersForRoleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersForRoleReply) ProtoMessage() {}
+
+func (x *GetUsersForRoleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersForRoleReply.ProtoReflect.Descriptor instead.
+func (*GetUsersForRoleReply) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUsersForRoleReply) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *GetUsersForRoleReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Permission struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Subject string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Object  string                 `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Action  string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Empty for global permissions, which apply in every tenant.
+	Tenant        string `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_authz_v1_authz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Permission) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Permission) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *Permission) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Permission) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type ListPermissionsForRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Tenant        string                 `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionsForRoleRequest) Reset() {
+	*x = ListPermissionsForRoleRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionsForRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionsForRoleRequest) ProtoMessage() {}
+
+func (x *ListPermissionsForRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionsForRoleRequest.ProtoReflect.Descriptor instead.
+func (*ListPermissionsForRoleRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPermissionsForRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListPermissionsForRoleRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ListPermissionsForRoleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPermissionsForRoleRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetImplicitPermissionsForUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tenant        string                 `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImplicitPermissionsForUserRequest) Reset() {
+	*x = GetImplicitPermissionsForUserRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImplicitPermissionsForUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImplicitPermissionsForUserRequest) ProtoMessage() {}
+
+func (x *GetImplicitPermissionsForUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImplicitPermissionsForUserRequest.ProtoReflect.Descriptor instead.
+func (*GetImplicitPermissionsForUserRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetImplicitPermissionsForUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetImplicitPermissionsForUserRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetImplicitPermissionsForUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetImplicitPermissionsForUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPermissionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionsReply) Reset() {
+	*x = ListPermissionsReply{}
+	mi := &file_authz_v1_authz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionsReply) ProtoMessage() {}
+
+func (x *ListPermissionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionsReply.ProtoReflect.Descriptor instead.
+func (*ListPermissionsReply) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPermissionsReply) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ListPermissionsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Tenant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_authz_v1_authz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Tenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tenant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{15}
+}
+
+type ListTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	mi := &file_authz_v1_authz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{16}
+}
+
+type ListTenantsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Tenant              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsReply) Reset() {
+	*x = ListTenantsReply{}
+	mi := &file_authz_v1_authz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsReply) ProtoMessage() {}
+
+func (x *ListTenantsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_authz_v1_authz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsReply.ProtoReflect.Descriptor instead.
+func (*ListTenantsReply) Descriptor() ([]byte, []int) {
+	return file_authz_v1_authz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTenantsReply) GetData() []*Tenant {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_authz_v1_authz_proto protoreflect.FileDescriptor
+
+const file_authz_v1_authz_proto_rawDesc = "" +
+	"\n" +
+	"\x14authz/v1/authz.proto\x12\bauthz.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x19authz/v1/permission.proto\"a\n" +
+	"\x10GrantRoleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1b\n" +
+	"\x04role\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04role\x12\x16\n" +
+	"\x06tenant\x18\x03 \x01(\tR\x06tenant\"b\n" +
+	"\x11RevokeRoleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1b\n" +
+	"\x04role\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04role\x12\x16\n" +
+	"\x06tenant\x18\x03 \x01(\tR\x06tenant\"\x92\x01\n" +
+	"\x16GrantPermissionRequest\x12 \n" +
+	"\asubject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\asubject\x12\x1e\n" +
+	"\x06object\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06object\x12\x1e\n" +
+	"\x06action\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06action\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\"\x93\x01\n" +
+	"\x17RevokePermissionRequest\x12 \n" +
+	"\asubject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\asubject\x12\x1e\n" +
+	"\x06object\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06object\x12\x1e\n" +
+	"\x06action\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06action\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\"r\n" +
+	"\x10ListRolesRequest\x12\x16\n" +
+	"\x06tenant\x18\x01 \x01(\tR\x06tenant\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"N\n" +
+	"\x0eListRolesReply\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9b\x01\n" +
+	"\x16GetRolesForUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x16\n" +
+	"\x06tenant\x18\x02 \x01(\tR\x06tenant\x12'\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x95\x01\n" +
+	"\x16GetUsersForRoleRequest\x12\x1b\n" +
+	"\x04role\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04role\x12\x16\n" +
+	"\x06tenant\x18\x02 \x01(\tR\x06tenant\x12'\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"Y\n" +
+	"\x14GetUsersForRoleReply\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"n\n" +
+	"\n" +
+	"Permission\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x16\n" +
+	"\x06object\x18\x02 \x01(\tR\x06object\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\"\x9c\x01\n" +
+	"\x1dListPermissionsForRoleRequest\x12\x1b\n" +
+	"\x04role\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04role\x12\x16\n" +
+	"\x06tenant\x18\x02 \x01(\tR\x06tenant\x12'\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xa9\x01\n" +
+	"$GetImplicitPermissionsForUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x16\n" +
+	"\x06tenant\x18\x02 \x01(\tR\x06tenant\x12'\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"v\n" +
+	"\x14ListPermissionsReply\x126\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x14.authz.v1.PermissionR\vpermissions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"g\n" +
+	"\x06Tenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"4\n" +
+	"\x13CreateTenantRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\"\x12\n" +
+	"\x10GetTenantRequest\"\x14\n" +
+	"\x12ListTenantsRequest\"8\n" +
+	"\x10ListTenantsReply\x12$\n" +
+	"\x04data\x18\x01 \x03(\v2\x10.authz.v1.TenantR\x04data2\xc1\r\n" +
+	"\fAuthzService\x12w\n" +
+	"\tGrantRole\x12\x1a.authz.v1.GrantRoleRequest\x1a\x16.google.protobuf.Empty\"6\x8a\xb5\x18\x14\n" +
+	"\x04role\x12\x05grant\x1a\x05admin\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/authz/roles\x12\x81\x01\n" +
+	"\n" +
+	"RevokeRole\x12\x1b.authz.v1.RevokeRoleRequest\x1a\x16.google.protobuf.Empty\">\x8a\xb5\x18\x15\n" +
+	"\x04role\x12\x06revoke\x1a\x05admin\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/authz/roles/revoke\x12\x8f\x01\n" +
+	"\x0fGrantPermission\x12 .authz.v1.GrantPermissionRequest\x1a\x16.google.protobuf.Empty\"B\x8a\xb5\x18\x1a\n" +
+	"\n" +
+	"permission\x12\x05grant\x1a\x05admin\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/authz/permissions\x12\x99\x01\n" +
+	"\x10RevokePermission\x12!.authz.v1.RevokePermissionRequest\x1a\x16.google.protobuf.Empty\"J\x8a\xb5\x18\x1b\n" +
+	"\n" +
+	"permission\x12\x06revoke\x1a\x05admin\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/authz/permissions/revoke\x12u\n" +
+	"\tListRoles\x12\x1a.authz.v1.ListRolesRequest\x1a\x18.authz.v1.ListRolesReply\"2\x8a\xb5\x18\x13\n" +
+	"\x04role\x12\x04read\x1a\x05admin\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/authz/roles\x12\x91\x01\n" +
+	"\x0fGetRolesForUser\x12 .authz.v1.GetRolesForUserRequest\x1a\x18.authz.v1.ListRolesReply\"B\x8a\xb5\x18\x13\n" +
+	"\x04role\x12\x04read\x1a\x05admin\x82\xd3\xe4\x93\x02%\x12#/api/v1/authz/users/{user_id}/roles\x12\x94\x01\n" +
+	"\x0fGetUsersForRole\x12 .authz.v1.GetUsersForRoleRequest\x1a\x1e.authz.v1.GetUsersForRoleReply\"?\x8a\xb5\x18\x13\n" +
+	"\x04role\x12\x04read\x1a\x05admin\x82\xd3\xe4\x93\x02\"\x12 /api/v1/authz/roles/{role}/users\x12\xae\x01\n" +
+	"\x16ListPermissionsForRole\x12'.authz.v1.ListPermissionsForRoleRequest\x1a\x1e.authz.v1.ListPermissionsReply\"K\x8a\xb5\x18\x19\n" +
+	"\n" +
+	"permission\x12\x04read\x1a\x05admin\x82\xd3\xe4\x93\x02(\x12&/api/v1/authz/roles/{role}/permissions\x12\xbf\x01\n" +
+	"\x1dGetImplicitPermissionsForUser\x12..authz.v1.GetImplicitPermissionsForUserRequest\x1a\x1e.authz.v1.ListPermissionsReply\"N\x8a\xb5\x18\x19\n" +
 	"\n" +
-	"permission\x12\x05grant\x1a\x05admin\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/authz/permissionsB\x89\x01\n" +
+	"permission\x12\x04read\x1a\x05admin\x82\xd3\xe4\x93\x02+\x12)/api/v1/authz/users/{user_id}/permissions\x12|\n" +
+	"\fCreateTenant\x12\x1d.authz.v1.CreateTenantRequest\x1a\x10.authz.v1.Tenant\";\x8a\xb5\x18\x17\n" +
+	"\x06tenant\x12\x06create\x1a\x05admin\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/authz/tenants\x12r\n" +
+	"\tGetTenant\x12\x1a.authz.v1.GetTenantRequest\x1a\x10.authz.v1.Tenant\"7\x8a\xb5\x18\x17\n" +
+	"\x06tenant\x12\x04read\x1a\x05admin(\x01\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/authz/tenant\x12\x7f\n" +
+	"\vListTenants\x12\x1c.authz.v1.ListTenantsRequest\x1a\x1a.authz.v1.ListTenantsReply\"6\x8a\xb5\x18\x15\n" +
+	"\x06tenant\x12\x04read\x1a\x05admin\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/authz/tenantsB\x87\x01\n" +
 	"\fcom.authz.v1B\n" +
-	"AuthzProtoP\x01Z,github.com/tencat-dev/go-base/api/authz/v1\xa2\x02\x03AXX\xaa\x02\bAuthz.V1\xca\x02\bAuthz\\V1\xe2\x02\x14Authz\\V1\\GPBMetadata\xea\x02\tAuthz::V1b\x06proto3"
+	"AuthzProtoP\x01Z*github.com/tencat-dev/go-base/api/authz/v1\xa2\x02\x03AXX\xaa\x02\bAuthz.V1\xca\x02\bAuthz\\V1\xe2\x02\x14Authz\\V1\\GPBMetadata\xea\x02\tAuthz::V1b\x06proto3"
 
 var (
 	file_authz_v1_authz_proto_rawDescOnce sync.Once
@@ -227,25 +1190,62 @@ func file_authz_v1_authz_proto_rawDescGZIP() []byte {
 	return file_authz_v1_authz_proto_rawDescData
 }
 
-var file_authz_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_authz_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_authz_v1_authz_proto_goTypes = []any{
-	(*GrantRoleRequest)(nil),       // 0: authz.v1.GrantRoleRequest
-	(*RevokeRoleRequest)(nil),      // 1: authz.v1.RevokeRoleRequest
-	(*GrantPermissionRequest)(nil), // 2: authz.v1.GrantPermissionRequest
-	(*emptypb.Empty)(nil),          // 3: google.protobuf.Empty
+	(*GrantRoleRequest)(nil),                     // 0: authz.v1.GrantRoleRequest
+	(*RevokeRoleRequest)(nil),                    // 1: authz.v1.RevokeRoleRequest
+	(*GrantPermissionRequest)(nil),               // 2: authz.v1.GrantPermissionRequest
+	(*RevokePermissionRequest)(nil),              // 3: authz.v1.RevokePermissionRequest
+	(*ListRolesRequest)(nil),                     // 4: authz.v1.ListRolesRequest
+	(*ListRolesReply)(nil),                       // 5: authz.v1.ListRolesReply
+	(*GetRolesForUserRequest)(nil),               // 6: authz.v1.GetRolesForUserRequest
+	(*GetUsersForRoleRequest)(nil),               // 7: authz.v1.GetUsersForRoleRequest
+	(*GetUsersForRoleReply)(nil),                 // 8: authz.v1.GetUsersForRoleReply
+	(*Permission)(nil),                           // 9: authz.v1.Permission
+	(*ListPermissionsForRoleRequest)(nil),        // 10: authz.v1.ListPermissionsForRoleRequest
+	(*GetImplicitPermissionsForUserRequest)(nil), // 11: authz.v1.GetImplicitPermissionsForUserRequest
+	(*ListPermissionsReply)(nil),                 // 12: authz.v1.ListPermissionsReply
+	(*Tenant)(nil),                               // 13: authz.v1.Tenant
+	(*CreateTenantRequest)(nil),                  // 14: authz.v1.CreateTenantRequest
+	(*GetTenantRequest)(nil),                     // 15: authz.v1.GetTenantRequest
+	(*ListTenantsRequest)(nil),                   // 16: authz.v1.ListTenantsRequest
+	(*ListTenantsReply)(nil),                     // 17: authz.v1.ListTenantsReply
+	(*timestamppb.Timestamp)(nil),                // 18: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                        // 19: google.protobuf.Empty
 }
 var file_authz_v1_authz_proto_depIdxs = []int32{
-	0, // 0: authz.v1.AuthzService.GrantRole:input_type -> authz.v1.GrantRoleRequest
-	1, // 1: authz.v1.AuthzService.RevokeRole:input_type -> authz.v1.RevokeRoleRequest
-	2, // 2: authz.v1.AuthzService.GrantPermission:input_type -> authz.v1.GrantPermissionRequest
-	3, // 3: authz.v1.AuthzService.GrantRole:output_type -> google.protobuf.Empty
-	3, // 4: authz.v1.AuthzService.RevokeRole:output_type -> google.protobuf.Empty
-	3, // 5: authz.v1.AuthzService.GrantPermission:output_type -> google.protobuf.Empty
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	9,  // 0: authz.v1.ListPermissionsReply.permissions:type_name -> authz.v1.Permission
+	18, // 1: authz.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	13, // 2: authz.v1.ListTenantsReply.data:type_name -> authz.v1.Tenant
+	0,  // 3: authz.v1.AuthzService.GrantRole:input_type -> authz.v1.GrantRoleRequest
+	1,  // 4: authz.v1.AuthzService.RevokeRole:input_type -> authz.v1.RevokeRoleRequest
+	2,  // 5: authz.v1.AuthzService.GrantPermission:input_type -> authz.v1.GrantPermissionRequest
+	3,  // 6: authz.v1.AuthzService.RevokePermission:input_type -> authz.v1.RevokePermissionRequest
+	4,  // 7: authz.v1.AuthzService.ListRoles:input_type -> authz.v1.ListRolesRequest
+	6,  // 8: authz.v1.AuthzService.GetRolesForUser:input_type -> authz.v1.GetRolesForUserRequest
+	7,  // 9: authz.v1.AuthzService.GetUsersForRole:input_type -> authz.v1.GetUsersForRoleRequest
+	10, // 10: authz.v1.AuthzService.ListPermissionsForRole:input_type -> authz.v1.ListPermissionsForRoleRequest
+	11, // 11: authz.v1.AuthzService.GetImplicitPermissionsForUser:input_type -> authz.v1.GetImplicitPermissionsForUserRequest
+	14, // 12: authz.v1.AuthzService.CreateTenant:input_type -> authz.v1.CreateTenantRequest
+	15, // 13: authz.v1.AuthzService.GetTenant:input_type -> authz.v1.GetTenantRequest
+	16, // 14: authz.v1.AuthzService.ListTenants:input_type -> authz.v1.ListTenantsRequest
+	19, // 15: authz.v1.AuthzService.GrantRole:output_type -> google.protobuf.Empty
+	19, // 16: authz.v1.AuthzService.RevokeRole:output_type -> google.protobuf.Empty
+	19, // 17: authz.v1.AuthzService.GrantPermission:output_type -> google.protobuf.Empty
+	19, // 18: authz.v1.AuthzService.RevokePermission:output_type -> google.protobuf.Empty
+	5,  // 19: authz.v1.AuthzService.ListRoles:output_type -> authz.v1.ListRolesReply
+	5,  // 20: authz.v1.AuthzService.GetRolesForUser:output_type -> authz.v1.ListRolesReply
+	8,  // 21: authz.v1.AuthzService.GetUsersForRole:output_type -> authz.v1.GetUsersForRoleReply
+	12, // 22: authz.v1.AuthzService.ListPermissionsForRole:output_type -> authz.v1.ListPermissionsReply
+	12, // 23: authz.v1.AuthzService.GetImplicitPermissionsForUser:output_type -> authz.v1.ListPermissionsReply
+	13, // 24: authz.v1.AuthzService.CreateTenant:output_type -> authz.v1.Tenant
+	13, // 25: authz.v1.AuthzService.GetTenant:output_type -> authz.v1.Tenant
+	17, // 26: authz.v1.AuthzService.ListTenants:output_type -> authz.v1.ListTenantsReply
+	15, // [15:27] is the sub-list for method output_type
+	3,  // [3:15] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_authz_v1_authz_proto_init() }
@@ -260,7 +1260,7 @@ func file_authz_v1_authz_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authz_v1_authz_proto_rawDesc), len(file_authz_v1_authz_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
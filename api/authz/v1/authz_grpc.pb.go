@@ -20,9 +20,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthzService_GrantRole_FullMethodName       = "/authz.v1.AuthzService/GrantRole"
-	AuthzService_RevokeRole_FullMethodName      = "/authz.v1.AuthzService/RevokeRole"
-	AuthzService_GrantPermission_FullMethodName = "/authz.v1.AuthzService/GrantPermission"
+	AuthzService_GrantRole_FullMethodName                     = "/authz.v1.AuthzService/GrantRole"
+	AuthzService_RevokeRole_FullMethodName                    = "/authz.v1.AuthzService/RevokeRole"
+	AuthzService_GrantPermission_FullMethodName               = "/authz.v1.AuthzService/GrantPermission"
+	AuthzService_RevokePermission_FullMethodName              = "/authz.v1.AuthzService/RevokePermission"
+	AuthzService_ListRoles_FullMethodName                     = "/authz.v1.AuthzService/ListRoles"
+	AuthzService_GetRolesForUser_FullMethodName               = "/authz.v1.AuthzService/GetRolesForUser"
+	AuthzService_GetUsersForRole_FullMethodName               = "/authz.v1.AuthzService/GetUsersForRole"
+	AuthzService_ListPermissionsForRole_FullMethodName        = "/authz.v1.AuthzService/ListPermissionsForRole"
+	AuthzService_GetImplicitPermissionsForUser_FullMethodName = "/authz.v1.AuthzService/GetImplicitPermissionsForUser"
+	AuthzService_CreateTenant_FullMethodName                  = "/authz.v1.AuthzService/CreateTenant"
+	AuthzService_GetTenant_FullMethodName                     = "/authz.v1.AuthzService/GetTenant"
+	AuthzService_ListTenants_FullMethodName                   = "/authz.v1.AuthzService/ListTenants"
 )
 
 // AuthzServiceClient is the client API for AuthzService service.
@@ -32,6 +41,18 @@ type AuthzServiceClient interface {
 	GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GrantPermission(ctx context.Context, in *GrantPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokePermission(ctx context.Context, in *RevokePermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesReply, error)
+	GetRolesForUser(ctx context.Context, in *GetRolesForUserRequest, opts ...grpc.CallOption) (*ListRolesReply, error)
+	GetUsersForRole(ctx context.Context, in *GetUsersForRoleRequest, opts ...grpc.CallOption) (*GetUsersForRoleReply, error)
+	ListPermissionsForRole(ctx context.Context, in *ListPermissionsForRoleRequest, opts ...grpc.CallOption) (*ListPermissionsReply, error)
+	// GetImplicitPermissionsForUser returns the user's own permissions and
+	// those of their roles, including inherited and global ones.
+	GetImplicitPermissionsForUser(ctx context.Context, in *GetImplicitPermissionsForUserRequest, opts ...grpc.CallOption) (*ListPermissionsReply, error)
+	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*Tenant, error)
+	// GetTenant returns the tenant of the request.
+	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*Tenant, error)
+	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsReply, error)
 }
 
 type authzServiceClient struct {
@@ -72,6 +93,96 @@ func (c *authzServiceClient) GrantPermission(ctx context.Context, in *GrantPermi
 	return out, nil
 }
 
+func (c *authzServiceClient) RevokePermission(ctx context.Context, in *RevokePermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthzService_RevokePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesReply)
+	err := c.cc.Invoke(ctx, AuthzService_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) GetRolesForUser(ctx context.Context, in *GetRolesForUserRequest, opts ...grpc.CallOption) (*ListRolesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesReply)
+	err := c.cc.Invoke(ctx, AuthzService_GetRolesForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) GetUsersForRole(ctx context.Context, in *GetUsersForRoleRequest, opts ...grpc.CallOption) (*GetUsersForRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersForRoleReply)
+	err := c.cc.Invoke(ctx, AuthzService_GetUsersForRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) ListPermissionsForRole(ctx context.Context, in *ListPermissionsForRoleRequest, opts ...grpc.CallOption) (*ListPermissionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPermissionsReply)
+	err := c.cc.Invoke(ctx, AuthzService_ListPermissionsForRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) GetImplicitPermissionsForUser(ctx context.Context, in *GetImplicitPermissionsForUserRequest, opts ...grpc.CallOption) (*ListPermissionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPermissionsReply)
+	err := c.cc.Invoke(ctx, AuthzService_GetImplicitPermissionsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*Tenant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tenant)
+	err := c.cc.Invoke(ctx, AuthzService_CreateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*Tenant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tenant)
+	err := c.cc.Invoke(ctx, AuthzService_GetTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantsReply)
+	err := c.cc.Invoke(ctx, AuthzService_ListTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthzServiceServer is the server API for AuthzService service.
 // All implementations must embed UnimplementedAuthzServiceServer
 // for forward compatibility.
@@ -79,6 +190,18 @@ type AuthzServiceServer interface {
 	GrantRole(context.Context, *GrantRoleRequest) (*emptypb.Empty, error)
 	RevokeRole(context.Context, *RevokeRoleRequest) (*emptypb.Empty, error)
 	GrantPermission(context.Context, *GrantPermissionRequest) (*emptypb.Empty, error)
+	RevokePermission(context.Context, *RevokePermissionRequest) (*emptypb.Empty, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesReply, error)
+	GetRolesForUser(context.Context, *GetRolesForUserRequest) (*ListRolesReply, error)
+	GetUsersForRole(context.Context, *GetUsersForRoleRequest) (*GetUsersForRoleReply, error)
+	ListPermissionsForRole(context.Context, *ListPermissionsForRoleRequest) (*ListPermissionsReply, error)
+	// GetImplicitPermissionsForUser returns the user's own permissions and
+	// those of their roles, including inherited and global ones.
+	GetImplicitPermissionsForUser(context.Context, *GetImplicitPermissionsForUserRequest) (*ListPermissionsReply, error)
+	CreateTenant(context.Context, *CreateTenantRequest) (*Tenant, error)
+	// GetTenant returns the tenant of the request.
+	GetTenant(context.Context, *GetTenantRequest) (*Tenant, error)
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsReply, error)
 	mustEmbedUnimplementedAuthzServiceServer()
 }
 
@@ -98,6 +221,33 @@ func (UnimplementedAuthzServiceServer) RevokeRole(context.Context, *RevokeRoleRe
 func (UnimplementedAuthzServiceServer) GrantPermission(context.Context, *GrantPermissionRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method GrantPermission not implemented")
 }
+func (UnimplementedAuthzServiceServer) RevokePermission(context.Context, *RevokePermissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokePermission not implemented")
+}
+func (UnimplementedAuthzServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedAuthzServiceServer) GetRolesForUser(context.Context, *GetRolesForUserRequest) (*ListRolesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRolesForUser not implemented")
+}
+func (UnimplementedAuthzServiceServer) GetUsersForRole(context.Context, *GetUsersForRoleRequest) (*GetUsersForRoleReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsersForRole not implemented")
+}
+func (UnimplementedAuthzServiceServer) ListPermissionsForRole(context.Context, *ListPermissionsForRoleRequest) (*ListPermissionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPermissionsForRole not implemented")
+}
+func (UnimplementedAuthzServiceServer) GetImplicitPermissionsForUser(context.Context, *GetImplicitPermissionsForUserRequest) (*ListPermissionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetImplicitPermissionsForUser not implemented")
+}
+func (UnimplementedAuthzServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*Tenant, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedAuthzServiceServer) GetTenant(context.Context, *GetTenantRequest) (*Tenant, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedAuthzServiceServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTenants not implemented")
+}
 func (UnimplementedAuthzServiceServer) mustEmbedUnimplementedAuthzServiceServer() {}
 func (UnimplementedAuthzServiceServer) testEmbeddedByValue()                      {}
 
@@ -173,6 +323,168 @@ func _AuthzService_GrantPermission_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthzService_RevokePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).RevokePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_RevokePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).RevokePermission(ctx, req.(*RevokePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_GetRolesForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolesForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).GetRolesForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_GetRolesForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).GetRolesForUser(ctx, req.(*GetRolesForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_GetUsersForRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersForRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).GetUsersForRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_GetUsersForRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).GetUsersForRole(ctx, req.(*GetUsersForRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_ListPermissionsForRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsForRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).ListPermissionsForRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_ListPermissionsForRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).ListPermissionsForRole(ctx, req.(*ListPermissionsForRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_GetImplicitPermissionsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImplicitPermissionsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).GetImplicitPermissionsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_GetImplicitPermissionsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).GetImplicitPermissionsForUser(ctx, req.(*GetImplicitPermissionsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_GetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_ListTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).ListTenants(ctx, req.(*ListTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthzService_ServiceDesc is the grpc.ServiceDesc for AuthzService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +504,42 @@ var AuthzService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GrantPermission",
 			Handler:    _AuthzService_GrantPermission_Handler,
 		},
+		{
+			MethodName: "RevokePermission",
+			Handler:    _AuthzService_RevokePermission_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _AuthzService_ListRoles_Handler,
+		},
+		{
+			MethodName: "GetRolesForUser",
+			Handler:    _AuthzService_GetRolesForUser_Handler,
+		},
+		{
+			MethodName: "GetUsersForRole",
+			Handler:    _AuthzService_GetUsersForRole_Handler,
+		},
+		{
+			MethodName: "ListPermissionsForRole",
+			Handler:    _AuthzService_ListPermissionsForRole_Handler,
+		},
+		{
+			MethodName: "GetImplicitPermissionsForUser",
+			Handler:    _AuthzService_GetImplicitPermissionsForUser_Handler,
+		},
+		{
+			MethodName: "CreateTenant",
+			Handler:    _AuthzService_CreateTenant_Handler,
+		},
+		{
+			MethodName: "GetTenant",
+			Handler:    _AuthzService_GetTenant_Handler,
+		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _AuthzService_ListTenants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authz/v1/authz.proto",
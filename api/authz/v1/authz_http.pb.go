@@ -20,13 +20,34 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthzServiceCreateTenant = "/authz.v1.AuthzService/CreateTenant"
+const OperationAuthzServiceGetImplicitPermissionsForUser = "/authz.v1.AuthzService/GetImplicitPermissionsForUser"
+const OperationAuthzServiceGetRolesForUser = "/authz.v1.AuthzService/GetRolesForUser"
+const OperationAuthzServiceGetTenant = "/authz.v1.AuthzService/GetTenant"
+const OperationAuthzServiceGetUsersForRole = "/authz.v1.AuthzService/GetUsersForRole"
 const OperationAuthzServiceGrantPermission = "/authz.v1.AuthzService/GrantPermission"
 const OperationAuthzServiceGrantRole = "/authz.v1.AuthzService/GrantRole"
+const OperationAuthzServiceListPermissionsForRole = "/authz.v1.AuthzService/ListPermissionsForRole"
+const OperationAuthzServiceListRoles = "/authz.v1.AuthzService/ListRoles"
+const OperationAuthzServiceListTenants = "/authz.v1.AuthzService/ListTenants"
+const OperationAuthzServiceRevokePermission = "/authz.v1.AuthzService/RevokePermission"
 const OperationAuthzServiceRevokeRole = "/authz.v1.AuthzService/RevokeRole"
 
 type AuthzServiceHTTPServer interface {
+	CreateTenant(context.Context, *CreateTenantRequest) (*Tenant, error)
+	// GetImplicitPermissionsForUser GetImplicitPermissionsForUser returns the user's own permissions and
+	// those of their roles, including inherited and global ones.
+	GetImplicitPermissionsForUser(context.Context, *GetImplicitPermissionsForUserRequest) (*ListPermissionsReply, error)
+	GetRolesForUser(context.Context, *GetRolesForUserRequest) (*ListRolesReply, error)
+	// GetTenant GetTenant returns the tenant of the request.
+	GetTenant(context.Context, *GetTenantRequest) (*Tenant, error)
+	GetUsersForRole(context.Context, *GetUsersForRoleRequest) (*GetUsersForRoleReply, error)
 	GrantPermission(context.Context, *GrantPermissionRequest) (*emptypb.Empty, error)
 	GrantRole(context.Context, *GrantRoleRequest) (*emptypb.Empty, error)
+	ListPermissionsForRole(context.Context, *ListPermissionsForRoleRequest) (*ListPermissionsReply, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesReply, error)
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsReply, error)
+	RevokePermission(context.Context, *RevokePermissionRequest) (*emptypb.Empty, error)
 	RevokeRole(context.Context, *RevokeRoleRequest) (*emptypb.Empty, error)
 }
 
@@ -35,6 +56,15 @@ func RegisterAuthzServiceHTTPServer(s *http.Server, srv AuthzServiceHTTPServer)
 	r.POST("/api/v1/authz/roles", _AuthzService_GrantRole0_HTTP_Handler(srv))
 	r.POST("/api/v1/authz/roles/revoke", _AuthzService_RevokeRole0_HTTP_Handler(srv))
 	r.POST("/api/v1/authz/permissions", _AuthzService_GrantPermission0_HTTP_Handler(srv))
+	r.POST("/api/v1/authz/permissions/revoke", _AuthzService_RevokePermission0_HTTP_Handler(srv))
+	r.GET("/api/v1/authz/roles", _AuthzService_ListRoles0_HTTP_Handler(srv))
+	r.GET("/api/v1/authz/users/{user_id}/roles", _AuthzService_GetRolesForUser0_HTTP_Handler(srv))
+	r.GET("/api/v1/authz/roles/{role}/users", _AuthzService_GetUsersForRole0_HTTP_Handler(srv))
+	r.GET("/api/v1/authz/roles/{role}/permissions", _AuthzService_ListPermissionsForRole0_HTTP_Handler(srv))
+	r.GET("/api/v1/authz/users/{user_id}/permissions", _AuthzService_GetImplicitPermissionsForUser0_HTTP_Handler(srv))
+	r.POST("/api/v1/authz/tenants", _AuthzService_CreateTenant0_HTTP_Handler(srv))
+	r.GET("/api/v1/authz/tenant", _AuthzService_GetTenant0_HTTP_Handler(srv))
+	r.GET("/api/v1/authz/tenants", _AuthzService_ListTenants0_HTTP_Handler(srv))
 }
 
 func _AuthzService_GrantRole0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
@@ -103,9 +133,210 @@ func _AuthzService_GrantPermission0_HTTP_Handler(srv AuthzServiceHTTPServer) fun
 	}
 }
 
+func _AuthzService_RevokePermission0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokePermissionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceRevokePermission)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokePermission(ctx, req.(*RevokePermissionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthzService_ListRoles0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRolesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceListRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoles(ctx, req.(*ListRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRolesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthzService_GetRolesForUser0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRolesForUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceGetRolesForUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRolesForUser(ctx, req.(*GetRolesForUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRolesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthzService_GetUsersForRole0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUsersForRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceGetUsersForRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUsersForRole(ctx, req.(*GetUsersForRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUsersForRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthzService_ListPermissionsForRole0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPermissionsForRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceListPermissionsForRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPermissionsForRole(ctx, req.(*ListPermissionsForRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPermissionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthzService_GetImplicitPermissionsForUser0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetImplicitPermissionsForUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceGetImplicitPermissionsForUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetImplicitPermissionsForUser(ctx, req.(*GetImplicitPermissionsForUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPermissionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthzService_CreateTenant0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceCreateTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTenant(ctx, req.(*CreateTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Tenant)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthzService_GetTenant0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceGetTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenant(ctx, req.(*GetTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Tenant)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthzService_ListTenants0_HTTP_Handler(srv AuthzServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthzServiceListTenants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenants(ctx, req.(*ListTenantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthzServiceHTTPClient interface {
+	CreateTenant(ctx context.Context, req *CreateTenantRequest, opts ...http.CallOption) (rsp *Tenant, err error)
+	// GetImplicitPermissionsForUser GetImplicitPermissionsForUser returns the user's own permissions and
+	// those of their roles, including inherited and global ones.
+	GetImplicitPermissionsForUser(ctx context.Context, req *GetImplicitPermissionsForUserRequest, opts ...http.CallOption) (rsp *ListPermissionsReply, err error)
+	GetRolesForUser(ctx context.Context, req *GetRolesForUserRequest, opts ...http.CallOption) (rsp *ListRolesReply, err error)
+	// GetTenant GetTenant returns the tenant of the request.
+	GetTenant(ctx context.Context, req *GetTenantRequest, opts ...http.CallOption) (rsp *Tenant, err error)
+	GetUsersForRole(ctx context.Context, req *GetUsersForRoleRequest, opts ...http.CallOption) (rsp *GetUsersForRoleReply, err error)
 	GrantPermission(ctx context.Context, req *GrantPermissionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	GrantRole(ctx context.Context, req *GrantRoleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	ListPermissionsForRole(ctx context.Context, req *ListPermissionsForRoleRequest, opts ...http.CallOption) (rsp *ListPermissionsReply, err error)
+	ListRoles(ctx context.Context, req *ListRolesRequest, opts ...http.CallOption) (rsp *ListRolesReply, err error)
+	ListTenants(ctx context.Context, req *ListTenantsRequest, opts ...http.CallOption) (rsp *ListTenantsReply, err error)
+	RevokePermission(ctx context.Context, req *RevokePermissionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	RevokeRole(ctx context.Context, req *RevokeRoleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 }
 
@@ -117,6 +348,74 @@ func NewAuthzServiceHTTPClient(client *http.Client) AuthzServiceHTTPClient {
 	return &AuthzServiceHTTPClientImpl{client}
 }
 
+func (c *AuthzServiceHTTPClientImpl) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...http.CallOption) (*Tenant, error) {
+	var out Tenant
+	pattern := "/api/v1/authz/tenants"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthzServiceCreateTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImplicitPermissionsForUser GetImplicitPermissionsForUser returns the user's own permissions and
+// those of their roles, including inherited and global ones.
+func (c *AuthzServiceHTTPClientImpl) GetImplicitPermissionsForUser(ctx context.Context, in *GetImplicitPermissionsForUserRequest, opts ...http.CallOption) (*ListPermissionsReply, error) {
+	var out ListPermissionsReply
+	pattern := "/api/v1/authz/users/{user_id}/permissions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthzServiceGetImplicitPermissionsForUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthzServiceHTTPClientImpl) GetRolesForUser(ctx context.Context, in *GetRolesForUserRequest, opts ...http.CallOption) (*ListRolesReply, error) {
+	var out ListRolesReply
+	pattern := "/api/v1/authz/users/{user_id}/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthzServiceGetRolesForUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTenant GetTenant returns the tenant of the request.
+func (c *AuthzServiceHTTPClientImpl) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...http.CallOption) (*Tenant, error) {
+	var out Tenant
+	pattern := "/api/v1/authz/tenant"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthzServiceGetTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthzServiceHTTPClientImpl) GetUsersForRole(ctx context.Context, in *GetUsersForRoleRequest, opts ...http.CallOption) (*GetUsersForRoleReply, error) {
+	var out GetUsersForRoleReply
+	pattern := "/api/v1/authz/roles/{role}/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthzServiceGetUsersForRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthzServiceHTTPClientImpl) GrantPermission(ctx context.Context, in *GrantPermissionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/api/v1/authz/permissions"
@@ -143,6 +442,58 @@ func (c *AuthzServiceHTTPClientImpl) GrantRole(ctx context.Context, in *GrantRol
 	return &out, nil
 }
 
+func (c *AuthzServiceHTTPClientImpl) ListPermissionsForRole(ctx context.Context, in *ListPermissionsForRoleRequest, opts ...http.CallOption) (*ListPermissionsReply, error) {
+	var out ListPermissionsReply
+	pattern := "/api/v1/authz/roles/{role}/permissions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthzServiceListPermissionsForRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthzServiceHTTPClientImpl) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...http.CallOption) (*ListRolesReply, error) {
+	var out ListRolesReply
+	pattern := "/api/v1/authz/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthzServiceListRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthzServiceHTTPClientImpl) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...http.CallOption) (*ListTenantsReply, error) {
+	var out ListTenantsReply
+	pattern := "/api/v1/authz/tenants"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthzServiceListTenants))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthzServiceHTTPClientImpl) RevokePermission(ctx context.Context, in *RevokePermissionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/authz/permissions/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthzServiceRevokePermission))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthzServiceHTTPClientImpl) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/api/v1/authz/roles/revoke"
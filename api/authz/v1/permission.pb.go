@@ -23,10 +23,20 @@ const (
 )
 
 type PermissionOption struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Object        string                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Object string                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Action string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Roles  []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	// authenticated only requires a valid token; no policy check is made.
+	Authenticated bool `protobuf:"varint,4,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	// tenant_scoped methods are checked in the tenant of the request, taken
+	// from the token's tenant claim or the X-Tenant-ID header. Other methods
+	// are checked in the global domain.
+	TenantScoped bool `protobuf:"varint,5,opt,name=tenant_scoped,json=tenantScoped,proto3" json:"tenant_scoped,omitempty"`
+	// owner_field is the path of a request field holding a user ID, such as
+	// "id" or "user.id". The caller is allowed when it is their own ID, even
+	// without the permission.
+	OwnerField    string `protobuf:"bytes,6,opt,name=owner_field,json=ownerField,proto3" json:"owner_field,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,6 +92,27 @@ func (x *PermissionOption) GetRoles() []string {
 	return nil
 }
 
+func (x *PermissionOption) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
+func (x *PermissionOption) GetTenantScoped() bool {
+	if x != nil {
+		return x.TenantScoped
+	}
+	return false
+}
+
+func (x *PermissionOption) GetOwnerField() string {
+	if x != nil {
+		return x.OwnerField
+	}
+	return ""
+}
+
 var file_authz_v1_permission_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
@@ -103,15 +134,19 @@ var File_authz_v1_permission_proto protoreflect.FileDescriptor
 
 const file_authz_v1_permission_proto_rawDesc = "" +
 	"\n" +
-	"\x19authz/v1/permission.proto\x12\bauthz.v1\x1a google/protobuf/descriptor.proto\"X\n" +
+	"\x19authz/v1/permission.proto\x12\bauthz.v1\x1a google/protobuf/descriptor.proto\"\xc4\x01\n" +
 	"\x10PermissionOption\x12\x16\n" +
 	"\x06object\x18\x01 \x01(\tR\x06object\x12\x16\n" +
 	"\x06action\x18\x02 \x01(\tR\x06action\x12\x14\n" +
-	"\x05roles\x18\x03 \x03(\tR\x05roles:\\\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\x12$\n" +
+	"\rauthenticated\x18\x04 \x01(\bR\rauthenticated\x12#\n" +
+	"\rtenant_scoped\x18\x05 \x01(\bR\ftenantScoped\x12\x1f\n" +
+	"\vowner_field\x18\x06 \x01(\tR\n" +
+	"ownerField:\\\n" +
 	"\n" +
 	"permission\x12\x1e.google.protobuf.MethodOptions\x18ц\x03 \x01(\v2\x1a.authz.v1.PermissionOptionR\n" +
-	"permissionB\x8e\x01\n" +
-	"\fcom.authz.v1B\x0fPermissionProtoP\x01Z,github.com/tencat-dev/go-base/api/authz/v1\xa2\x02\x03AXX\xaa\x02\bAuthz.V1\xca\x02\bAuthz\\V1\xe2\x02\x14Authz\\V1\\GPBMetadata\xea\x02\tAuthz::V1b\x06proto3"
+	"permissionB\x8c\x01\n" +
+	"\fcom.authz.v1B\x0fPermissionProtoP\x01Z*github.com/tencat-dev/go-base/api/authz/v1\xa2\x02\x03AXX\xaa\x02\bAuthz.V1\xca\x02\bAuthz\\V1\xe2\x02\x14Authz\\V1\\GPBMetadata\xea\x02\tAuthz::V1b\x06proto3"
 
 var (
 	file_authz_v1_permission_proto_rawDescOnce sync.Once
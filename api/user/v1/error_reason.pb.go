@@ -25,16 +25,19 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_USER_NOT_FOUND ErrorReason = 0
+	ErrorReason_USER_NOT_FOUND           ErrorReason = 0
+	ErrorReason_EMAIL_CHANGE_NOT_ALLOWED ErrorReason = 1
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
 		0: "USER_NOT_FOUND",
+		1: "EMAIL_CHANGE_NOT_ALLOWED",
 	}
 	ErrorReason_value = map[string]int32{
-		"USER_NOT_FOUND": 0,
+		"USER_NOT_FOUND":           0,
+		"EMAIL_CHANGE_NOT_ALLOWED": 1,
 	}
 )
 
@@ -69,10 +72,11 @@ var File_user_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_user_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x1auser/v1/error_reason.proto\x12\auser.v1\x1a\x13errors/errors.proto*-\n" +
+	"\x1auser/v1/error_reason.proto\x12\auser.v1\x1a\x13errors/errors.proto*Q\n" +
 	"\vErrorReason\x12\x18\n" +
-	"\x0eUSER_NOT_FOUND\x10\x00\x1a\x04\xa8E\x94\x03\x1a\x04\xa0E\xf4\x03B\x89\x01\n" +
-	"\vcom.user.v1B\x10ErrorReasonProtoP\x01Z+github.com/tencat-dev/go-base/api/user/v1\xa2\x02\x03UXX\xaa\x02\aUser.V1\xca\x02\aUser\\V1\xe2\x02\x13User\\V1\\GPBMetadata\xea\x02\bUser::V1b\x06proto3"
+	"\x0eUSER_NOT_FOUND\x10\x00\x1a\x04\xa8E\x94\x03\x12\"\n" +
+	"\x18EMAIL_CHANGE_NOT_ALLOWED\x10\x01\x1a\x04\xa8E\x93\x03\x1a\x04\xa0E\xf4\x03B\x87\x01\n" +
+	"\vcom.user.v1B\x10ErrorReasonProtoP\x01Z)github.com/tencat-dev/go-base/api/user/v1\xa2\x02\x03UXX\xaa\x02\aUser.V1\xca\x02\aUser\\V1\xe2\x02\x13User\\V1\\GPBMetadata\xea\x02\bUser::V1b\x06proto3"
 
 var (
 	file_user_v1_error_reason_proto_rawDescOnce sync.Once
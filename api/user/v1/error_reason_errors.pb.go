@@ -22,3 +22,15 @@ func IsUserNotFound(err error) bool {
 func ErrorUserNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, ErrorReason_USER_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
+
+func IsEmailChangeNotAllowed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EMAIL_CHANGE_NOT_ALLOWED.String() && e.Code == 403
+}
+
+func ErrorEmailChangeNotAllowed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_EMAIL_CHANGE_NOT_ALLOWED.String(), fmt.Sprintf(format, args...))
+}
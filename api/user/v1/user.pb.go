@@ -29,6 +29,7 @@ type User struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -84,6 +85,13 @@ func (x *User) GetEmail() string {
 	return ""
 }
 
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -540,15 +548,321 @@ func (x *ListUserReply) GetData() []*User {
 	return nil
 }
 
+type UnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnlockUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnlockUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserReply) Reset() {
+	*x = UnlockUserReply{}
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserReply) ProtoMessage() {}
+
+func (x *UnlockUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserReply.ProtoReflect.Descriptor instead.
+func (*UnlockUserReply) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+type ImportedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	PasswordHash  string                 `protobuf:"bytes,3,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedUser) Reset() {
+	*x = ImportedUser{}
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedUser) ProtoMessage() {}
+
+func (x *ImportedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedUser.ProtoReflect.Descriptor instead.
+func (*ImportedUser) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportedUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ImportedUser) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *ImportedUser) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+type ImportUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*ImportedUser        `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersRequest) Reset() {
+	*x = ImportUsersRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersRequest) ProtoMessage() {}
+
+func (x *ImportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ImportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportUsersRequest) GetUsers() []*ImportedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ImportUsersReply struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Imported      int32                       `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failures      []*ImportUsersReply_Failure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersReply) Reset() {
+	*x = ImportUsersReply{}
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersReply) ProtoMessage() {}
+
+func (x *ImportUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersReply.ProtoReflect.Descriptor instead.
+func (*ImportUsersReply) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportUsersReply) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportUsersReply) GetFailures() []*ImportUsersReply_Failure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type ImportUsersReply_Failure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the user in the request.
+	Index         int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersReply_Failure) Reset() {
+	*x = ImportUsersReply_Failure{}
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersReply_Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersReply_Failure) ProtoMessage() {}
+
+func (x *ImportUsersReply_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersReply_Failure.ProtoReflect.Descriptor instead.
+func (*ImportUsersReply_Failure) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ImportUsersReply_Failure) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportUsersReply_Failure) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ImportUsersReply_Failure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bbuf/validate/validate.proto\x1a\x19authz/v1/permission.proto\"@\n" +
+	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bbuf/validate/validate.proto\x1a\x19authz/v1/permission.proto\"g\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"u\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\"u\n" +
 	"\x11CreateUserRequest\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18@R\x04name\x12\x1d\n" +
 	"\x05email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12$\n" +
@@ -570,22 +884,45 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x01(\v2\r.user.v1.UserR\x04data\"\x11\n" +
 	"\x0fListUserRequest\"2\n" +
 	"\rListUserReply\x12!\n" +
-	"\x04data\x18\x01 \x03(\v2\r.user.v1.UserR\x04data2\xd3\x04\n" +
+	"\x04data\x18\x01 \x03(\v2\r.user.v1.UserR\x04data\"-\n" +
+	"\x11UnlockUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x11\n" +
+	"\x0fUnlockUserReply\"\xa2\x01\n" +
+	"\fImportedUser\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18@R\x04name\x12\x1d\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12/\n" +
+	"\rpassword_hash\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x04R\fpasswordHash\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\"N\n" +
+	"\x12ImportUsersRequest\x128\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.user.v1.ImportedUserB\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\x05users\"\xbc\x01\n" +
+	"\x10ImportUsersReply\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12=\n" +
+	"\bfailures\x18\x02 \x03(\v2!.user.v1.ImportUsersReply.FailureR\bfailures\x1aM\n" +
+	"\aFailure\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason2\xe0\x06\n" +
 	"\vUserService\x12u\n" +
 	"\n" +
 	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x18.user.v1.CreateUserReply\"1\x8a\xb5\x18\x15\n" +
-	"\x04user\x12\x06create\x1a\x05admin\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/users\x12z\n" +
+	"\x04user\x12\x06create\x1a\x05admin\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/users\x12~\n" +
 	"\n" +
-	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x18.user.v1.UpdateUserReply\"6\x8a\xb5\x18\x15\n" +
-	"\x04user\x12\x06update\x1a\x05admin\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/v1/users/{id}\x12w\n" +
+	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x18.user.v1.UpdateUserReply\":\x8a\xb5\x18\x19\n" +
+	"\x04user\x12\x06update\x1a\x05admin2\x02id\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/v1/users/{id}\x12w\n" +
 	"\n" +
 	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x18.user.v1.DeleteUserReply\"3\x8a\xb5\x18\x15\n" +
-	"\x04user\x12\x06delete\x1a\x05admin\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/users/{id}\x12l\n" +
-	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x15.user.v1.GetUserReply\"1\x8a\xb5\x18\x13\n" +
-	"\x04user\x12\x04read\x1a\x05admin\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/users/{id}\x12j\n" +
+	"\x04user\x12\x06delete\x1a\x05admin\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/users/{id}\x12p\n" +
+	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x15.user.v1.GetUserReply\"5\x8a\xb5\x18\x17\n" +
+	"\x04user\x12\x04read\x1a\x05admin2\x02id\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/users/{id}\x12j\n" +
 	"\bListUser\x12\x18.user.v1.ListUserRequest\x1a\x16.user.v1.ListUserReply\",\x8a\xb5\x18\x13\n" +
-	"\x04user\x12\x04list\x1a\x05admin\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/usersB\x82\x01\n" +
-	"\vcom.user.v1B\tUserProtoP\x01Z+github.com/tencat-dev/go-base/api/user/v1\xa2\x02\x03UXX\xaa\x02\aUser.V1\xca\x02\aUser\\V1\xe2\x02\x13User\\V1\\GPBMetadata\xea\x02\bUser::V1b\x06proto3"
+	"\x04user\x12\x04list\x1a\x05admin\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12\x81\x01\n" +
+	"\n" +
+	"UnlockUser\x12\x1a.user.v1.UnlockUserRequest\x1a\x18.user.v1.UnlockUserReply\"=\x8a\xb5\x18\x15\n" +
+	"\x04user\x12\x06unlock\x1a\x05admin\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/users/{id}/unlock\x12\x7f\n" +
+	"\vImportUsers\x12\x1b.user.v1.ImportUsersRequest\x1a\x19.user.v1.ImportUsersReply\"8\x8a\xb5\x18\x15\n" +
+	"\x04user\x12\x06import\x1a\x05admin\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/users/importB\x80\x01\n" +
+	"\vcom.user.v1B\tUserProtoP\x01Z)github.com/tencat-dev/go-base/api/user/v1\xa2\x02\x03UXX\xaa\x02\aUser.V1\xca\x02\aUser\\V1\xe2\x02\x13User\\V1\\GPBMetadata\xea\x02\bUser::V1b\x06proto3"
 
 var (
 	file_user_v1_user_proto_rawDescOnce sync.Once
@@ -599,40 +936,52 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_user_v1_user_proto_goTypes = []any{
-	(*User)(nil),              // 0: user.v1.User
-	(*CreateUserRequest)(nil), // 1: user.v1.CreateUserRequest
-	(*CreateUserReply)(nil),   // 2: user.v1.CreateUserReply
-	(*UpdateUserRequest)(nil), // 3: user.v1.UpdateUserRequest
-	(*UpdateUserReply)(nil),   // 4: user.v1.UpdateUserReply
-	(*DeleteUserRequest)(nil), // 5: user.v1.DeleteUserRequest
-	(*DeleteUserReply)(nil),   // 6: user.v1.DeleteUserReply
-	(*GetUserRequest)(nil),    // 7: user.v1.GetUserRequest
-	(*GetUserReply)(nil),      // 8: user.v1.GetUserReply
-	(*ListUserRequest)(nil),   // 9: user.v1.ListUserRequest
-	(*ListUserReply)(nil),     // 10: user.v1.ListUserReply
+	(*User)(nil),                     // 0: user.v1.User
+	(*CreateUserRequest)(nil),        // 1: user.v1.CreateUserRequest
+	(*CreateUserReply)(nil),          // 2: user.v1.CreateUserReply
+	(*UpdateUserRequest)(nil),        // 3: user.v1.UpdateUserRequest
+	(*UpdateUserReply)(nil),          // 4: user.v1.UpdateUserReply
+	(*DeleteUserRequest)(nil),        // 5: user.v1.DeleteUserRequest
+	(*DeleteUserReply)(nil),          // 6: user.v1.DeleteUserReply
+	(*GetUserRequest)(nil),           // 7: user.v1.GetUserRequest
+	(*GetUserReply)(nil),             // 8: user.v1.GetUserReply
+	(*ListUserRequest)(nil),          // 9: user.v1.ListUserRequest
+	(*ListUserReply)(nil),            // 10: user.v1.ListUserReply
+	(*UnlockUserRequest)(nil),        // 11: user.v1.UnlockUserRequest
+	(*UnlockUserReply)(nil),          // 12: user.v1.UnlockUserReply
+	(*ImportedUser)(nil),             // 13: user.v1.ImportedUser
+	(*ImportUsersRequest)(nil),       // 14: user.v1.ImportUsersRequest
+	(*ImportUsersReply)(nil),         // 15: user.v1.ImportUsersReply
+	(*ImportUsersReply_Failure)(nil), // 16: user.v1.ImportUsersReply.Failure
 }
 var file_user_v1_user_proto_depIdxs = []int32{
 	0,  // 0: user.v1.CreateUserReply.data:type_name -> user.v1.User
 	0,  // 1: user.v1.UpdateUserReply.data:type_name -> user.v1.User
 	0,  // 2: user.v1.GetUserReply.data:type_name -> user.v1.User
 	0,  // 3: user.v1.ListUserReply.data:type_name -> user.v1.User
-	1,  // 4: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
-	3,  // 5: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
-	5,  // 6: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
-	7,  // 7: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	9,  // 8: user.v1.UserService.ListUser:input_type -> user.v1.ListUserRequest
-	2,  // 9: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserReply
-	4,  // 10: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserReply
-	6,  // 11: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserReply
-	8,  // 12: user.v1.UserService.GetUser:output_type -> user.v1.GetUserReply
-	10, // 13: user.v1.UserService.ListUser:output_type -> user.v1.ListUserReply
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	13, // 4: user.v1.ImportUsersRequest.users:type_name -> user.v1.ImportedUser
+	16, // 5: user.v1.ImportUsersReply.failures:type_name -> user.v1.ImportUsersReply.Failure
+	1,  // 6: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
+	3,  // 7: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	5,  // 8: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
+	7,  // 9: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	9,  // 10: user.v1.UserService.ListUser:input_type -> user.v1.ListUserRequest
+	11, // 11: user.v1.UserService.UnlockUser:input_type -> user.v1.UnlockUserRequest
+	14, // 12: user.v1.UserService.ImportUsers:input_type -> user.v1.ImportUsersRequest
+	2,  // 13: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserReply
+	4,  // 14: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserReply
+	6,  // 15: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserReply
+	8,  // 16: user.v1.UserService.GetUser:output_type -> user.v1.GetUserReply
+	10, // 17: user.v1.UserService.ListUser:output_type -> user.v1.ListUserReply
+	12, // 18: user.v1.UserService.UnlockUser:output_type -> user.v1.UnlockUserReply
+	15, // 19: user.v1.UserService.ImportUsers:output_type -> user.v1.ImportUsersReply
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -646,7 +995,7 @@ func file_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
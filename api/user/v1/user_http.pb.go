@@ -22,14 +22,23 @@ const _ = http.SupportPackageIsVersion1
 const OperationUserServiceCreateUser = "/user.v1.UserService/CreateUser"
 const OperationUserServiceDeleteUser = "/user.v1.UserService/DeleteUser"
 const OperationUserServiceGetUser = "/user.v1.UserService/GetUser"
+const OperationUserServiceImportUsers = "/user.v1.UserService/ImportUsers"
 const OperationUserServiceListUser = "/user.v1.UserService/ListUser"
+const OperationUserServiceUnlockUser = "/user.v1.UserService/UnlockUser"
 const OperationUserServiceUpdateUser = "/user.v1.UserService/UpdateUser"
 
 type UserServiceHTTPServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserReply, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserReply, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserReply, error)
+	// ImportUsers ImportUsers creates users with password hashes from another system.
+	// Argon2, bcrypt, PBKDF2-SHA256 and scrypt hashes are accepted; the others
+	// are upgraded to Argon2id on each user's next login.
+	ImportUsers(context.Context, *ImportUsersRequest) (*ImportUsersReply, error)
 	ListUser(context.Context, *ListUserRequest) (*ListUserReply, error)
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserReply, error)
+	// UpdateUser Users may change their own name. Changing an email takes the
+	// user:update permission, and a verification link is sent to the new one.
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserReply, error)
 }
 
@@ -40,6 +49,8 @@ func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r.DELETE("/api/v1/users/{id}", _UserService_DeleteUser0_HTTP_Handler(srv))
 	r.GET("/api/v1/users/{id}", _UserService_GetUser0_HTTP_Handler(srv))
 	r.GET("/api/v1/users", _UserService_ListUser0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/{id}/unlock", _UserService_UnlockUser0_HTTP_Handler(srv))
+	r.POST("/api/v1/users/import", _UserService_ImportUsers0_HTTP_Handler(srv))
 }
 
 func _UserService_CreateUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
@@ -152,11 +163,65 @@ func _UserService_ListUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx htt
 	}
 }
 
+func _UserService_UnlockUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUnlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockUser(ctx, req.(*UnlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlockUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ImportUsers0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceImportUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportUsers(ctx, req.(*ImportUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserServiceHTTPClient interface {
 	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *CreateUserReply, err error)
 	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserReply, err error)
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserReply, err error)
+	// ImportUsers ImportUsers creates users with password hashes from another system.
+	// Argon2, bcrypt, PBKDF2-SHA256 and scrypt hashes are accepted; the others
+	// are upgraded to Argon2id on each user's next login.
+	ImportUsers(ctx context.Context, req *ImportUsersRequest, opts ...http.CallOption) (rsp *ImportUsersReply, err error)
 	ListUser(ctx context.Context, req *ListUserRequest, opts ...http.CallOption) (rsp *ListUserReply, err error)
+	UnlockUser(ctx context.Context, req *UnlockUserRequest, opts ...http.CallOption) (rsp *UnlockUserReply, err error)
+	// UpdateUser Users may change their own name. Changing an email takes the
+	// user:update permission, and a verification link is sent to the new one.
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UpdateUserReply, err error)
 }
 
@@ -207,6 +272,22 @@ func (c *UserServiceHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequ
 	return &out, nil
 }
 
+// ImportUsers ImportUsers creates users with password hashes from another system.
+// Argon2, bcrypt, PBKDF2-SHA256 and scrypt hashes are accepted; the others
+// are upgraded to Argon2id on each user's next login.
+func (c *UserServiceHTTPClientImpl) ImportUsers(ctx context.Context, in *ImportUsersRequest, opts ...http.CallOption) (*ImportUsersReply, error) {
+	var out ImportUsersReply
+	pattern := "/api/v1/users/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceImportUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) ListUser(ctx context.Context, in *ListUserRequest, opts ...http.CallOption) (*ListUserReply, error) {
 	var out ListUserReply
 	pattern := "/api/v1/users"
@@ -220,6 +301,21 @@ func (c *UserServiceHTTPClientImpl) ListUser(ctx context.Context, in *ListUserRe
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...http.CallOption) (*UnlockUserReply, error) {
+	var out UnlockUserReply
+	pattern := "/api/v1/users/{id}/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUnlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateUser Users may change their own name. Changing an email takes the
+// user:update permission, and a verification link is sent to the new one.
 func (c *UserServiceHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UpdateUserReply, error) {
 	var out UpdateUserReply
 	pattern := "/api/v1/users/{id}"
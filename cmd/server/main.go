@@ -22,6 +22,7 @@ import (
 	_ "go.uber.org/automaxprocs"
 
 	"github.com/tencat-dev/go-base/internal/authz"
+	"github.com/tencat-dev/go-base/internal/biz"
 	"github.com/tencat-dev/go-base/internal/conf"
 	"github.com/tencat-dev/go-base/internal/server"
 )
@@ -50,6 +51,7 @@ var ProviderSetConfig = wire.NewSet(
 	newData,
 	newDatabaseConfig,
 	newAuthz,
+	newMail,
 )
 
 func newServer(c *conf.Bootstrap) *conf.Server {
@@ -79,6 +81,9 @@ func newDatabaseConfig(c *conf.Data) *conf.DatabaseConfig {
 func newAuthz(c *conf.Bootstrap) *conf.Authz {
 	return c.Authz
 }
+func newMail(c *conf.Bootstrap) *conf.Mail {
+	return c.Mail
+}
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "./configs/config.yaml", "config path, eg: -conf config.yaml")
@@ -101,8 +106,10 @@ func newApp(
 	gs server.GrpcServer,
 	hs server.HttpServer,
 	ps server.PprofServer,
+	logHelper *log.Helper,
 	enforcer casbin.IEnforcer,
 	authzRegistry *authz.AuthzRegistry,
+	managedPolicies biz.ManagedPolicyRepo,
 ) (*kratos.App, func(), error) {
 	var srvs []transport.Server
 
@@ -123,8 +130,7 @@ func newApp(
 	}
 
 	if confAuthz.AutoSync {
-		err := authz.SyncFromRegistry(enforcer, authzRegistry)
-		if err != nil {
+		if err := syncPolicies(ctx, confAuthz, logHelper, enforcer, authzRegistry, managedPolicies); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -146,6 +152,54 @@ func newApp(
 	}, nil
 }
 
+// syncPolicies brings the policies in line with the permission annotations
+// in the way conf.Authz asks for.
+func syncPolicies(
+	ctx context.Context,
+	c *conf.Authz,
+	logHelper *log.Helper,
+	enforcer casbin.IEnforcer,
+	authzRegistry *authz.AuthzRegistry,
+	managedPolicies biz.ManagedPolicyRepo,
+) error {
+	switch c.SyncMode {
+	case "reconcile", "dry_run":
+		dryRun := c.SyncMode == "dry_run"
+		diff, err := authz.Reconcile(ctx, enforcer, authzRegistry, managedPolicies, dryRun)
+		if err != nil {
+			return err
+		}
+		for _, p := range diff.Added {
+			logHelper.Infof("authz sync: add policy %v (dry run: %t)", p, dryRun)
+		}
+		for _, p := range diff.Removed {
+			logHelper.Infof("authz sync: remove policy %v (dry run: %t)", p, dryRun)
+		}
+		return nil
+	case "adopt":
+		diff, err := authz.Adopt(ctx, enforcer, authzRegistry, managedPolicies)
+		if err != nil {
+			return err
+		}
+		for _, p := range diff.Added {
+			logHelper.Infof("authz sync: add policy %v", p)
+		}
+		logHelper.Info("authz sync: adopted the annotation policies as managed")
+		return nil
+	case "", "add":
+		diff, err := authz.SyncFromRegistry(ctx, enforcer, authzRegistry, managedPolicies)
+		if err != nil {
+			return err
+		}
+		for _, p := range diff.Added {
+			logHelper.Infof("authz sync: add policy %v", p)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown authz sync_mode %q", c.SyncMode)
+	}
+}
+
 func main() {
 	flag.Parse()
 
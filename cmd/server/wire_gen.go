@@ -15,6 +15,10 @@ import (
 	"github.com/tencat-dev/go-base/internal/conf"
 	"github.com/tencat-dev/go-base/internal/data"
 	"github.com/tencat-dev/go-base/internal/infra/auth"
+	"github.com/tencat-dev/go-base/internal/infra/mail"
+	"github.com/tencat-dev/go-base/internal/infra/metrics"
+	"github.com/tencat-dev/go-base/internal/infra/oidc"
+	"github.com/tencat-dev/go-base/internal/infra/password"
 	"github.com/tencat-dev/go-base/internal/server"
 	"github.com/tencat-dev/go-base/internal/service"
 )
@@ -36,42 +40,131 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, logger l
 	if err != nil {
 		return nil, nil, err
 	}
+	transaction := data.NewTransaction(dataData)
 	userRepo := data.NewUserRepo(dataData, helper)
-	userBiz := biz.NewUserBiz(userRepo)
-	userServiceServer := service.NewUserService(userBiz)
-	authRepo := data.NewAuthRepo(dataData, helper)
-	iEnforcer, err := data.NewCasbinEnforcer(dataData)
+	loginThrottleRepo := data.NewLoginThrottleRepo(dataData, helper)
+	confAuth := newAuth(bootstrap)
+	v := password.NewVerifiers()
+	passwordHasher := biz.NewPasswordHasher(confAuth, v)
+	passwordHistoryRepo := data.NewPasswordHistoryRepo(dataData, helper)
+	passwordPolicy, err := biz.NewPasswordPolicy(confAuth, passwordHistoryRepo, passwordHasher)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	meterProvider, cleanup2, err := metrics.NewMeterProvider()
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
+	iEnforcer, cleanup3, err := data.NewCasbinEnforcer(dataData, confAuthz, meterProvider, helper)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	casbinAuthz, err := data.NewCasbinAuthz(iEnforcer)
 	if err != nil {
+		cleanup3()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	permissionChecker := data.NewPermissionChecker(casbinAuthz)
-	authBiz := biz.NewAuthBiz(authRepo, permissionChecker)
-	confAuth := newAuth(bootstrap)
+	userBiz := biz.NewUserBiz(transaction, userRepo, loginThrottleRepo, passwordHasher, passwordPolicy, permissionChecker)
+	authRepo := data.NewAuthRepo(dataData, helper)
+	userTokenRepo := data.NewUserTokenRepo(dataData, helper)
+	confMail := newMail(bootstrap)
+	mailer, err := mail.NewMailer(confMail, helper)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	emailVerificationBiz := biz.NewEmailVerificationBiz(authRepo, userRepo, userTokenRepo, loginThrottleRepo, mailer, confAuth, helper)
+	userServiceServer := service.NewUserService(userBiz, emailVerificationBiz)
 	jwt := newJwtConfig(confAuth)
-	tokenMaker := auth.NewJWTMaker(jwt)
-	authServiceServer := service.NewAuthService(authBiz, tokenMaker)
+	keySet, err := auth.NewKeySet(jwt)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenMaker := auth.NewJWTMaker(keySet)
+	refreshTokenRepo := data.NewRefreshTokenRepo(dataData, helper)
+	sessionRepo := data.NewSessionRepo(dataData, confAuth, helper)
+	oAuthRepo := data.NewOAuthRepo(dataData, helper)
+	tenantRepo := data.NewTenantRepo(dataData, helper)
+	authBiz := biz.NewAuthBiz(authRepo, userRepo, permissionChecker, tokenMaker, refreshTokenRepo, sessionRepo, oAuthRepo, tenantRepo, loginThrottleRepo, passwordHasher, confAuth, helper)
+	sessionBiz := biz.NewSessionBiz(sessionRepo, refreshTokenRepo, confAuth)
+	mfaRepo := data.NewMFARepo(dataData, helper)
+	mfaBiz := biz.NewMFABiz(mfaRepo, userRepo, tokenMaker, loginThrottleRepo, confAuth)
+	apiKeyRepo := data.NewAPIKeyRepo(dataData, helper)
+	passwordBiz := biz.NewPasswordBiz(transaction, authRepo, userRepo, userTokenRepo, sessionRepo, refreshTokenRepo, apiKeyRepo, oAuthRepo, loginThrottleRepo, mailer, passwordHasher, passwordPolicy, confAuth, helper)
+	apiKeyBiz := biz.NewAPIKeyBiz(apiKeyRepo)
+	v2 := oidc.NewIdentityProviders(confAuth)
+	externalIdentityRepo := data.NewExternalIdentityRepo(dataData, helper)
+	federationBiz := biz.NewFederationBiz(v2, externalIdentityRepo, authRepo, userRepo, userTokenRepo, passwordHasher, confAuth, helper)
+	introspectionBiz := biz.NewIntrospectionBiz(tokenMaker, sessionRepo, refreshTokenRepo, userRepo, permissionChecker)
+	passkeyRepo := data.NewPasskeyRepo(dataData, helper)
+	passkeyBiz, err := biz.NewPasskeyBiz(passkeyRepo, userRepo, confAuth, helper)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	magicLinkBiz := biz.NewMagicLinkBiz(authRepo, userRepo, userTokenRepo, loginThrottleRepo, mailer, confAuth, helper)
+	authServiceServer := service.NewAuthService(authBiz, sessionBiz, mfaBiz, passwordBiz, emailVerificationBiz, apiKeyBiz, federationBiz, introspectionBiz, passkeyBiz, magicLinkBiz)
 	permissionManager := data.NewPermissionManager(casbinAuthz)
-	authzBiz := biz.NewAuthzBiz(permissionManager)
+	permissionReader := data.NewPermissionReader(casbinAuthz)
+	authzBiz := biz.NewAuthzBiz(permissionManager, permissionReader, tenantRepo)
 	authzServiceServer := service.NewAuthzService(authzBiz)
+	oAuthClientRepo := data.NewOAuthClientRepo(dataData, helper)
+	oAuthBiz := biz.NewOAuthBiz(transaction, oAuthClientRepo, oAuthRepo, userRepo, sessionBiz, sessionRepo, refreshTokenRepo, tokenMaker, confAuth)
+	oAuthServiceServer := service.NewOAuthService(oAuthBiz)
+	oAuthClientServiceServer := service.NewOAuthClientService(oAuthBiz)
 	authzRegistry := authz.NewAuthzRegistry()
-	authzMiddleware := authz.NewAuthzMiddleware(jwt, iEnforcer, authzRegistry)
-	serverGrpcServer := server.NewGRPCServer(grpcServer, userServiceServer, authServiceServer, authzServiceServer, logger, authzMiddleware)
+	sessionChecker := data.NewSessionChecker(sessionRepo)
+	authzMiddleware := authz.NewAuthzMiddleware(keySet, iEnforcer, authzRegistry, sessionChecker, apiKeyBiz, confAuth, confAuthz, helper)
+	serverGrpcServer, err := server.NewGRPCServer(grpcServer, userServiceServer, authServiceServer, authzServiceServer, oAuthServiceServer, oAuthClientServiceServer, logger, authzMiddleware)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	httpServer := newHttpServer(confServer)
-	serverHttpServer := server.NewHTTPServer(httpServer, userServiceServer, authServiceServer, authzServiceServer, logger, authzMiddleware)
+	oAuthHandler, err := service.NewOAuthHandler(oAuthBiz, keySet, helper)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	federationHandler := service.NewFederationHandler(federationBiz, helper)
+	serverHttpServer, err := server.NewHTTPServer(httpServer, userServiceServer, authServiceServer, authzServiceServer, oAuthServiceServer, oAuthClientServiceServer, logger, authzMiddleware, keySet, oAuthHandler, federationHandler)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	pprofServer := newPprofServer(confServer)
 	serverPprofServer := server.NewPprofServer(pprofServer)
-	app, cleanup2, err := newApp(contextContext, confServer, confAuthz, logger, serverGrpcServer, serverHttpServer, serverPprofServer, iEnforcer, authzRegistry)
+	managedPolicyRepo := data.NewManagedPolicyRepo(dataData, helper)
+	app, cleanup4, err := newApp(contextContext, confServer, confAuthz, logger, serverGrpcServer, serverHttpServer, serverPprofServer, helper, iEnforcer, authzRegistry, managedPolicyRepo)
 	if err != nil {
+		cleanup3()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	return app, func() {
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 	}, nil
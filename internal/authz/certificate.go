@@ -0,0 +1,101 @@
+package authz
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+	grpccreds "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	authzv1 "github.com/tencat-dev/go-base/api/authz/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+	"github.com/tencat-dev/go-base/internal/infra/auth"
+)
+
+// authorizeCertificate enforces the policy as the subject mapped from the
+// client's certificate. Like API keys, certificates cannot call methods that
+// only require authentication, as those act on the caller's session.
+func authorizeCertificate(
+	ctx context.Context,
+	req any,
+	next middleware.Handler,
+	e casbin.IEnforcer,
+	perm *authzv1.PermissionOption,
+	dom string,
+	sub string,
+) (any, error) {
+	if perm.Authenticated {
+		return nil, errors.Forbidden("ACCESS_DENIED", "permission denied")
+	}
+
+	allowed, err := e.Enforce(sub, dom, perm.Object, perm.Action)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.Forbidden("ACCESS_DENIED", "permission denied")
+	}
+
+	ctx = jwt.NewContext(ctx, &auth.JWTClaims{
+		RegisteredClaims: jwtv5.RegisteredClaims{Subject: sub},
+	})
+	return next(ctx, req)
+}
+
+// certificateSubject returns the Casbin subject of the first identity that
+// matches the certificate.
+func certificateSubject(identities []*conf.CertificateIdentity, cert *x509.Certificate) (string, bool) {
+	for _, id := range identities {
+		var values []string
+		switch id.GetSource() {
+		case "uri":
+			for _, u := range cert.URIs {
+				values = append(values, u.String())
+			}
+		case "dns":
+			values = cert.DNSNames
+		case "cn":
+			values = []string{cert.Subject.CommonName}
+		}
+
+		for _, v := range values {
+			name, ok := strings.CutPrefix(v, id.GetPrefix())
+			if ok && name != "" {
+				return id.GetSubjectPrefix() + name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// peerCertificate returns the client certificate of the connection if the
+// server verified it against its client CAs.
+func peerCertificate(ctx context.Context, tr transport.Transporter) *x509.Certificate {
+	var state *tls.ConnectionState
+	switch tr.Kind() {
+	case transport.KindHTTP:
+		if ht, ok := tr.(http.Transporter); ok {
+			state = ht.Request().TLS
+		}
+	case transport.KindGRPC:
+		if p, ok := peer.FromContext(ctx); ok {
+			if info, ok := p.AuthInfo.(grpccreds.TLSInfo); ok {
+				state = &info.State
+			}
+		}
+	}
+
+	if state == nil || len(state.VerifiedChains) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
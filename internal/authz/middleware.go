@@ -2,29 +2,73 @@ package authz
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/casbin/casbin/v3"
 	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/transport"
 	jwtv5 "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	authzv1 "github.com/tencat-dev/go-base/api/authz/v1"
+	oauthv1 "github.com/tencat-dev/go-base/api/oauth/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
 	"github.com/tencat-dev/go-base/internal/conf"
+	"github.com/tencat-dev/go-base/internal/infra/auth"
 )
 
 type AuthzMiddleware middleware.Middleware
 
+// TenantHeader names the tenant of a request made with a token that is not
+// bound to one.
+const TenantHeader = "X-Tenant-ID"
+
+// defaultImpersonationBlocked are the operations refused while impersonating
+// unless configured otherwise.
+var defaultImpersonationBlocked = []string{
+	authv1.OperationAuthServiceChangePassword,
+	authv1.OperationAuthServiceEnrollMFA,
+	authv1.OperationAuthServiceConfirmMFA,
+	authv1.OperationAuthServiceDisableMFA,
+	authv1.OperationAuthServiceCreateAPIKey,
+	authv1.OperationAuthServiceRevokeAPIKey,
+	authv1.OperationAuthServiceBeginPasskeyRegistration,
+	authv1.OperationAuthServiceFinishPasskeyRegistration,
+	authv1.OperationAuthServiceDeletePasskey,
+	authv1.OperationAuthServiceLogoutAll,
+	authv1.OperationAuthServiceRevokeMySession,
+	authzv1.OperationAuthzServiceGrantRole,
+	authzv1.OperationAuthzServiceRevokeRole,
+	authzv1.OperationAuthzServiceGrantPermission,
+	authzv1.OperationAuthzServiceRevokePermission,
+}
+
 func NewAuthzMiddleware(
-	jwtConf *conf.JWT,
+	keys *auth.KeySet,
 	e casbin.IEnforcer,
 	r *AuthzRegistry,
+	sessions biz.SessionChecker,
+	apiKeys *biz.APIKeyBiz,
+	c *conf.Auth,
+	ac *conf.Authz,
+	logger *log.Helper,
 ) AuthzMiddleware {
-	jwtMiddleware := jwt.Server(
-		func(*jwtv5.Token) (any, error) {
-			return []byte(jwtConf.Secret), nil
-		},
-		jwt.WithSigningMethod(jwtv5.SigningMethodHS256),
+	blocked := c.GetImpersonation().GetBlockedOperations()
+	if len(blocked) == 0 {
+		blocked = defaultImpersonationBlocked
+	}
+	// Nested impersonation, leaving the impersonated tenant and authorizing
+	// OAuth clients, which would issue tokens without the act claim, are
+	// always refused.
+	blocked = append(slices.Clone(blocked),
+		authv1.OperationAuthServiceImpersonate,
+		authv1.OperationAuthServiceSwitchTenant,
+		oauthv1.OperationOAuthServiceAuthorize,
 	)
 
 	return func(next middleware.Handler) middleware.Handler {
@@ -42,31 +86,225 @@ func NewAuthzMiddleware(
 				return next(ctx, req)
 			}
 
-			// 🔥 Protected API → verify JWT first
-			handlerWithJWT := jwtMiddleware(func(ctx context.Context, req any) (any, error) {
-
-				token, ok := jwt.FromContext(ctx)
-				if !ok {
-					return nil, errors.Unauthorized("NO_USER", "no user")
-				}
-
-				sub, err := token.GetSubject()
+			scheme, key := credentials(tr)
+			if strings.EqualFold(scheme, biz.APIKeyScheme) {
+				dom, err := domain(tr, perm, "")
 				if err != nil {
-					return nil, errors.Unauthorized("INVALID_TOKEN", err.Error())
+					return nil, err
 				}
+				ctx = withTenant(ctx, perm, dom)
+				return authorizeAPIKey(ctx, req, next, e, apiKeys, perm, dom, key)
+			}
 
-				allowed, err := e.Enforce(sub, perm.Object, perm.Action)
-				if err != nil {
-					return nil, err
+			// Without credentials, fall back to the client certificate.
+			if scheme == "" {
+				if cert := peerCertificate(ctx, tr); cert != nil {
+					if sub, ok := certificateSubject(ac.GetClientCertificates(), cert); ok {
+						dom, err := domain(tr, perm, "")
+						if err != nil {
+							return nil, err
+						}
+						ctx = withTenant(ctx, perm, dom)
+						return authorizeCertificate(ctx, req, next, e, perm, dom, sub)
+					}
 				}
-				if !allowed {
-					return nil, errors.Forbidden("ACCESS_DENIED", "permission denied")
+			}
+
+			// 🔥 Protected API → verify JWT first
+			ctx, err := authenticate(ctx, keys)
+			if err != nil {
+				return nil, err
+			}
+
+			claims, ok := auth.ClaimsFromContext(ctx)
+			if !ok {
+				return nil, errors.Unauthorized("NO_USER", "no user")
+			}
+
+			sub, err := claims.GetSubject()
+			if err != nil {
+				return nil, errors.Unauthorized("INVALID_TOKEN", err.Error())
+			}
+
+			sid, err := uuid.Parse(claims.SessionID)
+			if err != nil {
+				return nil, errors.Unauthorized("INVALID_TOKEN", "invalid session id")
+			}
+
+			revoked, err := sessions.IsRevoked(ctx, sid)
+			if err != nil {
+				return nil, err
+			}
+			if revoked {
+				return nil, errors.Unauthorized("SESSION_REVOKED", "session has been revoked")
+			}
+			if err := sessions.Touch(ctx, sid); err != nil {
+				return nil, err
+			}
+
+			// Permissions are enforced as the impersonated user.
+			if claims.Actor != nil {
+				if slices.Contains(blocked, fullMethod) {
+					return nil, authv1.ErrorImpersonationNotAllowed("%s is not allowed while impersonating", fullMethod)
 				}
+				logger.WithContext(ctx).Infof("impersonation: %s acting as %s called %s", claims.Actor.Subject, sub, fullMethod)
+			}
 
+			dom, err := domain(tr, perm, claims.Tenant)
+			if err != nil {
+				return nil, err
+			}
+			ctx = withTenant(ctx, perm, dom)
+
+			if perm.Authenticated {
 				return next(ctx, req)
-			})
+			}
+
+			// Owners may call the method on their own records.
+			if isOwner(req, perm.OwnerField, sub) {
+				return next(ctx, req)
+			}
+
+			allowed, err := e.Enforce(sub, dom, perm.Object, perm.Action)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				return nil, errors.Forbidden("ACCESS_DENIED", "permission denied")
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+// authorizeAPIKey checks the key and enforces the policy as the key's owner,
+// narrowed to the key's scopes. Keys cannot call methods that only require
+// authentication, as those act on the caller's session.
+func authorizeAPIKey(
+	ctx context.Context,
+	req any,
+	next middleware.Handler,
+	e casbin.IEnforcer,
+	apiKeys *biz.APIKeyBiz,
+	perm *authzv1.PermissionOption,
+	dom string,
+	key string,
+) (any, error) {
+	k, err := apiKeys.Authenticate(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if perm.Authenticated || !k.Allows(perm.Object, perm.Action) {
+		return nil, errors.Forbidden("ACCESS_DENIED", "permission denied")
+	}
+
+	sub := k.UserID.String()
+	if !isOwner(req, perm.OwnerField, sub) {
+		allowed, err := e.Enforce(sub, dom, perm.Object, perm.Action)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, errors.Forbidden("ACCESS_DENIED", "permission denied")
+		}
+	}
+
+	ctx = jwt.NewContext(ctx, &auth.JWTClaims{
+		RegisteredClaims: jwtv5.RegisteredClaims{Subject: sub},
+	})
+	return next(ctx, req)
+}
+
+// domain returns the Casbin domain the method is checked in. Tenant-scoped
+// methods are checked in the tenant the token is bound to, or else the one
+// named by the X-Tenant-ID header.
+func domain(tr transport.Transporter, perm *authzv1.PermissionOption, bound string) (string, error) {
+	if !perm.TenantScoped {
+		return biz.GlobalDomain, nil
+	}
 
-			return handlerWithJWT(ctx, req)
+	tenant := tr.RequestHeader().Get(TenantHeader)
+	if bound != "" {
+		if tenant != "" && tenant != bound {
+			return "", authzv1.ErrorInvalidTenant("token is bound to another tenant")
 		}
+		tenant = bound
+	}
+	if tenant == "" {
+		return "", authzv1.ErrorTenantRequired("%s requires a tenant", tr.Operation())
 	}
+
+	id, err := uuid.Parse(tenant)
+	if err != nil {
+		return "", authzv1.ErrorInvalidTenant("invalid tenant id")
+	}
+	return id.String(), nil
+}
+
+type tenantKey struct{}
+
+// withTenant records the tenant a tenant-scoped method is called in.
+func withTenant(ctx context.Context, perm *authzv1.PermissionOption, dom string) context.Context {
+	if !perm.TenantScoped {
+		return ctx
+	}
+	return context.WithValue(ctx, tenantKey{}, dom)
+}
+
+// TenantFromContext returns the tenant a tenant-scoped method is called in.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// credentials splits the Authorization header, which gRPC clients send as
+// metadata, into its scheme and value.
+func credentials(tr transport.Transporter) (string, string) {
+	parts := strings.SplitN(tr.RequestHeader().Get("Authorization"), " ", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], strings.TrimSpace(parts[1])
+}
+
+// authenticate verifies the bearer token of the request against the key set
+// and stores its claims in the context.
+func authenticate(ctx context.Context, keys *auth.KeySet) (context.Context, error) {
+	tr, _ := transport.FromServerContext(ctx)
+	scheme, token := credentials(tr)
+	if !strings.EqualFold(scheme, "Bearer") {
+		return nil, jwt.ErrMissingJwtToken
+	}
+
+	claims := &auth.JWTClaims{}
+	if _, err := keys.Parse(token, claims); err != nil {
+		switch {
+		case errors.Is(err, jwtv5.ErrTokenExpired):
+			return nil, authv1.ErrorTokenExpired("token has expired")
+		case errors.Is(err, jwtv5.ErrTokenNotValidYet), errors.Is(err, jwtv5.ErrTokenUsedBeforeIssued):
+			return nil, authv1.ErrorTokenNotYetValid("token is not valid yet")
+		case errors.Is(err, jwtv5.ErrTokenInvalidIssuer):
+			return nil, authv1.ErrorInvalidTokenIssuer("token was issued by another issuer")
+		case errors.Is(err, jwtv5.ErrTokenInvalidAudience):
+			return nil, authv1.ErrorInvalidTokenAudience("token is meant for another audience")
+		default:
+			return nil, authv1.ErrorInvalidToken("invalid token")
+		}
+	}
+
+	// Refresh and MFA tokens are signed with the same keys, but only access
+	// tokens grant access.
+	if claims.Type != biz.AccessToken {
+		return nil, authv1.ErrorWrongTokenType("expected an access token, got %q", claims.Type)
+	}
+
+	// Tokens issued to OAuth clients only carry OpenID Connect scopes, which
+	// grant nothing on the API itself.
+	if claims.ClientID != "" {
+		return nil, authv1.ErrorClientTokenNotAllowed("tokens issued to OAuth clients cannot call %s", tr.Operation())
+	}
+
+	return jwt.NewContext(ctx, claims), nil
 }
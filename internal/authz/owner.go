@@ -0,0 +1,45 @@
+package authz
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// isOwner reports whether the request field at path holds the subject's
+// user ID. Paths that do not resolve to a set string field never match.
+func isOwner(req any, path, sub string) bool {
+	if path == "" {
+		return false
+	}
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return false
+	}
+
+	m := msg.ProtoReflect()
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+			return false
+		}
+
+		if i < len(names)-1 {
+			if fd.Kind() != protoreflect.MessageKind {
+				return false
+			}
+			m = m.Get(fd).Message()
+			continue
+		}
+
+		if fd.Kind() != protoreflect.StringKind {
+			return false
+		}
+		id, err := uuid.Parse(m.Get(fd).String())
+		return err == nil && id.String() == sub
+	}
+	return false
+}
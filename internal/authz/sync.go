@@ -1,12 +1,177 @@
 package authz
 
 import (
+	"context"
+	"slices"
+
 	"github.com/casbin/casbin/v3"
 
 	authzv1 "github.com/tencat-dev/go-base/api/authz/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
 )
 
-func SyncFromRegistry(e casbin.IEnforcer, r *AuthzRegistry) error {
+// PolicyDiff is what reconciling changes in the policies.
+type PolicyDiff struct {
+	Added   [][]string
+	Removed [][]string
+}
+
+// SyncFromRegistry adds the annotation policies that are missing and records
+// them as managed. Policies that already exist stay unmanaged, as they may
+// have been granted by hand; Adopt hands them over.
+func SyncFromRegistry(
+	ctx context.Context,
+	e casbin.IEnforcer,
+	r *AuthzRegistry,
+	managed biz.ManagedPolicyRepo,
+) (*PolicyDiff, error) {
+	missing, err := missingPolicies(e, desiredPolicies(r))
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &PolicyDiff{Added: missing}
+	if len(missing) == 0 {
+		return diff, nil
+	}
+
+	if _, err := e.AddPolicies(missing); err != nil {
+		return nil, err
+	}
+	if err := managed.Add(ctx, permissions(missing)); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// Adopt adds the annotation policies that are missing and records all of
+// them as managed, including the ones that already existed. It is meant to
+// be run once, to hand over the policies added before they were recorded;
+// a grant made by hand that matches an annotation is adopted too.
+func Adopt(
+	ctx context.Context,
+	e casbin.IEnforcer,
+	r *AuthzRegistry,
+	managed biz.ManagedPolicyRepo,
+) (*PolicyDiff, error) {
+	desired := desiredPolicies(r)
+
+	diff, err := SyncFromRegistry(ctx, e, r, managed)
+	if err != nil {
+		return nil, err
+	}
+	if err := managed.Add(ctx, permissions(desired)); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// Reconcile makes the annotation policies match the registry. Missing ones
+// are added, and the recorded managed ones that no annotation grants
+// anymore are removed. Only the policies reconcile adds are recorded, so
+// policies granted by hand are left alone even when an annotation grants
+// the same. With dryRun nothing is changed and only the diff is returned.
+//
+// The policy adapter has no transactions, so the changes are not atomic. If
+// one fails, the policy is left with some of them applied; the additions are
+// recorded before anything is removed, so running Reconcile again finishes
+// the job.
+func Reconcile(
+	ctx context.Context,
+	e casbin.IEnforcer,
+	r *AuthzRegistry,
+	managed biz.ManagedPolicyRepo,
+	dryRun bool,
+) (*PolicyDiff, error) {
+	desired := desiredPolicies(r)
+
+	recorded, err := managed.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := missingPolicies(e, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &PolicyDiff{Added: added}
+	// The recorded policies that are still granted stay managed.
+	kept := slices.Clone(added)
+	for _, m := range recorded {
+		p := []string{m.Subject, m.Domain, m.Object, m.Action}
+		if slices.ContainsFunc(desired, func(d []string) bool { return slices.Equal(d, p) }) {
+			if !slices.ContainsFunc(added, func(a []string) bool { return slices.Equal(a, p) }) {
+				kept = append(kept, p)
+			}
+			continue
+		}
+		ok, err := e.HasPolicy(p)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			diff.Removed = append(diff.Removed, p)
+		}
+	}
+
+	if dryRun {
+		return diff, nil
+	}
+
+	if len(diff.Added) > 0 {
+		if _, err := e.AddPolicies(diff.Added); err != nil {
+			return nil, err
+		}
+		if err := managed.Add(ctx, permissions(diff.Added)); err != nil {
+			return nil, err
+		}
+	}
+	if len(diff.Removed) > 0 {
+		if _, err := e.RemovePolicies(diff.Removed); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := managed.Replace(ctx, permissions(kept)); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// missingPolicies returns the policies the enforcer does not have.
+func missingPolicies(e casbin.IEnforcer, policies [][]string) ([][]string, error) {
+	var missing [][]string
+	for _, p := range policies {
+		ok, err := e.HasPolicy(p)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			missing = append(missing, p)
+		}
+	}
+	return missing, nil
+}
+
+func permissions(policies [][]string) []*biz.Permission {
+	permissions := make([]*biz.Permission, 0, len(policies))
+	for _, p := range policies {
+		permissions = append(permissions, &biz.Permission{
+			Subject: p[0],
+			Domain:  p[1],
+			Object:  p[2],
+			Action:  p[3],
+		})
+	}
+	return permissions
+}
+
+// desiredPolicies returns the policies the permission annotations grant.
+func desiredPolicies(r *AuthzRegistry) [][]string {
 	m := r.data.Load().(map[string]*authzv1.PermissionOption)
 
 	// Ước lượng capacity để giảm re-alloc
@@ -24,23 +189,16 @@ func SyncFromRegistry(e casbin.IEnforcer, r *AuthzRegistry) error {
 			}
 			seen[key] = struct{}{}
 
+			// Granted globally, so the role carries the permission in
+			// every tenant it is granted in.
 			policies = append(policies, []string{
 				role,
+				biz.GlobalDomain,
 				perm.Object,
 				perm.Action,
 			})
 		}
 	}
 
-	if len(policies) == 0 {
-		return nil
-	}
-
-	// 🔥 Batch + ignore duplicate DB entries
-	_, err := e.AddPolicies(policies)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return policies
 }
@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+)
+
+const (
+	// APIKeyScheme is the Authorization scheme API keys are sent with.
+	APIKeyScheme = "ApiKey"
+
+	apiKeyPrefix    = "gb_"
+	apiKeyPrefixLen = 8
+	// apiKeyTouchInterval limits how often last_used_at is written.
+	apiKeyTouchInterval = time.Minute
+)
+
+// APIKeyScope is a permission an API key is restricted to.
+type APIKeyScope struct {
+	Object string
+	Action string
+}
+
+// APIKey is a long-lived credential for machine clients. It acts as its
+// owner, narrowed to Scopes when any are set. Only a hash of the key is
+// stored; Prefix is kept in clear so users can tell their keys apart.
+type APIKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []APIKeyScope
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// Allows reports whether the key's scopes cover the permission.
+func (k *APIKey) Allows(object, action string) bool {
+	if len(k.Scopes) == 0 {
+		return true
+	}
+	for _, s := range k.Scopes {
+		if s.Object == object && s.Action == action {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyRepo is a APIKey repo.
+type APIKeyRepo interface {
+	Save(context.Context, *APIKey) (*APIKey, error)
+	FindByHash(context.Context, string) (*APIKey, error)
+	ListByUserID(context.Context, uuid.UUID) ([]*APIKey, error)
+	// Revoke revokes the user's key and reports whether it was still active.
+	Revoke(ctx context.Context, id, userID uuid.UUID) (bool, error)
+	RevokeByUserID(context.Context, uuid.UUID) error
+	Touch(context.Context, uuid.UUID) error
+}
+
+// APIKeyBiz is a APIKey usecase.
+type APIKeyBiz struct {
+	repo APIKeyRepo
+}
+
+// NewAPIKeyBiz new a APIKey usecase.
+func NewAPIKeyBiz(repo APIKeyRepo) *APIKeyBiz {
+	return &APIKeyBiz{repo: repo}
+}
+
+// Create issues a new key for the user and returns it along with the raw
+// key, which is not stored and cannot be shown again.
+func (b *APIKeyBiz) Create(ctx context.Context, k *APIKey) (*APIKey, string, error) {
+	prefix := apiKeyPrefix + strings.ToLower(rand.Text()[:apiKeyPrefixLen])
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	key := prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+
+	k.Prefix = prefix
+	k.KeyHash = hashUserToken(key)
+	k.Scopes = uniqueScopes(k.Scopes)
+
+	created, err := b.repo.Save(ctx, k)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return created, key, nil
+}
+
+// uniqueScopes drops repeated scopes, keeping the first of each.
+func uniqueScopes(scopes []APIKeyScope) []APIKeyScope {
+	seen := make(map[APIKeyScope]bool, len(scopes))
+	out := make([]APIKeyScope, 0, len(scopes))
+	for _, s := range scopes {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// List returns the user's active keys.
+func (b *APIKeyBiz) List(ctx context.Context, userID uuid.UUID) ([]*APIKey, error) {
+	return b.repo.ListByUserID(ctx, userID)
+}
+
+// Revoke revokes one of the user's keys.
+func (b *APIKeyBiz) Revoke(ctx context.Context, userID, id uuid.UUID) error {
+	ok, err := b.repo.Revoke(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return authv1.ErrorApiKeyNotFound("api key not found")
+	}
+	return nil
+}
+
+// Authenticate returns the active key matching the raw key.
+func (b *APIKeyBiz) Authenticate(ctx context.Context, key string) (*APIKey, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, authv1.ErrorInvalidApiKey("invalid api key")
+	}
+
+	k, err := b.repo.FindByHash(ctx, hashUserToken(key))
+	if errors.Is(err, ErrNotFound) {
+		return nil, authv1.ErrorInvalidApiKey("invalid api key")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if k.RevokedAt != nil || (k.ExpiresAt != nil && now.After(*k.ExpiresAt)) {
+		return nil, authv1.ErrorInvalidApiKey("invalid api key")
+	}
+
+	if k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) > apiKeyTouchInterval {
+		if err := b.repo.Touch(ctx, k.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return k, nil
+}
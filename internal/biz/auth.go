@@ -2,23 +2,30 @@ package biz
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
-	"github.com/matthewhartstonge/argon2"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	authzv1 "github.com/tencat-dev/go-base/api/authz/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
 )
 
 type AuthLogin struct {
 	Email    string `json:"email,omitempty"`
 	Password string `json:"password,omitempty"`
+	IP       string `json:"ip,omitempty"`
 }
 
 // Auth is a Auth model.
 type Auth struct {
-	ID           uuid.UUID `json:"id,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	Email        string    `json:"email,omitempty"`
-	PasswordHash string    `json:"password_hash,omitempty"`
+	ID              uuid.UUID  `json:"id,omitempty"`
+	Name            string     `json:"name,omitempty"`
+	Email           string     `json:"email,omitempty"`
+	PasswordHash    string     `json:"password_hash,omitempty"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
 }
 
 // AuthRepo is a Greater repo.
@@ -28,33 +35,267 @@ type AuthRepo interface {
 
 // AuthBiz is a Auth usecase.
 type AuthBiz struct {
-	repo  AuthRepo
-	authz PermissionChecker
+	repo        AuthRepo
+	userRepo    UserRepo
+	authz       PermissionChecker
+	tokenMaker  TokenMaker
+	refreshRepo RefreshTokenRepo
+	sessionRepo SessionRepo
+	oauthRepo   OAuthRepo
+	tenantRepo  TenantRepo
+	throttle    *loginThrottler
+	hasher      *PasswordHasher
+	log         *log.Helper
+
+	requireVerifiedEmail bool
+	impersonationTTL     time.Duration
 }
 
 // NewAuthBiz new a Auth usecase.
-func NewAuthBiz(repo AuthRepo, authz PermissionChecker) *AuthBiz {
+func NewAuthBiz(
+	repo AuthRepo,
+	userRepo UserRepo,
+	authz PermissionChecker,
+	tokenMaker TokenMaker,
+	refreshRepo RefreshTokenRepo,
+	sessionRepo SessionRepo,
+	oauthRepo OAuthRepo,
+	tenantRepo TenantRepo,
+	throttleRepo LoginThrottleRepo,
+	hasher *PasswordHasher,
+	c *conf.Auth,
+	logger *log.Helper,
+) *AuthBiz {
 	return &AuthBiz{
-		repo:  repo,
-		authz: authz,
+		repo:        repo,
+		userRepo:    userRepo,
+		authz:       authz,
+		tokenMaker:  tokenMaker,
+		refreshRepo: refreshRepo,
+		sessionRepo: sessionRepo,
+		oauthRepo:   oauthRepo,
+		tenantRepo:  tenantRepo,
+		throttle:    newLoginThrottler(throttleRepo, c.GetLoginThrottle()),
+		hasher:      hasher,
+		log:         logger,
+
+		requireVerifiedEmail: c.GetRequireVerifiedEmail(),
+		impersonationTTL:     durationOrDefault(c.GetImpersonation().GetTokenTtl().AsDuration(), defaultImpersonationTTL),
 	}
 }
 
 // Login creates a Auth, and returns the new Auth.
 func (b *AuthBiz) Login(ctx context.Context, u *AuthLogin) (*Auth, error) {
+	if err := b.throttle.check(ctx, u.Email, u.IP); err != nil {
+		return nil, err
+	}
+
 	user, err := b.repo.FindByEmail(ctx, u.Email)
-	if err != nil {
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		return nil, err
 	}
 
-	ok, err := argon2.VerifyEncoded([]byte(u.Password), []byte(user.PasswordHash))
+	// Unknown emails are verified against a dummy hash so both cases take
+	// the same time and return the same error.
+	hash := b.hasher.dummyHash()
+	if user != nil {
+		hash = user.PasswordHash
+	}
+
+	// A hash that cannot be verified fails the login like a wrong password.
+	ok, err := b.hasher.Verify(u.Password, hash)
 	if err != nil {
+		b.log.WithContext(ctx).Errorf("verify password of %s: %v", u.Email, err)
+		ok = false
+	}
+
+	if !ok || user == nil {
+		if err := b.throttle.fail(ctx, u.Email, u.IP); err != nil {
+			return nil, err
+		}
+		return nil, authv1.ErrorInvalidCredentials("invalid email or password")
+	}
+
+	if err := b.throttle.reset(ctx, u.Email); err != nil {
 		return nil, err
 	}
 
-	if !ok {
-		return nil, fmt.Errorf("invalid password")
+	if b.requireVerifiedEmail && user.EmailVerifiedAt == nil {
+		return nil, authv1.ErrorEmailNotVerified("email address has not been verified")
+	}
+
+	if b.hasher.NeedsRehash(user.PasswordHash) {
+		b.rehash(ctx, user, u.Password)
 	}
 
 	return user, nil
 }
+
+// rehash upgrades the stored hash to the current parameters. Failures are
+// only logged since the login itself succeeded.
+func (b *AuthBiz) rehash(ctx context.Context, user *Auth, password string) {
+	hash, err := b.hasher.Hash(password)
+	if err == nil {
+		err = b.userRepo.UpdatePassword(ctx, user.ID, hash)
+	}
+	if err != nil {
+		b.log.WithContext(ctx).Errorf("rehash password of user %s: %v", user.ID, err)
+		return
+	}
+	user.PasswordHash = hash
+}
+
+// IssueTokens creates an access/refresh token pair for the session and
+// records the refresh token so it can be rotated later.
+func (b *AuthBiz) IssueTokens(ctx context.Context, userID, sessionID uuid.UUID) (*TokenPair, error) {
+	return issueTokens(ctx, b.tokenMaker, b.refreshRepo, AccessPayload{
+		UserID:    userID,
+		SessionID: sessionID,
+	})
+}
+
+// RefreshToken exchanges a refresh token for a new token pair. The presented
+// token is rotated; presenting it again revokes its whole token family.
+// Tokens issued to OAuth clients are refreshed at the token endpoint, which
+// keeps them bound to the client and its scopes.
+func (b *AuthBiz) RefreshToken(ctx context.Context, token string) (*TokenPair, error) {
+	payload, err := b.tokenMaker.ParseRefreshToken(token)
+	if err != nil {
+		return nil, authv1.ErrorInvalidToken("invalid refresh token")
+	}
+
+	_, err = b.oauthRepo.FindGrantBySessionID(ctx, payload.SessionID)
+	if err == nil {
+		return nil, authv1.ErrorInvalidToken("refresh token was issued to an OAuth client")
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	record, err := rotateRefreshToken(ctx, b.tokenMaker, b.refreshRepo, b.sessionRepo, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return issueTokens(ctx, b.tokenMaker, b.refreshRepo, AccessPayload{
+		UserID:    record.UserID,
+		SessionID: record.SessionID,
+		Tenant:    payload.Tenant,
+	})
+}
+
+// SwitchTenant issues a new token pair for the session, bound to the tenant
+// or unbound if tenant is empty. The user must have a role in the tenant,
+// or a global one.
+func (b *AuthBiz) SwitchTenant(ctx context.Context, userID, sessionID uuid.UUID, tenant string) (*TokenPair, error) {
+	if tenant != "" {
+		t, err := findTenant(ctx, b.tenantRepo, tenant)
+		if err != nil {
+			return nil, err
+		}
+		tenant = t.ID.String()
+
+		member := false
+		for _, dom := range []string{tenant, GlobalDomain} {
+			roles, err := b.authz.RolesFor(userID.String(), dom)
+			if err != nil {
+				return nil, err
+			}
+			member = member || len(roles) > 0
+		}
+		if !member {
+			return nil, authzv1.ErrorInvalidTenant("user has no role in the tenant")
+		}
+	}
+
+	return issueTokens(ctx, b.tokenMaker, b.refreshRepo, AccessPayload{
+		UserID:    userID,
+		SessionID: sessionID,
+		Tenant:    tenant,
+	})
+}
+
+func issueTokens(ctx context.Context, tokenMaker TokenMaker, refreshRepo RefreshTokenRepo, access AccessPayload) (*TokenPair, error) {
+	access.TTL = AccessTokenTTL
+	accessToken, err := tokenMaker.CreateAccessToken(access)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshID := uuid.Must(uuid.NewV7())
+	refreshToken, err := tokenMaker.CreateRefreshToken(RefreshPayload{
+		ID:        refreshID,
+		UserID:    access.UserID,
+		SessionID: access.SessionID,
+		Tenant:    access.Tenant,
+		TTL:       RefreshTokenTTL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = refreshRepo.Save(ctx, &RefreshTokenRecord{
+		ID:        refreshID,
+		SessionID: access.SessionID,
+		UserID:    access.UserID,
+		ExpiresAt: time.Now().UTC().Add(RefreshTokenTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// rotateRefreshToken marks the refresh token as used and returns its record.
+// Replaying a token that was already exchanged revokes its whole session.
+func rotateRefreshToken(
+	ctx context.Context,
+	tokenMaker TokenMaker,
+	refreshRepo RefreshTokenRepo,
+	sessionRepo SessionRepo,
+	token string,
+) (*RefreshTokenRecord, error) {
+	payload, err := tokenMaker.ParseRefreshToken(token)
+	if err != nil {
+		return nil, authv1.ErrorInvalidToken("invalid refresh token")
+	}
+
+	record, err := refreshRepo.FindByID(ctx, payload.ID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, authv1.ErrorInvalidToken("invalid refresh token")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if record.RevokedAt != nil {
+		return nil, authv1.ErrorInvalidToken("refresh token has been revoked")
+	}
+
+	rotated, err := refreshRepo.Rotate(ctx, record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rotated {
+		// The token was already exchanged once, so someone is replaying it.
+		// The access tokens of the session may be stolen too, so end it.
+		if err := sessionRepo.Revoke(ctx, record.SessionID); err != nil {
+			return nil, err
+		}
+		if err := refreshRepo.RevokeBySessionID(ctx, record.SessionID); err != nil {
+			return nil, err
+		}
+		return nil, authv1.ErrorTokenReused("refresh token reuse detected")
+	}
+
+	if err := sessionRepo.Touch(ctx, record.SessionID); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
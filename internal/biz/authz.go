@@ -1,27 +1,220 @@
 package biz
 
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	authzv1 "github.com/tencat-dev/go-base/api/authz/v1"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// Page is a request for part of a list. Token is the NextToken of the
+// previous page, or empty for the first one.
+type Page struct {
+	Size  int32
+	Token string
+}
+
 // AuthzBiz is a Auth usecase.
 type AuthzBiz struct {
-	pm PermissionManager
+	pm         PermissionManager
+	reader     PermissionReader
+	tenantRepo TenantRepo
 }
 
 // NewAuthzBiz new a Auth usecase.
-func NewAuthzBiz(pm PermissionManager) *AuthzBiz {
+func NewAuthzBiz(pm PermissionManager, reader PermissionReader, tenantRepo TenantRepo) *AuthzBiz {
 	return &AuthzBiz{
-		pm: pm,
+		pm:         pm,
+		reader:     reader,
+		tenantRepo: tenantRepo,
 	}
 }
 
-func (b *AuthzBiz) GrantRole(userID, role string) error {
-	return b.pm.GrantRole(userID, role)
+func (b *AuthzBiz) GrantRole(ctx context.Context, userID, role, tenant string) error {
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	return b.pm.GrantRole(userID, role, dom)
 }
 
-func (b *AuthzBiz) RevokeRole(userID, role string) error {
-	return b.pm.RevokeRole(userID, role)
+func (b *AuthzBiz) RevokeRole(ctx context.Context, userID, role, tenant string) error {
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	return b.pm.RevokeRole(userID, role, dom)
 }
 
 func (b *AuthzBiz) GrantPermission(
-	subject, object, action string,
+	ctx context.Context,
+	subject, object, action, tenant string,
 ) error {
-	return b.pm.GrantPermission(subject, object, action)
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	return b.pm.GrantPermission(subject, dom, object, action)
+}
+
+func (b *AuthzBiz) RevokePermission(
+	ctx context.Context,
+	subject, object, action, tenant string,
+) error {
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	return b.pm.RevokePermission(subject, dom, object, action)
+}
+
+// ListRoles returns the roles known in the tenant, sorted by name.
+func (b *AuthzBiz) ListRoles(ctx context.Context, tenant string, page Page) ([]string, string, error) {
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return nil, "", err
+	}
+
+	roles, err := b.reader.Roles(dom)
+	if err != nil {
+		return nil, "", err
+	}
+	slices.Sort(roles)
+	return paginate(roles, page)
+}
+
+// RolesForUser returns the roles granted to the user in the tenant, sorted
+// by name.
+func (b *AuthzBiz) RolesForUser(ctx context.Context, userID, tenant string, page Page) ([]string, string, error) {
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return nil, "", err
+	}
+
+	roles, err := b.reader.RolesForUser(userID, dom)
+	if err != nil {
+		return nil, "", err
+	}
+	slices.Sort(roles)
+	return paginate(roles, page)
+}
+
+// UsersForRole returns the users the role is granted to in the tenant,
+// sorted by ID.
+func (b *AuthzBiz) UsersForRole(ctx context.Context, role, tenant string, page Page) ([]string, string, error) {
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users, err := b.reader.UsersForRole(role, dom)
+	if err != nil {
+		return nil, "", err
+	}
+	slices.Sort(users)
+	return paginate(users, page)
+}
+
+// PermissionsForRole returns the permissions the role has in the tenant.
+func (b *AuthzBiz) PermissionsForRole(ctx context.Context, role, tenant string, page Page) ([]*Permission, string, error) {
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return nil, "", err
+	}
+
+	permissions, err := b.reader.PermissionsForRole(role, dom)
+	if err != nil {
+		return nil, "", err
+	}
+	sortPermissions(permissions)
+	return paginate(permissions, page)
+}
+
+// ImplicitPermissionsForUser returns the permissions the user has in the
+// tenant, including those of their roles.
+func (b *AuthzBiz) ImplicitPermissionsForUser(ctx context.Context, userID, tenant string, page Page) ([]*Permission, string, error) {
+	dom, err := b.domain(ctx, tenant)
+	if err != nil {
+		return nil, "", err
+	}
+
+	permissions, err := b.reader.ImplicitPermissionsForUser(userID, dom)
+	if err != nil {
+		return nil, "", err
+	}
+	sortPermissions(permissions)
+	return paginate(permissions, page)
+}
+
+// CreateTenant creates a tenant. Nobody has a role in it until one is
+// granted.
+func (b *AuthzBiz) CreateTenant(ctx context.Context, name string) (*Tenant, error) {
+	return b.tenantRepo.Save(ctx, &Tenant{Name: name})
+}
+
+func (b *AuthzBiz) GetTenant(ctx context.Context, tenant string) (*Tenant, error) {
+	return findTenant(ctx, b.tenantRepo, tenant)
+}
+
+func (b *AuthzBiz) ListTenants(ctx context.Context) ([]*Tenant, error) {
+	return b.tenantRepo.List(ctx)
+}
+
+// domain returns the Casbin domain of the tenant, or the global domain when
+// no tenant is given.
+func (b *AuthzBiz) domain(ctx context.Context, tenant string) (string, error) {
+	if tenant == "" {
+		return GlobalDomain, nil
+	}
+
+	t, err := findTenant(ctx, b.tenantRepo, tenant)
+	if err != nil {
+		return "", err
+	}
+
+	return t.ID.String(), nil
+}
+
+func sortPermissions(permissions []*Permission) {
+	slices.SortFunc(permissions, func(a, b *Permission) int {
+		return cmp.Or(
+			cmp.Compare(a.Subject, b.Subject),
+			cmp.Compare(a.Domain, b.Domain),
+			cmp.Compare(a.Object, b.Object),
+			cmp.Compare(a.Action, b.Action),
+		)
+	})
+}
+
+// paginate returns the page of items and the token of the next page, which
+// is empty on the last page. Tokens are offsets into the sorted items.
+func paginate[T any](items []T, page Page) ([]T, string, error) {
+	size := int(page.Size)
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	size = min(size, maxPageSize)
+
+	offset := 0
+	if page.Token != "" {
+		n, err := strconv.Atoi(page.Token)
+		if err != nil || n < 0 {
+			return nil, "", authzv1.ErrorInvalidPageToken("invalid page token")
+		}
+		offset = min(n, len(items))
+	}
+
+	end := min(offset+size, len(items))
+	next := ""
+	if end < len(items) {
+		next = strconv.Itoa(end)
+	}
+	return items[offset:end], next, nil
 }
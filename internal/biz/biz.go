@@ -1,10 +1,29 @@
 package biz
 
-import "github.com/goforj/wire"
+import (
+	"errors"
+
+	"github.com/goforj/wire"
+)
 
 // ProviderSetBiz is biz providers.
 var ProviderSetBiz = wire.NewSet(
 	NewUserBiz,
 	NewAuthBiz,
 	NewAuthzBiz,
+	NewSessionBiz,
+	NewMFABiz,
+	NewPasswordBiz,
+	NewEmailVerificationBiz,
+	NewAPIKeyBiz,
+	NewOAuthBiz,
+	NewFederationBiz,
+	NewIntrospectionBiz,
+	NewPasskeyBiz,
+	NewMagicLinkBiz,
+	NewPasswordHasher,
+	NewPasswordPolicy,
 )
+
+// ErrNotFound is returned by repos when the requested record does not exist.
+var ErrNotFound = errors.New("record not found")
@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const EmailVerificationTTL = 24 * time.Hour
+
+// EmailVerificationBiz is an email verification usecase.
+type EmailVerificationBiz struct {
+	authRepo  AuthRepo
+	userRepo  UserRepo
+	tokenRepo UserTokenRepo
+	throttle  *mailThrottler
+	mailer    Mailer
+	appURL    string
+	log       *log.Helper
+}
+
+// NewEmailVerificationBiz new an email verification usecase.
+func NewEmailVerificationBiz(
+	authRepo AuthRepo,
+	userRepo UserRepo,
+	tokenRepo UserTokenRepo,
+	throttleRepo LoginThrottleRepo,
+	mailer Mailer,
+	c *conf.Auth,
+	logger *log.Helper,
+) *EmailVerificationBiz {
+	return &EmailVerificationBiz{
+		authRepo:  authRepo,
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		throttle:  newMailThrottler(throttleRepo, c.GetMailThrottle()),
+		mailer:    mailer,
+		appURL:    c.GetAppUrl(),
+		log:       logger,
+	}
+}
+
+// SendVerification mails a verification link to the user's email address.
+// Mail delivery failures are only logged; the user can ask for a new link.
+func (b *EmailVerificationBiz) SendVerification(ctx context.Context, user *User) error {
+	token, err := issueEmailToken(ctx, b.tokenRepo, user.ID, user.Email, PurposeEmailVerification, EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	err = b.mailer.Send(ctx, &Mail{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address using the link below. It expires in %s.\n\n%s\n",
+			user.Name, EmailVerificationTTL, link(b.appURL, "/verify-email", token),
+		),
+	})
+	if err != nil {
+		b.log.WithContext(ctx).Errorf("send verification mail: %v", err)
+	}
+
+	return nil
+}
+
+// VerifyEmail marks the email address behind the token as verified. The
+// token is rejected if the user has changed their email since it was sent.
+func (b *EmailVerificationBiz) VerifyEmail(ctx context.Context, token string) error {
+	t, err := consumeUserToken(ctx, b.tokenRepo, token, PurposeEmailVerification)
+	if err != nil {
+		return err
+	}
+
+	user, err := b.userRepo.FindByID(ctx, t.UserID)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(user.Email, t.Email) {
+		return authv1.ErrorInvalidOneTimeToken("invalid or expired token")
+	}
+
+	return b.userRepo.MarkEmailVerified(ctx, user.ID, user.Email)
+}
+
+// ResendVerification sends a new link if the email belongs to an unverified
+// user. It reports success either way so callers cannot probe for emails,
+// and looks the user up in the background so the response time does not
+// tell them apart either.
+func (b *EmailVerificationBiz) ResendVerification(ctx context.Context, email, ip string) error {
+	if err := b.throttle.allow(ctx, "verification", email, ip); err != nil {
+		return err
+	}
+
+	mailLater(ctx, b.log, func(ctx context.Context) error {
+		return b.resendVerification(ctx, email)
+	})
+	return nil
+}
+
+func (b *EmailVerificationBiz) resendVerification(ctx context.Context, email string) error {
+	auth, err := b.authRepo.FindByEmail(ctx, email)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if auth.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	return b.SendVerification(ctx, &User{
+		ID:    auth.ID,
+		Name:  auth.Name,
+		Email: auth.Email,
+	})
+}
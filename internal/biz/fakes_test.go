@@ -0,0 +1,280 @@
+package biz_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+// The fakes below keep their records in memory. They embed the repo
+// interface, so calling a method a test does not expect panics.
+
+type fakeUserRepo struct {
+	biz.UserRepo
+
+	mu    sync.Mutex
+	users map[uuid.UUID]*biz.User
+}
+
+func newFakeUserRepo(users ...*biz.User) *fakeUserRepo {
+	r := &fakeUserRepo{users: make(map[uuid.UUID]*biz.User)}
+	for _, u := range users {
+		r.users[u.ID] = u
+	}
+	return r
+}
+
+func (r *fakeUserRepo) Save(_ context.Context, u *biz.User) (*biz.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.save(u), nil
+}
+
+func (r *fakeUserRepo) save(u *biz.User) *biz.User {
+	saved := *u
+	saved.ID = uuid.Must(uuid.NewV7())
+	saved.CreatedAt = time.Now().UTC()
+	saved.UpdatedAt = saved.CreatedAt
+	r.users[saved.ID] = &saved
+	return &saved
+}
+
+func (r *fakeUserRepo) FindByID(_ context.Context, id uuid.UUID) (*biz.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return nil, biz.ErrNotFound
+	}
+	return u, nil
+}
+
+// FindByEmail implements biz.AuthRepo.
+func (r *fakeUserRepo) FindByEmail(_ context.Context, email string) (*biz.Auth, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range r.users {
+		if strings.EqualFold(u.Email, email) {
+			return &biz.Auth{
+				ID:              u.ID,
+				Name:            u.Name,
+				Email:           u.Email,
+				PasswordHash:    u.PasswordHash,
+				EmailVerifiedAt: u.EmailVerifiedAt,
+			}, nil
+		}
+	}
+	return nil, biz.ErrNotFound
+}
+
+type fakeUserTokenRepo struct {
+	biz.UserTokenRepo
+
+	mu     sync.Mutex
+	tokens []*biz.UserToken
+}
+
+func (r *fakeUserTokenRepo) Save(_ context.Context, t *biz.UserToken) (*biz.UserToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *t
+	saved.ID = uuid.Must(uuid.NewV7())
+	saved.CreatedAt = time.Now().UTC()
+	r.tokens = append(r.tokens, &saved)
+	return &saved, nil
+}
+
+func (r *fakeUserTokenRepo) FindByHash(_ context.Context, hash string) (*biz.UserToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range r.tokens {
+		if t.TokenHash == hash {
+			found := *t
+			return &found, nil
+		}
+	}
+	return nil, biz.ErrNotFound
+}
+
+func (r *fakeUserTokenRepo) Consume(_ context.Context, id uuid.UUID) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range r.tokens {
+		if t.ID == id && t.UsedAt == nil {
+			now := time.Now().UTC()
+			t.UsedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeUserTokenRepo) DeleteByUserID(_ context.Context, userID uuid.UUID, purpose biz.TokenPurpose) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.tokens[:0]
+	for _, t := range r.tokens {
+		if t.UserID != userID || t.Purpose != purpose {
+			kept = append(kept, t)
+		}
+	}
+	r.tokens = kept
+	return nil
+}
+
+type fakeExternalIdentityRepo struct {
+	users *fakeUserRepo
+
+	mu         sync.Mutex
+	identities []*biz.ExternalIdentity
+}
+
+func (r *fakeExternalIdentityRepo) FindBySubject(_ context.Context, provider, subject string) (*biz.ExternalIdentity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, i := range r.identities {
+		if i.Provider == provider && i.Subject == subject {
+			return i, nil
+		}
+	}
+	return nil, biz.ErrNotFound
+}
+
+func (r *fakeExternalIdentityRepo) Save(_ context.Context, i *biz.ExternalIdentity) (*biz.ExternalIdentity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.save(i), nil
+}
+
+func (r *fakeExternalIdentityRepo) SaveWithUser(_ context.Context, u *biz.User, i *biz.ExternalIdentity) (*biz.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.users.mu.Lock()
+	defer r.users.mu.Unlock()
+
+	user := r.users.save(u)
+	i.UserID = user.ID
+	r.save(i)
+	return user, nil
+}
+
+func (r *fakeExternalIdentityRepo) save(i *biz.ExternalIdentity) *biz.ExternalIdentity {
+	saved := *i
+	saved.ID = uuid.Must(uuid.NewV7())
+	saved.CreatedAt = time.Now().UTC()
+	r.identities = append(r.identities, &saved)
+	return &saved
+}
+
+func (r *fakeExternalIdentityRepo) Touch(_ context.Context, id uuid.UUID, email string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, i := range r.identities {
+		if i.ID == id {
+			now := time.Now().UTC()
+			i.Email, i.LastLoginAt = email, &now
+			return nil
+		}
+	}
+	return biz.ErrNotFound
+}
+
+type fakePasskeyRepo struct {
+	biz.PasskeyRepo
+
+	mu       sync.Mutex
+	passkeys []*biz.Passkey
+	sessions map[uuid.UUID]*biz.WebAuthnSession
+}
+
+func newFakePasskeyRepo() *fakePasskeyRepo {
+	return &fakePasskeyRepo{sessions: make(map[uuid.UUID]*biz.WebAuthnSession)}
+}
+
+func (r *fakePasskeyRepo) Save(_ context.Context, p *biz.Passkey) (*biz.Passkey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *p
+	saved.ID = uuid.Must(uuid.NewV7())
+	saved.CreatedAt = time.Now().UTC()
+	r.passkeys = append(r.passkeys, &saved)
+	return &saved, nil
+}
+
+func (r *fakePasskeyRepo) ListByUserID(_ context.Context, userID uuid.UUID) ([]*biz.Passkey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var list []*biz.Passkey
+	for _, p := range r.passkeys {
+		if p.UserID == userID {
+			list = append(list, p)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakePasskeyRepo) FindByCredentialID(_ context.Context, credentialID []byte) (*biz.Passkey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range r.passkeys {
+		if bytes.Equal(p.CredentialID, credentialID) {
+			return p, nil
+		}
+	}
+	return nil, biz.ErrNotFound
+}
+
+func (r *fakePasskeyRepo) RecordUse(_ context.Context, id uuid.UUID, signCount uint32, backupState bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range r.passkeys {
+		if p.ID == id {
+			now := time.Now().UTC()
+			p.SignCount, p.BackupState, p.LastUsedAt = signCount, backupState, &now
+			return nil
+		}
+	}
+	return biz.ErrNotFound
+}
+
+func (r *fakePasskeyRepo) SaveSession(_ context.Context, s *biz.WebAuthnSession) (*biz.WebAuthnSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *s
+	saved.ID = uuid.Must(uuid.NewV7())
+	r.sessions[saved.ID] = &saved
+	return &saved, nil
+}
+
+func (r *fakePasskeyRepo) ConsumeSession(_ context.Context, id uuid.UUID, ceremony biz.WebAuthnCeremony) (*biz.WebAuthnSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.sessions[id]
+	if !ok || s.Ceremony != ceremony || !s.ExpiresAt.After(time.Now()) {
+		return nil, biz.ErrNotFound
+	}
+	delete(r.sessions, id)
+	return s, nil
+}
@@ -0,0 +1,302 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+// FederatedLoginTTL is how long the web app has to exchange the code it
+// receives after a federated login.
+const FederatedLoginTTL = time.Minute
+
+// ExternalIdentity links an account at an upstream identity provider to a
+// local user.
+type ExternalIdentity struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Provider    string
+	Subject     string
+	Email       string
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
+}
+
+// ExternalClaims are the claims of a verified upstream ID token.
+type ExternalClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// FederationState is kept by the browser between the start of a federated
+// login and the provider's callback.
+type FederationState struct {
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+// IdentityProvider is an upstream OpenID Connect provider.
+type IdentityProvider interface {
+	Config() *conf.OIDCProvider
+	// AuthCodeURL returns the provider URL that starts a login.
+	AuthCodeURL(ctx context.Context, redirectURL string, s *FederationState) (string, error)
+	// Exchange redeems an authorization code and verifies the ID token
+	// issued with it.
+	Exchange(ctx context.Context, redirectURL, code string, s *FederationState) (*ExternalClaims, error)
+}
+
+// ExternalIdentityRepo is a ExternalIdentity repo.
+type ExternalIdentityRepo interface {
+	FindBySubject(ctx context.Context, provider, subject string) (*ExternalIdentity, error)
+	Save(context.Context, *ExternalIdentity) (*ExternalIdentity, error)
+	// SaveWithUser creates the user and links the identity to it.
+	SaveWithUser(context.Context, *User, *ExternalIdentity) (*User, error)
+	// Touch records a login and the email the provider currently reports.
+	Touch(ctx context.Context, id uuid.UUID, email string) error
+}
+
+// FederationBiz is a federated login usecase.
+type FederationBiz struct {
+	providers []IdentityProvider
+	repo      ExternalIdentityRepo
+	authRepo  AuthRepo
+	userRepo  UserRepo
+	tokenRepo UserTokenRepo
+	hasher    *PasswordHasher
+	log       *log.Helper
+
+	baseURL              string
+	returnURL            string
+	requireVerifiedEmail bool
+}
+
+// NewFederationBiz new a federated login usecase.
+func NewFederationBiz(
+	providers []IdentityProvider,
+	repo ExternalIdentityRepo,
+	authRepo AuthRepo,
+	userRepo UserRepo,
+	tokenRepo UserTokenRepo,
+	hasher *PasswordHasher,
+	c *conf.Auth,
+	logger *log.Helper,
+) *FederationBiz {
+	baseURL := c.GetFederation().GetBaseUrl()
+	if baseURL == "" {
+		baseURL = c.GetOauth().GetIssuer()
+	}
+	returnURL := c.GetFederation().GetReturnUrl()
+	if returnURL == "" {
+		returnURL = strings.TrimRight(c.GetAppUrl(), "/") + "/auth/callback"
+	}
+
+	return &FederationBiz{
+		providers: providers,
+		repo:      repo,
+		authRepo:  authRepo,
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		hasher:    hasher,
+		log:       logger,
+
+		baseURL:              strings.TrimRight(baseURL, "/"),
+		returnURL:            returnURL,
+		requireVerifiedEmail: c.GetRequireVerifiedEmail(),
+	}
+}
+
+// Providers returns the configured identity providers.
+func (b *FederationBiz) Providers() []IdentityProvider {
+	return b.providers
+}
+
+// LoginURL is where the browser is sent to sign in with the provider.
+func (b *FederationBiz) LoginURL(providerID string) string {
+	return b.baseURL + "/auth/oidc/" + providerID + "/begin"
+}
+
+// Secure reports whether the server is served over HTTPS.
+func (b *FederationBiz) Secure() bool {
+	return strings.HasPrefix(b.baseURL, "https://")
+}
+
+// ReturnURL is the web app page the browser is sent back to with either a
+// login code or an error reason.
+func (b *FederationBiz) ReturnURL(code, reason string) string {
+	return redirectURI(b.returnURL, url.Values{
+		"code":  {code},
+		"error": {reason},
+	})
+}
+
+// Begin starts a login at the provider. The returned state must be kept by
+// the browser and passed back to Callback.
+func (b *FederationBiz) Begin(ctx context.Context, providerID string) (*FederationState, string, error) {
+	p, err := b.provider(providerID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s := &FederationState{
+		State:    randomToken(),
+		Nonce:    randomToken(),
+		Verifier: randomToken(),
+	}
+
+	authURL, err := p.AuthCodeURL(ctx, b.callbackURL(providerID), s)
+	if err != nil {
+		b.log.WithContext(ctx).Errorf("federation: start login with %s: %v", providerID, err)
+		return nil, "", authv1.ErrorFederatedLoginFailed("identity provider is unavailable")
+	}
+
+	return s, authURL, nil
+}
+
+// Callback completes a login at the provider, resolves the local user and
+// returns a short-lived code the web app exchanges for tokens.
+func (b *FederationBiz) Callback(ctx context.Context, providerID, code, state string, s *FederationState) (string, error) {
+	p, err := b.provider(providerID)
+	if err != nil {
+		return "", err
+	}
+
+	if s == nil || subtle.ConstantTimeCompare([]byte(state), []byte(s.State)) != 1 {
+		return "", authv1.ErrorFederatedLoginFailed("invalid state")
+	}
+
+	claims, err := p.Exchange(ctx, b.callbackURL(providerID), code, s)
+	if err != nil {
+		b.log.WithContext(ctx).Warnf("federation: login with %s: %v", providerID, err)
+		return "", authv1.ErrorFederatedLoginFailed("identity provider rejected the login")
+	}
+
+	userID, err := b.resolve(ctx, p.Config(), claims)
+	if err != nil {
+		return "", err
+	}
+
+	return issueUserToken(ctx, b.tokenRepo, userID, PurposeFederatedLogin, FederatedLoginTTL)
+}
+
+// CompleteLogin exchanges the code returned by Callback for the user it
+// was issued to.
+func (b *FederationBiz) CompleteLogin(ctx context.Context, code string) (*Auth, error) {
+	t, err := consumeUserToken(ctx, b.tokenRepo, code, PurposeFederatedLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := b.userRepo.FindByID(ctx, t.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.requireVerifiedEmail && user.EmailVerifiedAt == nil {
+		return nil, authv1.ErrorEmailNotVerified("email address has not been verified")
+	}
+
+	return &Auth{
+		ID:              user.ID,
+		Name:            user.Name,
+		Email:           user.Email,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+	}, nil
+}
+
+// resolve returns the local user the identity is linked to, linking or
+// provisioning one if the provider allows it.
+func (b *FederationBiz) resolve(ctx context.Context, c *conf.OIDCProvider, claims *ExternalClaims) (uuid.UUID, error) {
+	identity, err := b.repo.FindBySubject(ctx, c.GetId(), claims.Subject)
+	if err == nil {
+		if err := b.repo.Touch(ctx, identity.ID, claims.Email); err != nil {
+			return uuid.Nil, err
+		}
+		return identity.UserID, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return uuid.Nil, err
+	}
+
+	identity = &ExternalIdentity{
+		Provider: c.GetId(),
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+	}
+
+	var existing *Auth
+	if claims.Email != "" {
+		existing, err = b.authRepo.FindByEmail(ctx, claims.Email)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return uuid.Nil, err
+		}
+	}
+
+	switch {
+	case existing != nil && c.GetLinkByEmail() && claims.EmailVerified:
+		identity.UserID = existing.ID
+		if _, err := b.repo.Save(ctx, identity); err != nil {
+			return uuid.Nil, err
+		}
+		return existing.ID, nil
+	case existing == nil && c.GetAutoProvision() && claims.Email != "":
+		user, err := b.provision(ctx, claims, identity)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return user.ID, nil
+	default:
+		return uuid.Nil, authv1.ErrorAccountNotLinked("no account is linked to this identity")
+	}
+}
+
+// provision creates a user for the identity. The user gets a random
+// password, which they can replace through a password reset.
+func (b *FederationBiz) provision(ctx context.Context, claims *ExternalClaims, identity *ExternalIdentity) (*User, error) {
+	hash, err := b.hasher.Hash(rand.Text())
+	if err != nil {
+		return nil, err
+	}
+
+	name := claims.Name
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	user := &User{
+		Name:         name,
+		Email:        claims.Email,
+		PasswordHash: hash,
+	}
+	if claims.EmailVerified {
+		now := time.Now().UTC()
+		user.EmailVerifiedAt = &now
+	}
+
+	return b.repo.SaveWithUser(ctx, user, identity)
+}
+
+func (b *FederationBiz) provider(id string) (IdentityProvider, error) {
+	for _, p := range b.providers {
+		if p.Config().GetId() == id {
+			return p, nil
+		}
+	}
+	return nil, authv1.ErrorIdentityProviderNotFound("identity provider %q not found", id)
+}
+
+func (b *FederationBiz) callbackURL(providerID string) string {
+	return b.baseURL + "/auth/oidc/" + providerID + "/callback"
+}
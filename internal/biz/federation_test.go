@@ -0,0 +1,367 @@
+package biz_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/conf"
+	"github.com/tencat-dev/go-base/internal/infra/oidc"
+)
+
+const (
+	mockClientID     = "go-base"
+	mockClientSecret = "secret"
+)
+
+// mockIdentity is the account the mock provider signs in.
+type mockIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// mockOIDCProvider is an OpenID Connect provider that signs in whichever
+// identity the test sets, without asking anyone.
+type mockOIDCProvider struct {
+	*httptest.Server
+	t   *testing.T
+	key *rsa.PrivateKey
+
+	mu       sync.Mutex
+	identity mockIdentity
+	codes    map[string]mockAuthorization
+}
+
+type mockAuthorization struct {
+	identity    mockIdentity
+	nonce       string
+	challenge   string
+	redirectURI string
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &mockOIDCProvider{t: t, key: key, codes: make(map[string]mockAuthorization)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("GET /jwks", p.jwks)
+	mux.HandleFunc("GET /authorize", p.authorize)
+	mux.HandleFunc("POST /token", p.token)
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+
+	return p
+}
+
+func (p *mockOIDCProvider) signIn(identity mockIdentity) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.identity = identity
+}
+
+func (p *mockOIDCProvider) discovery(w http.ResponseWriter, _ *http.Request) {
+	p.writeJSON(w, map[string]any{
+		"issuer":                                p.URL,
+		"authorization_endpoint":                p.URL + "/authorize",
+		"token_endpoint":                        p.URL + "/token",
+		"jwks_uri":                              p.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *mockOIDCProvider) jwks(w http.ResponseWriter, _ *http.Request) {
+	p.writeJSON(w, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "mock",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+// authorize signs the current identity in and redirects back with a code.
+func (p *mockOIDCProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != mockClientID || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	code := rand.Text()
+	p.mu.Lock()
+	p.codes[code] = mockAuthorization{
+		identity:    p.identity,
+		nonce:       q.Get("nonce"),
+		challenge:   q.Get("code_challenge"),
+		redirectURI: q.Get("redirect_uri"),
+	}
+	p.mu.Unlock()
+
+	callback, _ := url.Parse(q.Get("redirect_uri"))
+	callback.RawQuery = url.Values{"code": {code}, "state": {q.Get("state")}}.Encode()
+	http.Redirect(w, r, callback.String(), http.StatusFound)
+}
+
+func (p *mockOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID, secret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+	}
+	if clientID != mockClientID || secret != mockClientSecret {
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+		return
+	}
+
+	p.mu.Lock()
+	a, ok := p.codes[r.PostFormValue("code")]
+	delete(p.codes, r.PostFormValue("code"))
+	p.mu.Unlock()
+
+	verifier := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if !ok || a.redirectURI != r.PostFormValue("redirect_uri") ||
+		base64.RawURLEncoding.EncodeToString(verifier[:]) != a.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	now := time.Now()
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            p.URL,
+		"sub":            a.identity.Subject,
+		"aud":            mockClientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"nonce":          a.nonce,
+		"email":          a.identity.Email,
+		"email_verified": a.identity.EmailVerified,
+		"name":           a.identity.Name,
+	})
+	idToken.Header["kid"] = "mock"
+	signed, err := idToken.SignedString(p.key)
+	if err != nil {
+		p.t.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	p.writeJSON(w, map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     signed,
+	})
+}
+
+func (p *mockOIDCProvider) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		p.t.Error(err)
+	}
+}
+
+type federationFixture struct {
+	biz        *biz.FederationBiz
+	provider   *mockOIDCProvider
+	users      *fakeUserRepo
+	identities *fakeExternalIdentityRepo
+	ctx        context.Context
+}
+
+func newFederationFixture(t *testing.T, c *conf.OIDCProvider, users ...*biz.User) *federationFixture {
+	t.Helper()
+
+	provider := newMockOIDCProvider(t)
+	c.Id = "mock"
+	c.Issuer = provider.URL
+	c.ClientId = mockClientID
+	c.ClientSecret = mockClientSecret
+
+	userRepo := newFakeUserRepo(users...)
+	identities := &fakeExternalIdentityRepo{users: userRepo}
+	auth := &conf.Auth{
+		AppUrl:     "https://app.example.com",
+		Argon2:     &conf.Argon2{Memory: 1024, Iterations: 1, Parallelism: 1},
+		Federation: &conf.Federation{BaseUrl: "https://api.example.com"},
+	}
+
+	b := biz.NewFederationBiz(
+		[]biz.IdentityProvider{oidc.NewProvider(c)},
+		identities,
+		userRepo,
+		userRepo,
+		&fakeUserTokenRepo{},
+		biz.NewPasswordHasher(auth, nil),
+		auth,
+		log.NewHelper(log.DefaultLogger),
+	)
+
+	return &federationFixture{
+		biz:        b,
+		provider:   provider,
+		users:      userRepo,
+		identities: identities,
+		ctx:        context.WithValue(context.Background(), oauth2.HTTPClient, provider.Client()),
+	}
+}
+
+// login runs the browser's side of a federated login and returns the code
+// the web app would exchange, or the error of the callback.
+func (f *federationFixture) login(t *testing.T) (string, error) {
+	t.Helper()
+
+	s, authURL, err := f.biz.Begin(f.ctx, "mock")
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+
+	client := f.provider.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize: status %d", resp.StatusCode)
+	}
+
+	callback, err := resp.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://api.example.com/auth/oidc/mock/callback"; callback.Scheme+"://"+callback.Host+callback.Path != want {
+		t.Fatalf("redirected to %s, want %s", callback, want)
+	}
+
+	q := callback.Query()
+	return f.biz.Callback(f.ctx, "mock", q.Get("code"), q.Get("state"), s)
+}
+
+func TestFederationProvisionsAndSignsInUser(t *testing.T) {
+	f := newFederationFixture(t, &conf.OIDCProvider{AutoProvision: true})
+	f.provider.signIn(mockIdentity{Subject: "alice", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+
+	code, err := f.login(t)
+	if err != nil {
+		t.Fatalf("Callback: %v", err)
+	}
+	auth, err := f.biz.CompleteLogin(f.ctx, code)
+	if err != nil {
+		t.Fatalf("CompleteLogin: %v", err)
+	}
+	if auth.Email != "alice@example.com" || auth.Name != "Alice" || auth.EmailVerifiedAt == nil {
+		t.Fatalf("provisioned %+v, want a verified alice@example.com", auth)
+	}
+
+	// The login code can only be exchanged once.
+	if _, err := f.biz.CompleteLogin(f.ctx, code); !authv1.IsInvalidOneTimeToken(err) {
+		t.Fatalf("second exchange: err = %v, want INVALID_ONE_TIME_TOKEN", err)
+	}
+
+	// Later logins resolve to the same user and record the current email.
+	f.provider.signIn(mockIdentity{Subject: "alice", Email: "alice@example.org", EmailVerified: true})
+	code, err = f.login(t)
+	if err != nil {
+		t.Fatalf("Callback: %v", err)
+	}
+	again, err := f.biz.CompleteLogin(f.ctx, code)
+	if err != nil {
+		t.Fatalf("CompleteLogin: %v", err)
+	}
+	if again.ID != auth.ID {
+		t.Fatalf("second login resolved to %s, want %s", again.ID, auth.ID)
+	}
+	if len(f.users.users) != 1 || f.identities.identities[0].Email != "alice@example.org" {
+		t.Fatalf("identity was not reused: %d users, email %q", len(f.users.users), f.identities.identities[0].Email)
+	}
+}
+
+func TestFederationLinksByVerifiedEmail(t *testing.T) {
+	existing := &biz.User{ID: uuid.Must(uuid.NewV7()), Name: "Bob", Email: "bob@example.com"}
+
+	tests := []struct {
+		name          string
+		emailVerified bool
+		wantLinked    bool
+	}{
+		{name: "verified", emailVerified: true, wantLinked: true},
+		{name: "unverified", emailVerified: false, wantLinked: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFederationFixture(t, &conf.OIDCProvider{LinkByEmail: true, AutoProvision: true}, existing)
+			f.provider.signIn(mockIdentity{Subject: "bob", Email: "bob@example.com", EmailVerified: tt.emailVerified})
+
+			code, err := f.login(t)
+			if !tt.wantLinked {
+				if !authv1.IsAccountNotLinked(err) {
+					t.Fatalf("err = %v, want ACCOUNT_NOT_LINKED", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Callback: %v", err)
+			}
+
+			auth, err := f.biz.CompleteLogin(f.ctx, code)
+			if err != nil {
+				t.Fatalf("CompleteLogin: %v", err)
+			}
+			if auth.ID != existing.ID {
+				t.Fatalf("signed in as %s, want %s", auth.ID, existing.ID)
+			}
+		})
+	}
+}
+
+func TestFederationRejectsForgedCallback(t *testing.T) {
+	f := newFederationFixture(t, &conf.OIDCProvider{AutoProvision: true})
+	f.provider.signIn(mockIdentity{Subject: "alice", Email: "alice@example.com", EmailVerified: true})
+
+	s, _, err := f.biz.Begin(f.ctx, "mock")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.biz.Callback(f.ctx, "mock", "code", "forged", s); !authv1.IsFederatedLoginFailed(err) {
+		t.Fatalf("wrong state: err = %v, want FEDERATED_LOGIN_FAILED", err)
+	}
+	if _, err := f.biz.Callback(f.ctx, "mock", "unknown", s.State, s); !authv1.IsFederatedLoginFailed(err) {
+		t.Fatalf("unknown code: err = %v, want FEDERATED_LOGIN_FAILED", err)
+	}
+	if len(f.users.users) != 0 {
+		t.Fatalf("forged callbacks provisioned %d users", len(f.users.users))
+	}
+}
@@ -0,0 +1,49 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+)
+
+const defaultImpersonationTTL = 15 * time.Minute
+
+// Impersonate issues an access token for acting as the user. The token
+// belongs to the actor's session, so it stops working when the actor signs
+// out. Users who may impersonate cannot be impersonated themselves, as that
+// would hand out their permissions.
+func (b *AuthBiz) Impersonate(ctx context.Context, actorID, sessionID, userID uuid.UUID) (string, time.Time, error) {
+	if actorID == userID {
+		return "", time.Time{}, authv1.ErrorImpersonationNotAllowed("cannot impersonate yourself")
+	}
+
+	user, err := b.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	privileged, err := b.authz.Can(user.ID.String(), GlobalDomain, "user", "impersonate")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if privileged {
+		return "", time.Time{}, authv1.ErrorImpersonationNotAllowed("cannot impersonate a user who can impersonate")
+	}
+
+	expiresAt := time.Now().UTC().Add(b.impersonationTTL)
+	token, err := b.tokenMaker.CreateAccessToken(AccessPayload{
+		UserID:    user.ID,
+		SessionID: sessionID,
+		Actor:     actorID,
+		TTL:       b.impersonationTTL,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	b.log.WithContext(ctx).Infof("impersonation: %s started impersonating %s", actorID, user.ID)
+	return token, expiresAt, nil
+}
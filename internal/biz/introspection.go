@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Introspection describes a token presented to Introspect. Only Active is
+// set for tokens that cannot be used.
+type Introspection struct {
+	Active bool
+	*TokenInfo
+	Roles []string
+}
+
+// UserProfile is the profile of a token's subject.
+type UserProfile struct {
+	*User
+	Roles []string
+}
+
+// IntrospectionBiz is a token introspection usecase.
+type IntrospectionBiz struct {
+	tokenMaker  TokenMaker
+	sessionRepo SessionRepo
+	refreshRepo RefreshTokenRepo
+	userRepo    UserRepo
+	authz       PermissionChecker
+}
+
+// NewIntrospectionBiz new a token introspection usecase.
+func NewIntrospectionBiz(
+	tokenMaker TokenMaker,
+	sessionRepo SessionRepo,
+	refreshRepo RefreshTokenRepo,
+	userRepo UserRepo,
+	authz PermissionChecker,
+) *IntrospectionBiz {
+	return &IntrospectionBiz{
+		tokenMaker:  tokenMaker,
+		sessionRepo: sessionRepo,
+		refreshRepo: refreshRepo,
+		userRepo:    userRepo,
+		authz:       authz,
+	}
+}
+
+// Introspect reports whether the token is an access or refresh token that
+// can still be used. Invalid tokens are inactive rather than an error.
+func (b *IntrospectionBiz) Introspect(ctx context.Context, token string) (*Introspection, error) {
+	inactive := &Introspection{}
+
+	info, err := b.tokenMaker.ParseToken(token)
+	if err != nil {
+		return inactive, nil
+	}
+	if info.Type != AccessToken && info.Type != RefreshToken {
+		return inactive, nil
+	}
+
+	revoked, err := b.sessionRepo.IsRevoked(ctx, info.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return inactive, nil
+	}
+
+	// Refresh tokens are single use, so a rotated one is no longer active.
+	if info.Type == RefreshToken {
+		record, err := b.refreshRepo.FindByID(ctx, info.ID)
+		if errors.Is(err, ErrNotFound) {
+			return inactive, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if record.RotatedAt != nil || record.RevokedAt != nil {
+			return inactive, nil
+		}
+	}
+
+	if _, err := b.userRepo.FindByID(ctx, info.UserID); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return inactive, nil
+		}
+		return nil, err
+	}
+
+	roles, err := b.effectiveRoles(info.UserID, info.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Introspection{
+		Active:    true,
+		TokenInfo: info,
+		Roles:     roles,
+	}, nil
+}
+
+// UserInfo returns the profile of the user together with their roles in
+// the tenant, which may be empty.
+func (b *IntrospectionBiz) UserInfo(ctx context.Context, userID uuid.UUID, tenant string) (*UserProfile, error) {
+	user, err := b.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := b.effectiveRoles(userID, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserProfile{User: user, Roles: roles}, nil
+}
+
+// effectiveRoles returns the user's global roles and, for a token bound to
+// a tenant, their roles in it.
+func (b *IntrospectionBiz) effectiveRoles(userID uuid.UUID, tenant string) ([]string, error) {
+	roles, err := b.authz.RolesFor(userID.String(), GlobalDomain)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == "" {
+		return roles, nil
+	}
+
+	tenantRoles, err := b.authz.RolesFor(userID.String(), tenant)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range tenantRoles {
+		if !slices.Contains(roles, r) {
+			roles = append(roles, r)
+		}
+	}
+	return roles, nil
+}
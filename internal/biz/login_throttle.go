@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+// LoginThrottle counts the failed logins of one account or client IP.
+type LoginThrottle struct {
+	Key           string
+	Failures      int32
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+// LoginThrottleRepo is a LoginThrottle repo.
+type LoginThrottleRepo interface {
+	FindByKey(context.Context, string) (*LoginThrottle, error)
+	// RecordFailure counts a failure, starting over if the previous one is
+	// older than resetAfter, and returns the updated throttle.
+	RecordFailure(ctx context.Context, key string, resetAfter time.Duration) (*LoginThrottle, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	DeleteByKey(context.Context, string) error
+}
+
+// loginThrottler applies exponential backoff and temporary lockouts to
+// failed logins.
+type loginThrottler struct {
+	repo LoginThrottleRepo
+	// account returns the key failures of an account are counted under.
+	account func(email string) string
+
+	maxAccountFailures int32
+	maxIPFailures      int32
+	baseDelay          time.Duration
+	maxDelay           time.Duration
+	lockout            time.Duration
+	resetAfter         time.Duration
+}
+
+func newLoginThrottler(repo LoginThrottleRepo, c *conf.LoginThrottle) *loginThrottler {
+	return &loginThrottler{
+		repo:               repo,
+		account:            accountThrottleKey,
+		maxAccountFailures: int32(orDefault(c.GetMaxAccountFailures(), 10)),
+		maxIPFailures:      int32(orDefault(c.GetMaxIpFailures(), 50)),
+		baseDelay:          durationOrDefault(c.GetBaseDelay().AsDuration(), time.Second),
+		maxDelay:           durationOrDefault(c.GetMaxDelay().AsDuration(), time.Minute),
+		lockout:            durationOrDefault(c.GetLockoutDuration().AsDuration(), 15*time.Minute),
+		resetAfter:         durationOrDefault(c.GetResetAfter().AsDuration(), time.Hour),
+	}
+}
+
+func accountThrottleKey(email string) string {
+	return "account:" + strings.ToLower(email)
+}
+
+// mfaThrottleKey counts an account's failed MFA codes apart from its failed
+// passwords, since a correct password resets the latter.
+func mfaThrottleKey(email string) string {
+	return "mfa_account:" + strings.ToLower(email)
+}
+
+func ipThrottleKey(ip string) string {
+	return "ip:" + ip
+}
+
+// check returns LOGIN_THROTTLED if the account or IP has to wait before the
+// next attempt.
+func (t *loginThrottler) check(ctx context.Context, email, ip string) error {
+	now := time.Now()
+
+	wait, err := t.wait(ctx, t.account(email), true, now)
+	if err != nil {
+		return err
+	}
+	if ip != "" {
+		ipWait, err := t.wait(ctx, ipThrottleKey(ip), false, now)
+		if err != nil {
+			return err
+		}
+		wait = max(wait, ipWait)
+	}
+
+	if wait > 0 {
+		seconds := int(math.Ceil(wait.Seconds()))
+		return authv1.ErrorLoginThrottled("too many failed login attempts").
+			WithMetadata(map[string]string{"retry_after": strconv.Itoa(seconds)})
+	}
+	return nil
+}
+
+func (t *loginThrottler) wait(ctx context.Context, key string, backoff bool, now time.Time) (time.Duration, error) {
+	th, err := t.repo.FindByKey(ctx, key)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var wait time.Duration
+	if th.LockedUntil != nil {
+		wait = th.LockedUntil.Sub(now)
+	}
+	if backoff && th.Failures > 0 && now.Sub(th.LastFailureAt) < t.resetAfter {
+		delay := t.baseDelay << min(th.Failures-1, 30)
+		if delay <= 0 || delay > t.maxDelay {
+			delay = t.maxDelay
+		}
+		wait = max(wait, th.LastFailureAt.Add(delay).Sub(now))
+	}
+	return wait, nil
+}
+
+// fail records a failed attempt and locks whatever crossed its threshold.
+func (t *loginThrottler) fail(ctx context.Context, email, ip string) error {
+	if err := t.record(ctx, t.account(email), t.maxAccountFailures); err != nil {
+		return err
+	}
+	if ip == "" {
+		return nil
+	}
+	return t.record(ctx, ipThrottleKey(ip), t.maxIPFailures)
+}
+
+func (t *loginThrottler) record(ctx context.Context, key string, limit int32) error {
+	th, err := t.repo.RecordFailure(ctx, key, t.resetAfter)
+	if err != nil {
+		return err
+	}
+	if th.Failures >= limit {
+		return t.repo.Lock(ctx, key, time.Now().UTC().Add(t.lockout))
+	}
+	return nil
+}
+
+// reset clears the failures and lock of an account.
+func (t *loginThrottler) reset(ctx context.Context, email string) error {
+	return t.repo.DeleteByKey(ctx, t.account(email))
+}
+
+func orDefault(v, def uint32) uint32 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+func durationOrDefault(v, def time.Duration) time.Duration {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
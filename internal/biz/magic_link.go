@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const (
+	defaultMagicLinkTTL         = 15 * time.Minute
+	defaultMagicLinkMaxRequests = 5
+	defaultMagicLinkWindow      = time.Hour
+)
+
+// MagicLinkBiz is a passwordless login usecase.
+type MagicLinkBiz struct {
+	authRepo     AuthRepo
+	userRepo     UserRepo
+	tokenRepo    UserTokenRepo
+	throttleRepo LoginThrottleRepo
+	mailer       Mailer
+	appURL       string
+	log          *log.Helper
+
+	ttl         time.Duration
+	maxRequests int32
+	window      time.Duration
+}
+
+// NewMagicLinkBiz new a passwordless login usecase.
+func NewMagicLinkBiz(
+	authRepo AuthRepo,
+	userRepo UserRepo,
+	tokenRepo UserTokenRepo,
+	throttleRepo LoginThrottleRepo,
+	mailer Mailer,
+	c *conf.Auth,
+	logger *log.Helper,
+) *MagicLinkBiz {
+	m := c.GetMagicLink()
+	return &MagicLinkBiz{
+		authRepo:     authRepo,
+		userRepo:     userRepo,
+		tokenRepo:    tokenRepo,
+		throttleRepo: throttleRepo,
+		mailer:       mailer,
+		appURL:       c.GetAppUrl(),
+		log:          logger,
+
+		ttl:         durationOrDefault(m.GetTtl().AsDuration(), defaultMagicLinkTTL),
+		maxRequests: int32(orDefault(m.GetMaxRequests(), defaultMagicLinkMaxRequests)),
+		window:      durationOrDefault(m.GetWindow().AsDuration(), defaultMagicLinkWindow),
+	}
+}
+
+// RequestMagicLink mails a sign-in link if the email belongs to a user. The
+// number of requests per email is limited whether or not it belongs to a
+// user, so callers cannot probe for registered emails. The user is looked up
+// in the background, so the response time does not tell them apart either.
+func (b *MagicLinkBiz) RequestMagicLink(ctx context.Context, email string) error {
+	// The throttle counts requests rather than failures here.
+	th, err := b.throttleRepo.RecordFailure(ctx, "magic_link:"+strings.ToLower(email), b.window)
+	if err != nil {
+		return err
+	}
+	if th.Failures > b.maxRequests {
+		seconds := int(th.LastFailureAt.Add(b.window).Sub(time.Now()).Seconds())
+		return authv1.ErrorLoginThrottled("too many magic link requests").
+			WithMetadata(map[string]string{"retry_after": strconv.Itoa(max(seconds, 1))})
+	}
+
+	mailLater(ctx, b.log, func(ctx context.Context) error {
+		return b.sendMagicLink(ctx, email)
+	})
+	return nil
+}
+
+func (b *MagicLinkBiz) sendMagicLink(ctx context.Context, email string) error {
+	user, err := b.authRepo.FindByEmail(ctx, email)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Issuing a link invalidates the previous one.
+	token, err := issueEmailToken(ctx, b.tokenRepo, user.ID, user.Email, PurposeMagicLink, b.ttl)
+	if err != nil {
+		return err
+	}
+
+	return b.mailer.Send(ctx, &Mail{
+		To:      user.Email,
+		Subject: "Your sign-in link",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nUse the link below to sign in. It can be used once and expires in %s.\n\n%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			user.Name, b.ttl, link(b.appURL, "/magic-link", token),
+		),
+	})
+}
+
+// ConsumeMagicLink uses up the link's token and returns the user it was
+// sent to. Following the link proves the user owns the email address, so it
+// is marked as verified. A link sent to an address the user has since
+// changed is rejected.
+func (b *MagicLinkBiz) ConsumeMagicLink(ctx context.Context, token string) (*Auth, error) {
+	t, err := consumeUserToken(ctx, b.tokenRepo, token, PurposeMagicLink)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := b.userRepo.FindByID(ctx, t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, t.Email) {
+		return nil, authv1.ErrorInvalidOneTimeToken("invalid or expired token")
+	}
+
+	if user.EmailVerifiedAt == nil {
+		if err := b.userRepo.MarkEmailVerified(ctx, user.ID, user.Email); err != nil {
+			return nil, err
+		}
+		now := time.Now().UTC()
+		user.EmailVerifiedAt = &now
+	}
+
+	return &Auth{
+		ID:              user.ID,
+		Name:            user.Name,
+		Email:           user.Email,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+	}, nil
+}
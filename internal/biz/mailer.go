@@ -0,0 +1,90 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+// mailTimeout bounds the work done in the background to send a mail.
+const mailTimeout = time.Minute
+
+// Mail is an outgoing plain text email.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails.
+type Mailer interface {
+	Send(context.Context, *Mail) error
+}
+
+// mailThrottler limits how many mails of one kind can be requested for an
+// email address or from a client IP.
+type mailThrottler struct {
+	repo LoginThrottleRepo
+
+	maxPerEmail int32
+	maxPerIP    int32
+	window      time.Duration
+}
+
+func newMailThrottler(repo LoginThrottleRepo, c *conf.MailThrottle) *mailThrottler {
+	return &mailThrottler{
+		repo:        repo,
+		maxPerEmail: int32(orDefault(c.GetMaxPerEmail(), 5)),
+		maxPerIP:    int32(orDefault(c.GetMaxPerIp(), 50)),
+		window:      durationOrDefault(c.GetWindow().AsDuration(), time.Hour),
+	}
+}
+
+// allow counts a request for a mail of the given kind and returns
+// LOGIN_THROTTLED once the email or IP has made too many. Requests are
+// counted whether or not the email belongs to a user, so the limit does not
+// reveal registered emails.
+func (t *mailThrottler) allow(ctx context.Context, kind, email, ip string) error {
+	if err := t.count(ctx, kind+":"+strings.ToLower(email), t.maxPerEmail); err != nil {
+		return err
+	}
+	if ip == "" {
+		return nil
+	}
+	return t.count(ctx, kind+":ip:"+ip, t.maxPerIP)
+}
+
+func (t *mailThrottler) count(ctx context.Context, key string, limit int32) error {
+	// The throttle counts requests rather than failures here.
+	th, err := t.repo.RecordFailure(ctx, key, t.window)
+	if err != nil {
+		return err
+	}
+	if th.Failures > limit {
+		seconds := int(time.Until(th.LastFailureAt.Add(t.window)).Seconds())
+		return authv1.ErrorLoginThrottled("too many requests").
+			WithMetadata(map[string]string{"retry_after": strconv.Itoa(max(seconds, 1))})
+	}
+	return nil
+}
+
+// mailLater runs send in the background, so a request that mails only
+// registered emails takes as long either way. Its errors are logged, as
+// failing the request would reveal that the email exists.
+func mailLater(ctx context.Context, logger *log.Helper, send func(context.Context) error) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, mailTimeout)
+		defer cancel()
+
+		if err := send(ctx); err != nil {
+			logger.WithContext(ctx).Errorf("send mail: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,359 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matthewhartstonge/argon2"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const (
+	// MFAIssuer is shown by authenticator apps next to the account name.
+	MFAIssuer = "go-base"
+
+	totpPeriod        = 30
+	recoveryCodeCount = 10
+	recoveryCodeLen   = 10
+	// maxMFAAttempts is the number of codes that can be tried with one
+	// challenge before the user has to sign in again.
+	maxMFAAttempts = 5
+)
+
+// MFA is a user's TOTP enrollment. It is pending until EnabledAt is set.
+type MFA struct {
+	UserID       uuid.UUID
+	Secret       string
+	LastUsedStep int64
+	EnabledAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// RecoveryCode is a hashed single-use recovery code.
+type RecoveryCode struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	CodeHash string
+}
+
+// MFAEnrollment is returned when a user starts enrolling a TOTP secret.
+type MFAEnrollment struct {
+	Secret string
+	URI    string
+}
+
+// MFARepo is a MFA repo.
+type MFARepo interface {
+	Save(context.Context, *MFA) (*MFA, error)
+	FindByUserID(context.Context, uuid.UUID) (*MFA, error)
+	// Enable enables the enrollment and replaces the recovery codes with
+	// hashes in one transaction.
+	Enable(ctx context.Context, userID uuid.UUID, hashes []string) error
+	DeleteByUserID(context.Context, uuid.UUID) error
+	// UseStep records a TOTP time step as used and reports false if it, or
+	// a later step, was used before.
+	UseStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	ListUnusedRecoveryCodes(context.Context, uuid.UUID) ([]*RecoveryCode, error)
+	// UseRecoveryCode marks the code as used and reports whether it was still unused.
+	UseRecoveryCode(context.Context, uuid.UUID) (bool, error)
+}
+
+// MFABiz is a MFA usecase.
+type MFABiz struct {
+	repo         MFARepo
+	userRepo     UserRepo
+	tokenMaker   TokenMaker
+	throttleRepo LoginThrottleRepo
+	throttle     *loginThrottler
+}
+
+// NewMFABiz new a MFA usecase.
+func NewMFABiz(repo MFARepo, userRepo UserRepo, tokenMaker TokenMaker, throttleRepo LoginThrottleRepo, c *conf.Auth) *MFABiz {
+	throttle := newLoginThrottler(throttleRepo, c.GetLoginThrottle())
+	throttle.account = mfaThrottleKey
+
+	return &MFABiz{
+		repo:         repo,
+		userRepo:     userRepo,
+		tokenMaker:   tokenMaker,
+		throttleRepo: throttleRepo,
+		throttle:     throttle,
+	}
+}
+
+// Enroll generates a new TOTP secret for the user. The secret only takes
+// effect once confirmed with a code.
+func (b *MFABiz) Enroll(ctx context.Context, userID uuid.UUID) (*MFAEnrollment, error) {
+	current, err := b.repo.FindByUserID(ctx, userID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	if current != nil && current.EnabledAt != nil {
+		return nil, authv1.ErrorMfaAlreadyEnabled("mfa is already enabled")
+	}
+
+	user, err := b.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      MFAIssuer,
+		AccountName: user.Email,
+		Period:      totpPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if current != nil {
+		if err := b.repo.DeleteByUserID(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = b.repo.Save(ctx, &MFA{
+		UserID: userID,
+		Secret: key.Secret(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MFAEnrollment{
+		Secret: key.Secret(),
+		URI:    key.URL(),
+	}, nil
+}
+
+// Confirm enables a pending enrollment and returns a fresh set of recovery
+// codes. The codes are only ever shown here.
+func (b *MFABiz) Confirm(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	m, err := b.repo.FindByUserID(ctx, userID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, authv1.ErrorMfaNotEnabled("mfa enrollment has not been started")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if m.EnabledAt != nil {
+		return nil, authv1.ErrorMfaAlreadyEnabled("mfa is already enabled")
+	}
+
+	ok, err := b.verifyTOTP(ctx, m, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, authv1.ErrorInvalidMfaCode("invalid mfa code")
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := b.repo.Enable(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Disable removes the user's second factor after checking a code.
+func (b *MFABiz) Disable(ctx context.Context, userID uuid.UUID, code string) error {
+	m, err := b.enabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := b.verifyCode(ctx, m, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return authv1.ErrorInvalidMfaCode("invalid mfa code")
+	}
+
+	return b.repo.DeleteByUserID(ctx, userID)
+}
+
+// IsEnabled reports whether the user has to pass a second factor on login.
+func (b *MFABiz) IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	m, err := b.repo.FindByUserID(ctx, userID)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return m.EnabledAt != nil, nil
+}
+
+// CreateChallenge issues the short-lived mfa_pending token returned by Login.
+func (b *MFABiz) CreateChallenge(userID uuid.UUID) (string, error) {
+	return b.tokenMaker.CreateMFAToken(MFAPayload{
+		ID:     uuid.Must(uuid.NewV7()),
+		UserID: userID,
+		TTL:    MFATokenTTL,
+	})
+}
+
+// VerifyChallenge checks the challenge token and code and returns the user
+// that completed the login. A challenge can be completed once, and only a
+// few codes can be tried with it. Wrong codes are also throttled per account
+// and IP like failed logins, so new challenges do not allow more guesses.
+func (b *MFABiz) VerifyChallenge(ctx context.Context, token, code, ip string) (uuid.UUID, error) {
+	payload, err := b.tokenMaker.ParseMFAToken(token)
+	if err != nil {
+		return uuid.Nil, authv1.ErrorInvalidToken("invalid mfa token")
+	}
+
+	// The throttle counts attempts rather than failures here, and is locked
+	// once the challenge is completed.
+	key := "mfa:" + payload.ID.String()
+	th, err := b.throttleRepo.RecordFailure(ctx, key, MFATokenTTL)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if th.LockedUntil != nil {
+		return uuid.Nil, authv1.ErrorInvalidToken("mfa token has already been used")
+	}
+	if th.Failures > maxMFAAttempts {
+		return uuid.Nil, authv1.ErrorInvalidToken("too many attempts, sign in again")
+	}
+
+	user, err := b.userRepo.FindByID(ctx, payload.UserID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := b.throttle.check(ctx, user.Email, ip); err != nil {
+		return uuid.Nil, err
+	}
+
+	m, err := b.enabled(ctx, payload.UserID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	ok, err := b.verifyCode(ctx, m, code)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !ok {
+		if err := b.throttle.fail(ctx, user.Email, ip); err != nil {
+			return uuid.Nil, err
+		}
+		return uuid.Nil, authv1.ErrorInvalidMfaCode("invalid mfa code")
+	}
+
+	if err := b.throttle.reset(ctx, user.Email); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := b.throttleRepo.Lock(ctx, key, time.Now().UTC().Add(MFATokenTTL)); err != nil {
+		return uuid.Nil, err
+	}
+
+	return payload.UserID, nil
+}
+
+func (b *MFABiz) enabled(ctx context.Context, userID uuid.UUID) (*MFA, error) {
+	m, err := b.repo.FindByUserID(ctx, userID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, authv1.ErrorMfaNotEnabled("mfa is not enabled")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if m.EnabledAt == nil {
+		return nil, authv1.ErrorMfaNotEnabled("mfa is not enabled")
+	}
+	return m, nil
+}
+
+// verifyCode accepts either a TOTP code or an unused recovery code.
+func (b *MFABiz) verifyCode(ctx context.Context, m *MFA, code string) (bool, error) {
+	if len(code) == int(otp.DigitsSix) {
+		return b.verifyTOTP(ctx, m, code)
+	}
+	return b.useRecoveryCode(ctx, m.UserID, code)
+}
+
+// verifyTOTP checks the code against the current time step and one step on
+// either side. A step can only be used once, so a code cannot be replayed.
+func (b *MFABiz) verifyTOTP(ctx context.Context, m *MFA, code string) (bool, error) {
+	now := time.Now().UTC()
+	for _, skew := range []int64{0, -1, 1} {
+		t := now.Add(time.Duration(skew*totpPeriod) * time.Second)
+		ok, err := totp.ValidateCustom(code, m.Secret, t, totp.ValidateOpts{
+			Period:    totpPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			// A malformed code is simply a wrong code.
+			return false, nil
+		}
+		if ok {
+			return b.repo.UseStep(ctx, m.UserID, t.Unix()/totpPeriod)
+		}
+	}
+	return false, nil
+}
+
+func (b *MFABiz) useRecoveryCode(ctx context.Context, userID uuid.UUID, code string) (bool, error) {
+	code = normalizeRecoveryCode(code)
+
+	codes, err := b.repo.ListUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range codes {
+		ok, err := argon2.VerifyEncoded([]byte(code), []byte(c.CodeHash))
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return b.repo.UseRecoveryCode(ctx, c.ID)
+		}
+	}
+	return false, nil
+}
+
+// generateRecoveryCodes returns the codes formatted for display along with
+// their argon2 hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	argon := argon2.DefaultConfig()
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	for range recoveryCodeCount {
+		raw := strings.ToLower(rand.Text()[:recoveryCodeLen])
+
+		hash, err := argon.HashEncoded([]byte(raw))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes = append(codes, raw[:recoveryCodeLen/2]+"-"+raw[recoveryCodeLen/2:])
+		hashes = append(hashes, string(hash))
+	}
+
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
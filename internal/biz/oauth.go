@@ -0,0 +1,674 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	oauthv1 "github.com/tencat-dev/go-base/api/oauth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+
+	PKCEMethodS256 = "S256"
+
+	defaultAuthorizationCodeTTL = time.Minute
+	defaultIDTokenTTL           = time.Hour
+)
+
+// SupportedScopes are the scopes an OAuth client can be registered for.
+var SupportedScopes = []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+
+// OAuth error codes defined by RFC 6749.
+const (
+	OAuthInvalidRequest          = "invalid_request"
+	OAuthInvalidClient           = "invalid_client"
+	OAuthInvalidGrant            = "invalid_grant"
+	OAuthInvalidScope            = "invalid_scope"
+	OAuthInvalidToken            = "invalid_token"
+	OAuthUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthUnsupportedResponseType = "unsupported_response_type"
+)
+
+// OAuthError is an error returned to an OAuth client in the format of
+// RFC 6749.
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func oauthError(code, description string) *OAuthError {
+	return &OAuthError{Code: code, Description: description}
+}
+
+// OAuthClient is an application allowed to sign users in through go-base.
+type OAuthClient struct {
+	ID           string
+	Name         string
+	SecretHash   string
+	RedirectURIs []string
+	Scopes       []string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Confidential reports whether the client authenticates with a secret.
+// Public clients rely on PKCE alone.
+func (c *OAuthClient) Confidential() bool {
+	return c.SecretHash != ""
+}
+
+// AuthorizationCode is an issued authorization code. Only a hash of the code
+// is stored.
+type AuthorizationCode struct {
+	CodeHash      string
+	ClientID      string
+	UserID        uuid.UUID
+	RedirectURI   string
+	Scopes        []string
+	CodeChallenge string
+	Nonce         string
+	AuthTime      time.Time
+	SessionID     *uuid.UUID
+	ExpiresAt     time.Time
+	UsedAt        *time.Time
+}
+
+// OAuthGrant binds a session created by the token endpoint to the client it
+// was issued to.
+type OAuthGrant struct {
+	SessionID uuid.UUID
+	ClientID  string
+	Scopes    []string
+	AuthTime  time.Time
+}
+
+// OAuthClientRepo is a OAuthClient repo.
+type OAuthClientRepo interface {
+	Save(context.Context, *OAuthClient) (*OAuthClient, error)
+	Update(context.Context, *OAuthClient) (*OAuthClient, error)
+	FindByID(context.Context, string) (*OAuthClient, error)
+	ListAll(context.Context) ([]*OAuthClient, error)
+	DeleteByID(context.Context, string) error
+}
+
+// OAuthRepo stores authorization codes and grants.
+type OAuthRepo interface {
+	SaveCode(context.Context, *AuthorizationCode) error
+	FindCodeByHash(context.Context, string) (*AuthorizationCode, error)
+	// ConsumeCode marks the code as exchanged for the session and reports
+	// whether it was still unused.
+	ConsumeCode(ctx context.Context, hash string, sessionID uuid.UUID) (bool, error)
+	SaveGrant(context.Context, *OAuthGrant) error
+	FindGrantBySessionID(context.Context, uuid.UUID) (*OAuthGrant, error)
+	// DeleteGrants deletes the grants of the user's sessions except keep.
+	DeleteGrants(ctx context.Context, userID, keep uuid.UUID) error
+}
+
+// AuthorizeRequest is an authorization request (RFC 6749 section 4.1.1)
+// approved by the signed-in user.
+type AuthorizeRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
+}
+
+// TokenRequest is a request to the token endpoint.
+type TokenRequest struct {
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	ClientID     string
+	ClientSecret string
+	UserAgent    string
+	IP           string
+}
+
+// TokenResponse is a successful token endpoint response.
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// UserInfo holds the claims returned by the userinfo endpoint.
+type UserInfo struct {
+	Sub           string `json:"sub"`
+	Name          string `json:"name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+}
+
+// OAuthBiz is a OAuth usecase.
+type OAuthBiz struct {
+	tx          Transaction
+	clientRepo  OAuthClientRepo
+	repo        OAuthRepo
+	userRepo    UserRepo
+	sessions    *SessionBiz
+	sessionRepo SessionRepo
+	refreshRepo RefreshTokenRepo
+	tokenMaker  TokenMaker
+
+	issuer     string
+	loginURL   string
+	codeTTL    time.Duration
+	idTokenTTL time.Duration
+}
+
+// NewOAuthBiz new a OAuth usecase.
+func NewOAuthBiz(
+	tx Transaction,
+	clientRepo OAuthClientRepo,
+	repo OAuthRepo,
+	userRepo UserRepo,
+	sessions *SessionBiz,
+	sessionRepo SessionRepo,
+	refreshRepo RefreshTokenRepo,
+	tokenMaker TokenMaker,
+	c *conf.Auth,
+) *OAuthBiz {
+	loginURL := c.GetOauth().GetLoginUrl()
+	if loginURL == "" {
+		loginURL = strings.TrimRight(c.GetAppUrl(), "/") + "/oauth/authorize"
+	}
+
+	return &OAuthBiz{
+		tx:          tx,
+		clientRepo:  clientRepo,
+		repo:        repo,
+		userRepo:    userRepo,
+		sessions:    sessions,
+		sessionRepo: sessionRepo,
+		refreshRepo: refreshRepo,
+		tokenMaker:  tokenMaker,
+
+		issuer:     strings.TrimRight(c.GetOauth().GetIssuer(), "/"),
+		loginURL:   loginURL,
+		codeTTL:    durationOrDefault(c.GetOauth().GetCodeTtl().AsDuration(), defaultAuthorizationCodeTTL),
+		idTokenTTL: durationOrDefault(c.GetOauth().GetIdTokenTtl().AsDuration(), defaultIDTokenTTL),
+	}
+}
+
+// Issuer is the iss of ID tokens and the base URL of the OAuth endpoints.
+func (b *OAuthBiz) Issuer() string {
+	return b.issuer
+}
+
+// LoginURL is the web app page authorization requests are forwarded to.
+func (b *OAuthBiz) LoginURL() string {
+	return b.loginURL
+}
+
+// CreateClient registers a client and returns it along with its secret,
+// which is only set for confidential clients.
+func (b *OAuthBiz) CreateClient(ctx context.Context, c *OAuthClient, confidential bool) (*OAuthClient, string, error) {
+	if err := validateScopes(c.Scopes); err != nil {
+		return nil, "", err
+	}
+
+	c.ID = strings.ToLower(rand.Text())
+
+	var secret string
+	if confidential {
+		secret = randomToken()
+		c.SecretHash = hashUserToken(secret)
+	}
+
+	created, err := b.clientRepo.Save(ctx, c)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return created, secret, nil
+}
+
+// UpdateClient changes the name, redirect URIs or scopes of a client.
+func (b *OAuthBiz) UpdateClient(ctx context.Context, c *OAuthClient) (*OAuthClient, error) {
+	client, err := b.GetClient(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Name != "" {
+		client.Name = c.Name
+	}
+	if len(c.RedirectURIs) > 0 {
+		client.RedirectURIs = c.RedirectURIs
+	}
+	if len(c.Scopes) > 0 {
+		if err := validateScopes(c.Scopes); err != nil {
+			return nil, err
+		}
+		client.Scopes = c.Scopes
+	}
+
+	return b.clientRepo.Update(ctx, client)
+}
+
+// RotateClientSecret replaces the secret of a confidential client.
+func (b *OAuthBiz) RotateClientSecret(ctx context.Context, id string) (string, error) {
+	client, err := b.GetClient(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if !client.Confidential() {
+		return "", oauthv1.ErrorInvalidOauthClient("public clients have no secret")
+	}
+
+	secret := randomToken()
+	client.SecretHash = hashUserToken(secret)
+	if _, err := b.clientRepo.Update(ctx, client); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+// DeleteClient removes a client together with its codes and grants.
+func (b *OAuthBiz) DeleteClient(ctx context.Context, id string) error {
+	if _, err := b.GetClient(ctx, id); err != nil {
+		return err
+	}
+	return b.clientRepo.DeleteByID(ctx, id)
+}
+
+// GetClient returns a registered client.
+func (b *OAuthBiz) GetClient(ctx context.Context, id string) (*OAuthClient, error) {
+	client, err := b.clientRepo.FindByID(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, oauthv1.ErrorOauthClientNotFound("oauth client not found")
+	}
+	return client, err
+}
+
+// ListClients returns every registered client.
+func (b *OAuthBiz) ListClients(ctx context.Context) ([]*OAuthClient, error) {
+	return b.clientRepo.ListAll(ctx)
+}
+
+// ValidateRedirect checks the client and redirect URI of an authorization
+// request. Errors here must be shown to the user rather than sent to the
+// redirect URI, which cannot be trusted yet.
+func (b *OAuthBiz) ValidateRedirect(ctx context.Context, clientID, redirectURI string) (*OAuthClient, error) {
+	client, err := b.clientRepo.FindByID(ctx, clientID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, oauthv1.ErrorInvalidOauthClient("unknown client_id")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(client.RedirectURIs, redirectURI) {
+		return nil, oauthv1.ErrorInvalidRedirectUri("redirect_uri is not registered for this client")
+	}
+
+	return client, nil
+}
+
+// Authorize issues an authorization code for the signed-in user and returns
+// the URI to redirect the browser to. Problems with the request itself are
+// reported to the client through that URI. Impersonators, whose actor is
+// set, cannot authorize clients.
+func (b *OAuthBiz) Authorize(ctx context.Context, userID, sessionID, actor uuid.UUID, r *AuthorizeRequest) (string, error) {
+	if actor != uuid.Nil {
+		return "", authv1.ErrorImpersonationNotAllowed("oauth clients cannot be authorized while impersonating")
+	}
+
+	client, err := b.ValidateRedirect(ctx, r.ClientID, r.RedirectURI)
+	if err != nil {
+		return "", err
+	}
+
+	scopes, oerr := b.authorizeScopes(client, r)
+	if oerr != nil {
+		return redirectURI(r.RedirectURI, url.Values{
+			"error":             {oerr.Code},
+			"error_description": {oerr.Description},
+			"state":             {r.State},
+			"iss":               {b.issuer},
+		}), nil
+	}
+
+	session, err := b.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	code := randomToken()
+	err = b.repo.SaveCode(ctx, &AuthorizationCode{
+		CodeHash:      hashUserToken(code),
+		ClientID:      client.ID,
+		UserID:        userID,
+		RedirectURI:   r.RedirectURI,
+		Scopes:        scopes,
+		CodeChallenge: r.CodeChallenge,
+		Nonce:         r.Nonce,
+		AuthTime:      session.CreatedAt,
+		ExpiresAt:     time.Now().UTC().Add(b.codeTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return redirectURI(r.RedirectURI, url.Values{
+		"code":  {code},
+		"state": {r.State},
+		"iss":   {b.issuer},
+	}), nil
+}
+
+func (b *OAuthBiz) authorizeScopes(client *OAuthClient, r *AuthorizeRequest) ([]string, *OAuthError) {
+	if r.ResponseType != "code" {
+		return nil, oauthError(OAuthUnsupportedResponseType, "only the code response type is supported")
+	}
+	// PKCE is required for every client, as recommended by OAuth 2.1.
+	if r.CodeChallenge == "" || r.CodeChallengeMethod != PKCEMethodS256 {
+		return nil, oauthError(OAuthInvalidRequest, "code_challenge with method S256 is required")
+	}
+
+	scopes := strings.Fields(r.Scope)
+	if len(scopes) == 0 {
+		return client.Scopes, nil
+	}
+	for _, s := range scopes {
+		if !slices.Contains(client.Scopes, s) {
+			return nil, oauthError(OAuthInvalidScope, "scope "+s+" is not allowed for this client")
+		}
+	}
+	return scopes, nil
+}
+
+// Token handles the authorization_code and refresh_token grants.
+func (b *OAuthBiz) Token(ctx context.Context, r *TokenRequest) (*TokenResponse, error) {
+	client, err := b.authenticateClient(ctx, r.ClientID, r.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	switch r.GrantType {
+	case GrantTypeAuthorizationCode:
+		return b.exchangeCode(ctx, client, r)
+	case GrantTypeRefreshToken:
+		return b.refresh(ctx, client, r)
+	default:
+		return nil, oauthError(OAuthUnsupportedGrantType, "unsupported grant_type")
+	}
+}
+
+func (b *OAuthBiz) authenticateClient(ctx context.Context, id, secret string) (*OAuthClient, error) {
+	client, err := b.clientRepo.FindByID(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, oauthError(OAuthInvalidClient, "unknown client")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if client.Confidential() {
+		if subtle.ConstantTimeCompare([]byte(hashUserToken(secret)), []byte(client.SecretHash)) != 1 {
+			return nil, oauthError(OAuthInvalidClient, "invalid client credentials")
+		}
+	} else if secret != "" {
+		return nil, oauthError(OAuthInvalidClient, "public clients must not send a secret")
+	}
+
+	return client, nil
+}
+
+func (b *OAuthBiz) exchangeCode(ctx context.Context, client *OAuthClient, r *TokenRequest) (*TokenResponse, error) {
+	hash := hashUserToken(r.Code)
+	code, err := b.repo.FindCodeByHash(ctx, hash)
+	if errors.Is(err, ErrNotFound) {
+		return nil, oauthError(OAuthInvalidGrant, "invalid authorization code")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if code.UsedAt != nil {
+		// A replayed code revokes the tokens it was exchanged for (RFC 6749
+		// section 4.1.2).
+		if code.SessionID != nil {
+			if err := b.revokeSession(ctx, *code.SessionID); err != nil {
+				return nil, err
+			}
+		}
+		return nil, oauthError(OAuthInvalidGrant, "authorization code has already been used")
+	}
+
+	if code.ClientID != client.ID || code.RedirectURI != r.RedirectURI || time.Now().After(code.ExpiresAt) {
+		return nil, oauthError(OAuthInvalidGrant, "invalid authorization code")
+	}
+	if !verifyPKCE(r.CodeVerifier, code.CodeChallenge) {
+		return nil, oauthError(OAuthInvalidGrant, "invalid code_verifier")
+	}
+
+	// The session, subject to the per-user limit, is only kept if the code
+	// is consumed and the grant saved.
+	var grant *OAuthGrant
+	err = b.tx.InTx(ctx, func(ctx context.Context) error {
+		session, err := b.sessions.Create(ctx, &Session{
+			UserID:    code.UserID,
+			UserAgent: r.UserAgent,
+			IP:        r.IP,
+		})
+		if err != nil {
+			return err
+		}
+
+		ok, err := b.repo.ConsumeCode(ctx, hash, session.ID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return oauthError(OAuthInvalidGrant, "authorization code has already been used")
+		}
+
+		grant = &OAuthGrant{
+			SessionID: session.ID,
+			ClientID:  client.ID,
+			Scopes:    code.Scopes,
+			AuthTime:  code.AuthTime,
+		}
+		return b.repo.SaveGrant(ctx, grant)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.issue(ctx, code.UserID, grant, code.Nonce)
+}
+
+func (b *OAuthBiz) refresh(ctx context.Context, client *OAuthClient, r *TokenRequest) (*TokenResponse, error) {
+	payload, err := b.tokenMaker.ParseRefreshToken(r.RefreshToken)
+	if err != nil {
+		return nil, oauthError(OAuthInvalidGrant, "invalid refresh token")
+	}
+
+	grant, err := b.repo.FindGrantBySessionID(ctx, payload.SessionID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, oauthError(OAuthInvalidGrant, "invalid refresh token")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if grant.ClientID != client.ID {
+		return nil, oauthError(OAuthInvalidGrant, "refresh token was issued to another client")
+	}
+
+	record, err := rotateRefreshToken(ctx, b.tokenMaker, b.refreshRepo, b.sessionRepo, r.RefreshToken)
+	if err != nil {
+		return nil, oauthError(OAuthInvalidGrant, "invalid refresh token")
+	}
+
+	return b.issue(ctx, record.UserID, grant, "")
+}
+
+func (b *OAuthBiz) issue(ctx context.Context, userID uuid.UUID, grant *OAuthGrant, nonce string) (*TokenResponse, error) {
+	tokens, err := issueTokens(ctx, b.tokenMaker, b.refreshRepo, AccessPayload{
+		UserID:    userID,
+		SessionID: grant.SessionID,
+		ClientID:  grant.ClientID,
+		Scopes:    grant.Scopes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &TokenResponse{
+		AccessToken:  tokens.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(AccessTokenTTL.Seconds()),
+		RefreshToken: tokens.RefreshToken,
+		Scope:        strings.Join(grant.Scopes, " "),
+	}
+
+	if slices.Contains(grant.Scopes, ScopeOpenID) {
+		user, err := b.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		info := userInfo(user, grant.Scopes)
+		resp.IDToken, err = b.tokenMaker.CreateIDToken(IDTokenPayload{
+			Issuer:        b.issuer,
+			UserID:        userID,
+			ClientID:      grant.ClientID,
+			Nonce:         nonce,
+			AuthTime:      grant.AuthTime,
+			Name:          info.Name,
+			Email:         info.Email,
+			EmailVerified: info.EmailVerified,
+			TTL:           b.idTokenTTL,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// UserInfo returns the claims of the access token's subject allowed by the
+// token's scopes. First-party tokens carry no scopes and see every claim.
+func (b *OAuthBiz) UserInfo(ctx context.Context, accessToken string) (*UserInfo, error) {
+	payload, err := b.tokenMaker.ParseAccessToken(accessToken)
+	if err != nil {
+		return nil, oauthError(OAuthInvalidToken, "invalid access token")
+	}
+
+	revoked, err := b.sessionRepo.IsRevoked(ctx, payload.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, oauthError(OAuthInvalidToken, "session has been revoked")
+	}
+
+	scopes := payload.Scopes
+	if payload.ClientID == "" {
+		scopes = SupportedScopes
+	} else if !slices.Contains(scopes, ScopeOpenID) {
+		return nil, oauthError(OAuthInvalidToken, "the openid scope is required")
+	}
+
+	user, err := b.userRepo.FindByID(ctx, payload.UserID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, oauthError(OAuthInvalidToken, "unknown subject")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return userInfo(user, scopes), nil
+}
+
+func (b *OAuthBiz) revokeSession(ctx context.Context, sessionID uuid.UUID) error {
+	if err := b.sessionRepo.Revoke(ctx, sessionID); err != nil {
+		return err
+	}
+	return b.refreshRepo.RevokeBySessionID(ctx, sessionID)
+}
+
+func userInfo(user *User, scopes []string) *UserInfo {
+	info := &UserInfo{Sub: user.ID.String()}
+	if slices.Contains(scopes, ScopeProfile) {
+		info.Name = user.Name
+	}
+	if slices.Contains(scopes, ScopeEmail) {
+		verified := user.EmailVerifiedAt != nil
+		info.Email = user.Email
+		info.EmailVerified = &verified
+	}
+	return info
+}
+
+func validateScopes(scopes []string) error {
+	for _, s := range scopes {
+		if !slices.Contains(SupportedScopes, s) {
+			return oauthv1.ErrorUnsupportedScope("unsupported scope %q", s)
+		}
+	}
+	return nil
+}
+
+// verifyPKCE checks the verifier against an S256 challenge (RFC 7636).
+func verifyPKCE(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+func redirectURI(base string, params url.Values) string {
+	for k, v := range params {
+		if len(v) == 0 || v[0] == "" {
+			delete(params, k)
+		}
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return base
+	}
+	q := u.Query()
+	for k, v := range params {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,402 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const (
+	defaultWebAuthnTimeout = 5 * time.Minute
+	defaultRPDisplayName   = "go-base"
+)
+
+// Passkey is a WebAuthn credential registered by a user.
+type Passkey struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Name            string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	AAGUID          []byte
+	SignCount       uint32
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+	LastUsedAt      *time.Time
+	CreatedAt       time.Time
+}
+
+type WebAuthnCeremony string
+
+const (
+	WebAuthnRegistration WebAuthnCeremony = "registration"
+	WebAuthnLogin        WebAuthnCeremony = "login"
+)
+
+// WebAuthnSession is the state of a ceremony between its begin and finish
+// steps. UserID is zero for logins.
+type WebAuthnSession struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Ceremony  WebAuthnCeremony
+	Data      webauthn.SessionData
+	ExpiresAt time.Time
+}
+
+// PasskeyRepo is a Passkey repo.
+type PasskeyRepo interface {
+	Save(context.Context, *Passkey) (*Passkey, error)
+	ListByUserID(context.Context, uuid.UUID) ([]*Passkey, error)
+	FindByCredentialID(context.Context, []byte) (*Passkey, error)
+	// RecordUse stores the state the authenticator reported on a login.
+	RecordUse(ctx context.Context, id uuid.UUID, signCount uint32, backupState bool) error
+	// Delete removes the user's passkey, returning ErrNotFound if they have
+	// no such passkey.
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	// SaveSession saves the session and purges expired ones, which are left
+	// behind by ceremonies that were never finished.
+	SaveSession(context.Context, *WebAuthnSession) (*WebAuthnSession, error)
+	// ConsumeSession deletes the session and returns it if it has not
+	// expired.
+	ConsumeSession(ctx context.Context, id uuid.UUID, ceremony WebAuthnCeremony) (*WebAuthnSession, error)
+}
+
+// PasskeyBiz is a passkey usecase.
+type PasskeyBiz struct {
+	repo     PasskeyRepo
+	userRepo UserRepo
+	// webauthn is nil when passkeys are disabled.
+	webauthn *webauthn.WebAuthn
+	timeout  time.Duration
+	log      *log.Helper
+
+	requireVerifiedEmail bool
+}
+
+// NewPasskeyBiz new a passkey usecase.
+func NewPasskeyBiz(repo PasskeyRepo, userRepo UserRepo, c *conf.Auth, logger *log.Helper) (*PasskeyBiz, error) {
+	wc := c.GetWebauthn()
+	b := &PasskeyBiz{
+		repo:     repo,
+		userRepo: userRepo,
+		timeout:  durationOrDefault(wc.GetTimeout().AsDuration(), defaultWebAuthnTimeout),
+		log:      logger,
+
+		requireVerifiedEmail: c.GetRequireVerifiedEmail(),
+	}
+
+	rpID, origins := wc.GetRpId(), wc.GetRpOrigins()
+	if app, err := url.Parse(c.GetAppUrl()); err == nil && app.Host != "" {
+		if rpID == "" {
+			rpID = app.Hostname()
+		}
+		if len(origins) == 0 {
+			origins = []string{app.Scheme + "://" + app.Host}
+		}
+	}
+	if rpID == "" {
+		return b, nil
+	}
+
+	name := wc.GetRpDisplayName()
+	if name == "" {
+		name = defaultRPDisplayName
+	}
+	timeout := webauthn.TimeoutConfig{Enforce: true, Timeout: b.timeout, TimeoutUVD: b.timeout}
+
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          rpID,
+		RPDisplayName: name,
+		RPOrigins:     origins,
+		// Passkeys replace both the password and the second factor, so
+		// they must be discoverable and verify the user.
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			UserVerification:   protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{Login: timeout, Registration: timeout},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webauthn: %w", err)
+	}
+	b.webauthn = w
+
+	return b, nil
+}
+
+// BeginRegistration starts registering a passkey for the user.
+func (b *PasskeyBiz) BeginRegistration(ctx context.Context, userID uuid.UUID) (*WebAuthnSession, *protocol.CredentialCreation, error) {
+	if b.webauthn == nil {
+		return nil, nil, authv1.ErrorPasskeysDisabled("passkeys are not configured")
+	}
+
+	u, err := b.passkeyUser(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Authenticators that already hold a passkey of the user refuse to
+	// create another one.
+	exclude := webauthn.Credentials(u.WebAuthnCredentials()).CredentialDescriptors()
+	creation, data, err := b.webauthn.BeginRegistration(u, webauthn.WithExclusions(exclude))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s, err := b.saveSession(ctx, userID, WebAuthnRegistration, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, creation, nil
+}
+
+// FinishRegistration verifies the authenticator's response and stores the
+// new passkey. response is the JSON encoded PublicKeyCredential.
+func (b *PasskeyBiz) FinishRegistration(ctx context.Context, userID, sessionID uuid.UUID, name string, response []byte) (*Passkey, error) {
+	if b.webauthn == nil {
+		return nil, authv1.ErrorPasskeysDisabled("passkeys are not configured")
+	}
+
+	s, err := b.consumeSession(ctx, sessionID, WebAuthnRegistration)
+	if err != nil {
+		return nil, err
+	}
+	if s.UserID != userID {
+		return nil, authv1.ErrorPasskeyVerificationFailed("passkey ceremony has expired")
+	}
+
+	u, err := b.passkeyUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, b.verificationFailed(ctx, err)
+	}
+
+	cred, err := b.webauthn.CreateCredential(u, s.Data, parsed)
+	if err != nil {
+		return nil, b.verificationFailed(ctx, err)
+	}
+
+	transports := make([]string, 0, len(cred.Transport))
+	for _, t := range cred.Transport {
+		transports = append(transports, string(t))
+	}
+
+	return b.repo.Save(ctx, &Passkey{
+		UserID:          userID,
+		Name:            name,
+		CredentialID:    cred.ID,
+		PublicKey:       cred.PublicKey,
+		AttestationType: cred.AttestationType,
+		AAGUID:          cred.Authenticator.AAGUID,
+		SignCount:       cred.Authenticator.SignCount,
+		Transports:      transports,
+		BackupEligible:  cred.Flags.BackupEligible,
+		BackupState:     cred.Flags.BackupState,
+	})
+}
+
+// List returns the user's passkeys.
+func (b *PasskeyBiz) List(ctx context.Context, userID uuid.UUID) ([]*Passkey, error) {
+	return b.repo.ListByUserID(ctx, userID)
+}
+
+// Delete removes one of the user's passkeys.
+func (b *PasskeyBiz) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	err := b.repo.Delete(ctx, userID, id)
+	if errors.Is(err, ErrNotFound) {
+		return authv1.ErrorPasskeyNotFound("passkey not found")
+	}
+	return err
+}
+
+// BeginLogin starts a login with any passkey the authenticator holds for
+// this relying party.
+func (b *PasskeyBiz) BeginLogin(ctx context.Context) (*WebAuthnSession, *protocol.CredentialAssertion, error) {
+	if b.webauthn == nil {
+		return nil, nil, authv1.ErrorPasskeysDisabled("passkeys are not configured")
+	}
+
+	assertion, data, err := b.webauthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s, err := b.saveSession(ctx, uuid.Nil, WebAuthnLogin, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, assertion, nil
+}
+
+// FinishLogin verifies the authenticator's assertion and returns the user
+// owning the passkey. response is the JSON encoded PublicKeyCredential.
+func (b *PasskeyBiz) FinishLogin(ctx context.Context, sessionID uuid.UUID, response []byte) (*Auth, error) {
+	if b.webauthn == nil {
+		return nil, authv1.ErrorPasskeysDisabled("passkeys are not configured")
+	}
+
+	s, err := b.consumeSession(ctx, sessionID, WebAuthnLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, b.verificationFailed(ctx, err)
+	}
+
+	var (
+		user    *User
+		passkey *Passkey
+	)
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		p, err := b.repo.FindByCredentialID(ctx, rawID)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(userHandle, p.UserID[:]) {
+			return nil, errors.New("user handle does not match the passkey")
+		}
+
+		u, err := b.userRepo.FindByID(ctx, p.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		passkey, user = p, u
+		return &passkeyUser{user: u, passkeys: []*Passkey{p}}, nil
+	}
+
+	cred, err := b.webauthn.ValidateDiscoverableLogin(handler, s.Data, parsed)
+	if err != nil {
+		return nil, b.verificationFailed(ctx, err)
+	}
+	// A counter that went backwards means the private key was copied.
+	if cred.Authenticator.CloneWarning {
+		b.log.WithContext(ctx).Warnf("passkey %s of user %s may be cloned", passkey.ID, user.ID)
+		return nil, authv1.ErrorPasskeyVerificationFailed("passkey verification failed")
+	}
+
+	if err := b.repo.RecordUse(ctx, passkey.ID, cred.Authenticator.SignCount, cred.Flags.BackupState); err != nil {
+		return nil, err
+	}
+
+	if b.requireVerifiedEmail && user.EmailVerifiedAt == nil {
+		return nil, authv1.ErrorEmailNotVerified("email address has not been verified")
+	}
+
+	return &Auth{
+		ID:              user.ID,
+		Name:            user.Name,
+		Email:           user.Email,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+	}, nil
+}
+
+func (b *PasskeyBiz) passkeyUser(ctx context.Context, userID uuid.UUID) (*passkeyUser, error) {
+	user, err := b.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := b.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passkeyUser{user: user, passkeys: passkeys}, nil
+}
+
+func (b *PasskeyBiz) saveSession(ctx context.Context, userID uuid.UUID, ceremony WebAuthnCeremony, data *webauthn.SessionData) (*WebAuthnSession, error) {
+	return b.repo.SaveSession(ctx, &WebAuthnSession{
+		UserID:    userID,
+		Ceremony:  ceremony,
+		Data:      *data,
+		ExpiresAt: time.Now().UTC().Add(b.timeout),
+	})
+}
+
+func (b *PasskeyBiz) consumeSession(ctx context.Context, id uuid.UUID, ceremony WebAuthnCeremony) (*WebAuthnSession, error) {
+	s, err := b.repo.ConsumeSession(ctx, id, ceremony)
+	if errors.Is(err, ErrNotFound) {
+		return nil, authv1.ErrorPasskeyVerificationFailed("passkey ceremony has expired")
+	}
+	return s, err
+}
+
+// verificationFailed logs why the library rejected a response and hides the
+// details from the client.
+func (b *PasskeyBiz) verificationFailed(ctx context.Context, err error) error {
+	var perr *protocol.Error
+	if errors.As(err, &perr) {
+		b.log.WithContext(ctx).Warnf("passkey: %s: %s", perr.Details, perr.DevInfo)
+	} else {
+		b.log.WithContext(ctx).Warnf("passkey: %v", err)
+	}
+	return authv1.ErrorPasskeyVerificationFailed("passkey verification failed")
+}
+
+// passkeyUser adapts a user and their passkeys to the webauthn library. The
+// user handle is the user's ID.
+type passkeyUser struct {
+	user     *User
+	passkeys []*Passkey
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return u.user.ID[:]
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.user.Name
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, 0, len(u.passkeys))
+	for _, p := range u.passkeys {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(p.Transports))
+		for _, t := range p.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+
+		creds = append(creds, webauthn.Credential{
+			ID:              p.CredentialID,
+			PublicKey:       p.PublicKey,
+			AttestationType: p.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				UserPresent:    true,
+				UserVerified:   true,
+				BackupEligible: p.BackupEligible,
+				BackupState:    p.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    p.AAGUID,
+				SignCount: p.SignCount,
+			},
+		})
+	}
+	return creds
+}
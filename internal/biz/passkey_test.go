@@ -0,0 +1,231 @@
+package biz_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const (
+	testRPID   = "app.example.com"
+	testOrigin = "https://app.example.com"
+)
+
+// softAuthenticator is a platform authenticator holding a single P-256
+// passkey, producing the responses a browser would send.
+type softAuthenticator struct {
+	t          *testing.T
+	key        *ecdsa.PrivateKey
+	credential []byte
+	userHandle []byte
+	signCount  uint32
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credential := make([]byte, 32)
+	if _, err := rand.Read(credential); err != nil {
+		t.Fatal(err)
+	}
+	return &softAuthenticator{t: t, key: key, credential: credential}
+}
+
+// create answers navigator.credentials.create.
+func (a *softAuthenticator) create(challenge string, userHandle []byte) []byte {
+	a.t.Helper()
+	a.userHandle = userHandle
+
+	x, y := make([]byte, 32), make([]byte, 32)
+	a.key.X.FillBytes(x)
+	a.key.Y.FillBytes(y)
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: x,
+		YCoord: y,
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	// User present, user verified and attested credential data included.
+	authData := a.authData(0x45)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credential)))
+	authData = append(authData, a.credential...)
+	authData = append(authData, publicKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	return a.marshal(map[string]string{
+		"clientDataJSON":    b64(a.clientData("webauthn.create", challenge)),
+		"attestationObject": b64(attestation),
+	})
+}
+
+// get answers navigator.credentials.get.
+func (a *softAuthenticator) get(challenge string) []byte {
+	a.t.Helper()
+
+	a.signCount++
+	// User present and user verified.
+	authData := a.authData(0x05)
+	clientData := a.clientData("webauthn.get", challenge)
+	hash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, hash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	return a.marshal(map[string]string{
+		"clientDataJSON":    b64(clientData),
+		"authenticatorData": b64(authData),
+		"signature":         b64(signature),
+		"userHandle":        b64(a.userHandle),
+	})
+}
+
+func (a *softAuthenticator) authData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(testRPID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, a.signCount)
+}
+
+func (a *softAuthenticator) clientData(typ, challenge string) []byte {
+	data, err := json.Marshal(map[string]string{
+		"type":      typ,
+		"challenge": challenge,
+		"origin":    testOrigin,
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	return data
+}
+
+func (a *softAuthenticator) marshal(response map[string]string) []byte {
+	data, err := json.Marshal(map[string]any{
+		"id":       b64(a.credential),
+		"rawId":    b64(a.credential),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	return data
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func newTestPasskeyBiz(t *testing.T, users *fakeUserRepo) (*biz.PasskeyBiz, *fakePasskeyRepo) {
+	t.Helper()
+
+	repo := newFakePasskeyRepo()
+	b, err := biz.NewPasskeyBiz(repo, users, &conf.Auth{AppUrl: testOrigin}, log.NewHelper(log.DefaultLogger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b, repo
+}
+
+func registerPasskey(t *testing.T, b *biz.PasskeyBiz, user *biz.User, a *softAuthenticator) *biz.Passkey {
+	t.Helper()
+	ctx := context.Background()
+
+	s, _, err := b.BeginRegistration(ctx, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := b.FinishRegistration(ctx, user.ID, s.ID, "laptop", a.create(s.Data.Challenge, user.ID[:]))
+	if err != nil {
+		t.Fatalf("FinishRegistration: %v", err)
+	}
+	return p
+}
+
+func TestPasskeyRegisterAndLogin(t *testing.T) {
+	ctx := context.Background()
+	user := &biz.User{ID: uuid.Must(uuid.NewV7()), Name: "Ada", Email: "ada@example.com"}
+	b, repo := newTestPasskeyBiz(t, newFakeUserRepo(user))
+	a := newSoftAuthenticator(t)
+
+	p := registerPasskey(t, b, user, a)
+	if p.UserID != user.ID || p.Name != "laptop" {
+		t.Fatalf("passkey = %+v, want one named laptop for %s", p, user.ID)
+	}
+
+	s, _, err := b.BeginLogin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := a.get(s.Data.Challenge)
+	auth, err := b.FinishLogin(ctx, s.ID, response)
+	if err != nil {
+		t.Fatalf("FinishLogin: %v", err)
+	}
+	if auth.ID != user.ID {
+		t.Fatalf("logged in as %s, want %s", auth.ID, user.ID)
+	}
+	if got := repo.passkeys[0]; got.SignCount != 1 || got.LastUsedAt == nil {
+		t.Fatalf("use was not recorded: %+v", got)
+	}
+
+	// The ceremony is gone once finished, so the response cannot be replayed.
+	if _, err := b.FinishLogin(ctx, s.ID, response); !authv1.IsPasskeyVerificationFailed(err) {
+		t.Fatalf("replayed login: err = %v, want PASSKEY_VERIFICATION_FAILED", err)
+	}
+}
+
+func TestPasskeyLoginRejectsBadSignature(t *testing.T) {
+	ctx := context.Background()
+	user := &biz.User{ID: uuid.Must(uuid.NewV7()), Name: "Ada", Email: "ada@example.com"}
+	b, _ := newTestPasskeyBiz(t, newFakeUserRepo(user))
+	a := newSoftAuthenticator(t)
+	registerPasskey(t, b, user, a)
+
+	// A different key signs for the registered credential.
+	other := newSoftAuthenticator(t)
+	other.credential, other.userHandle = a.credential, a.userHandle
+
+	s, _, err := b.BeginLogin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.FinishLogin(ctx, s.ID, other.get(s.Data.Challenge)); !authv1.IsPasskeyVerificationFailed(err) {
+		t.Fatalf("err = %v, want PASSKEY_VERIFICATION_FAILED", err)
+	}
+}
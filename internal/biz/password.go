@@ -0,0 +1,223 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const PasswordResetTTL = 30 * time.Minute
+
+// PasswordBiz is a password usecase.
+type PasswordBiz struct {
+	tx          Transaction
+	authRepo    AuthRepo
+	userRepo    UserRepo
+	tokenRepo   UserTokenRepo
+	sessionRepo SessionRepo
+	refreshRepo RefreshTokenRepo
+	apiKeyRepo  APIKeyRepo
+	oauthRepo   OAuthRepo
+	throttle    *loginThrottler
+	mailLimit   *mailThrottler
+	mailer      Mailer
+	hasher      *PasswordHasher
+	policy      *PasswordPolicy
+	appURL      string
+	log         *log.Helper
+
+	revokeAPIKeys bool
+}
+
+// NewPasswordBiz new a password usecase.
+func NewPasswordBiz(
+	tx Transaction,
+	authRepo AuthRepo,
+	userRepo UserRepo,
+	tokenRepo UserTokenRepo,
+	sessionRepo SessionRepo,
+	refreshRepo RefreshTokenRepo,
+	apiKeyRepo APIKeyRepo,
+	oauthRepo OAuthRepo,
+	throttleRepo LoginThrottleRepo,
+	mailer Mailer,
+	hasher *PasswordHasher,
+	policy *PasswordPolicy,
+	c *conf.Auth,
+	logger *log.Helper,
+) *PasswordBiz {
+	return &PasswordBiz{
+		tx:          tx,
+		authRepo:    authRepo,
+		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		sessionRepo: sessionRepo,
+		refreshRepo: refreshRepo,
+		apiKeyRepo:  apiKeyRepo,
+		oauthRepo:   oauthRepo,
+		throttle:    newLoginThrottler(throttleRepo, c.GetLoginThrottle()),
+		mailLimit:   newMailThrottler(throttleRepo, c.GetMailThrottle()),
+		mailer:      mailer,
+		hasher:      hasher,
+		policy:      policy,
+		appURL:      c.GetAppUrl(),
+		log:         logger,
+
+		revokeAPIKeys: c.GetRevokeApiKeysOnPasswordChange(),
+	}
+}
+
+// RequestPasswordReset mails a reset link if the email belongs to a user. It
+// reports success either way so callers cannot probe for registered emails.
+// The user is looked up in the background too, so the response time does
+// not tell them apart either.
+func (b *PasswordBiz) RequestPasswordReset(ctx context.Context, email, ip string) error {
+	if err := b.mailLimit.allow(ctx, "password_reset", email, ip); err != nil {
+		return err
+	}
+
+	mailLater(ctx, b.log, func(ctx context.Context) error {
+		return b.sendPasswordReset(ctx, email)
+	})
+	return nil
+}
+
+func (b *PasswordBiz) sendPasswordReset(ctx context.Context, email string) error {
+	user, err := b.authRepo.FindByEmail(ctx, email)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := issueUserToken(ctx, b.tokenRepo, user.ID, PurposePasswordReset, PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+
+	return b.mailer.Send(ctx, &Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nUse the link below to choose a new password. It expires in %s.\n\n%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			user.Name, PasswordResetTTL, link(b.appURL, "/reset-password", token),
+		),
+	})
+}
+
+// ResetPassword sets a new password using a reset token and signs the user
+// out of every session.
+func (b *PasswordBiz) ResetPassword(ctx context.Context, token, newPassword string) error {
+	t, err := findUserToken(ctx, b.tokenRepo, token, PurposePasswordReset)
+	if err != nil {
+		return err
+	}
+
+	user, err := b.userRepo.FindByID(ctx, t.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Checked before the token is used up, so the user can try another
+	// password with the same link.
+	if err := b.policy.Validate(ctx, newPassword, user); err != nil {
+		return err
+	}
+
+	hash, err := b.hasher.Hash(newPassword)
+	if err != nil {
+		return err
+	}
+
+	// The token is only used up if the password is changed.
+	return b.tx.InTx(ctx, func(ctx context.Context) error {
+		if _, err := useUserToken(ctx, b.tokenRepo, t); err != nil {
+			return err
+		}
+		return b.setPassword(ctx, t.UserID, uuid.Nil, hash)
+	})
+}
+
+// ChangePassword sets a new password for a signed-in user who knows the
+// current one. Every session but the current one is revoked. Wrong current
+// passwords count as failed logins.
+func (b *PasswordBiz) ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, current, newPassword, ip string) error {
+	user, err := b.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := b.throttle.check(ctx, user.Email, ip); err != nil {
+		return err
+	}
+
+	ok, err := b.hasher.Verify(current, user.PasswordHash)
+	if err != nil {
+		b.log.WithContext(ctx).Errorf("verify password of user %s: %v", userID, err)
+		ok = false
+	}
+	if !ok {
+		if err := b.throttle.fail(ctx, user.Email, ip); err != nil {
+			return err
+		}
+		return authv1.ErrorInvalidCredentials("current password is incorrect")
+	}
+
+	if err := b.throttle.reset(ctx, user.Email); err != nil {
+		return err
+	}
+
+	if err := b.policy.Validate(ctx, newPassword, user); err != nil {
+		return err
+	}
+
+	hash, err := b.hasher.Hash(newPassword)
+	if err != nil {
+		return err
+	}
+
+	return b.setPassword(ctx, userID, sessionID, hash)
+}
+
+// setPassword stores the new hash in a single transaction and signs the
+// user out of every session but keep, which may be uuid.Nil. OAuth grants
+// are revoked too, and API keys if so configured, as whoever knew the old
+// password may have created them.
+func (b *PasswordBiz) setPassword(ctx context.Context, userID, keep uuid.UUID, hash string) error {
+	return b.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := b.userRepo.UpdatePassword(ctx, userID, hash); err != nil {
+			return err
+		}
+
+		if err := b.policy.Remember(ctx, userID, hash); err != nil {
+			return err
+		}
+
+		if err := b.sessionRepo.RevokeOthers(ctx, userID, keep); err != nil {
+			return err
+		}
+		if err := b.refreshRepo.RevokeOthers(ctx, userID, keep); err != nil {
+			return err
+		}
+		if b.revokeAPIKeys {
+			if err := b.apiKeyRepo.RevokeByUserID(ctx, userID); err != nil {
+				return err
+			}
+		}
+		return b.oauthRepo.DeleteGrants(ctx, userID, keep)
+	})
+}
+
+// link builds an app link carrying a mailed token.
+func link(appURL, path, token string) string {
+	return appURL + path + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/matthewhartstonge/argon2"
+
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const argon2Prefix = "$argon2"
+
+// Limits on the cost of Argon2 hashes that were not made here, such as
+// imported ones, so that a single login cannot exhaust the server. Hashes
+// made with the configured parameters are always accepted.
+const (
+	maxArgon2Memory      = 256 * 1024 // KiB
+	maxArgon2Iterations  = 16
+	maxArgon2Parallelism = 16
+)
+
+// ErrUnsupportedPasswordHash is returned for hashes no verifier recognizes.
+var ErrUnsupportedPasswordHash = errors.New("unsupported password hash format")
+
+// PasswordVerifier checks passwords against hashes made by another system,
+// such as the one users were imported from.
+type PasswordVerifier interface {
+	// Recognizes reports whether the hash is in the verifier's format and
+	// can be verified within its cost limits.
+	Recognizes(hash string) bool
+	Verify(password, hash string) (bool, error)
+}
+
+// PasswordHasher hashes passwords with the configured Argon2id parameters.
+// It also verifies the legacy formats of its verifiers; such hashes always
+// need a rehash.
+type PasswordHasher struct {
+	config    argon2.Config
+	verifiers []PasswordVerifier
+	dummy     func() []byte
+}
+
+// NewPasswordHasher new a PasswordHasher.
+func NewPasswordHasher(c *conf.Auth, verifiers []PasswordVerifier) *PasswordHasher {
+	def := argon2.DefaultConfig()
+	a := c.GetArgon2()
+
+	h := &PasswordHasher{
+		config: argon2.Config{
+			HashLength:  orDefault(a.GetKeyLength(), def.HashLength),
+			SaltLength:  orDefault(a.GetSaltLength(), def.SaltLength),
+			TimeCost:    orDefault(a.GetIterations(), def.TimeCost),
+			MemoryCost:  orDefault(a.GetMemory(), def.MemoryCost),
+			Parallelism: uint8(orDefault(a.GetParallelism(), uint32(def.Parallelism))),
+			Mode:        argon2.ModeArgon2id,
+			Version:     argon2.Version13,
+		},
+		verifiers: verifiers,
+	}
+	// Unknown emails are verified against this hash so that they cost as
+	// much as known ones.
+	h.dummy = sync.OnceValue(func() []byte {
+		hash, err := h.config.HashEncoded([]byte(rand.Text()))
+		if err != nil {
+			panic(err)
+		}
+		return hash
+	})
+
+	return h
+}
+
+// Hash returns the encoded hash of the password.
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	hash, err := h.config.HashEncoded([]byte(password))
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Verify reports whether the password matches the encoded hash.
+func (h *PasswordHasher) Verify(password, hash string) (bool, error) {
+	if strings.HasPrefix(hash, argon2Prefix) {
+		raw, err := h.decodeArgon2(hash)
+		if err != nil {
+			return false, err
+		}
+		return raw.Verify([]byte(password))
+	}
+
+	v := h.verifier(hash)
+	if v == nil {
+		return false, ErrUnsupportedPasswordHash
+	}
+	return v.Verify(password, hash)
+}
+
+// Recognizes reports whether the hash is in a format Verify supports.
+func (h *PasswordHasher) Recognizes(hash string) bool {
+	if strings.HasPrefix(hash, argon2Prefix) {
+		_, err := h.decodeArgon2(hash)
+		return err == nil
+	}
+	return h.verifier(hash) != nil
+}
+
+// NeedsRehash reports whether the hash was made with weaker parameters than
+// the current ones.
+func (h *PasswordHasher) NeedsRehash(hash string) bool {
+	raw, err := argon2.Decode([]byte(hash))
+	if err != nil {
+		return true
+	}
+
+	c := raw.Config
+	return c.Mode != h.config.Mode ||
+		c.Version < h.config.Version ||
+		c.MemoryCost < h.config.MemoryCost ||
+		c.TimeCost < h.config.TimeCost ||
+		c.Parallelism < h.config.Parallelism ||
+		c.HashLength < h.config.HashLength ||
+		uint32(len(raw.Salt)) < h.config.SaltLength
+}
+
+// decodeArgon2 decodes an Argon2 hash within the cost limits.
+func (h *PasswordHasher) decodeArgon2(hash string) (argon2.Raw, error) {
+	raw, err := argon2.Decode([]byte(hash))
+	if err != nil {
+		return raw, err
+	}
+
+	c := raw.Config
+	if c.MemoryCost > max(maxArgon2Memory, h.config.MemoryCost) ||
+		c.TimeCost > max(maxArgon2Iterations, h.config.TimeCost) ||
+		c.Parallelism > max(maxArgon2Parallelism, h.config.Parallelism) {
+		return raw, ErrUnsupportedPasswordHash
+	}
+	return raw, nil
+}
+
+func (h *PasswordHasher) verifier(hash string) PasswordVerifier {
+	for _, v := range h.verifiers {
+		if v.Recognizes(hash) {
+			return v
+		}
+	}
+	return nil
+}
+
+// dummyHash returns a hash no password is known for.
+func (h *PasswordHasher) dummyHash() string {
+	return string(h.dummy())
+}
@@ -0,0 +1,169 @@
+package biz
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const (
+	defaultPasswordMinLength = 8
+	// personalInfoMinLength keeps short names from rejecting most passwords.
+	personalInfoMinLength = 3
+)
+
+// PasswordHistoryRepo keeps the hashes of the passwords a user had.
+type PasswordHistoryRepo interface {
+	// ListRecent returns the user's last n hashes, newest first.
+	ListRecent(ctx context.Context, userID uuid.UUID, n int) ([]string, error)
+	// Add records the hash and forgets all but the last keep hashes.
+	Add(ctx context.Context, userID uuid.UUID, hash string, keep int) error
+}
+
+// PasswordPolicy checks new passwords against the configured rules.
+type PasswordPolicy struct {
+	c         *conf.PasswordPolicy
+	minLength int
+	blocklist map[string]struct{}
+	history   PasswordHistoryRepo
+	hasher    *PasswordHasher
+}
+
+// NewPasswordPolicy new a PasswordPolicy. It reads the blocklist file once.
+func NewPasswordPolicy(c *conf.Auth, history PasswordHistoryRepo, hasher *PasswordHasher) (*PasswordPolicy, error) {
+	p := &PasswordPolicy{
+		c:         c.GetPasswordPolicy(),
+		minLength: int(orDefault(c.GetPasswordPolicy().GetMinLength(), defaultPasswordMinLength)),
+		blocklist: map[string]struct{}{},
+		history:   history,
+		hasher:    hasher,
+	}
+
+	if path := p.c.GetBlocklistFile(); path != "" {
+		if err := p.loadBlocklist(path); err != nil {
+			return nil, fmt.Errorf("password blocklist: %w", err)
+		}
+	}
+
+	return p, nil
+}
+
+// Validate checks the password a user wants to set. user.ID may be zero for
+// users that do not exist yet, which skips the history check.
+func (p *PasswordPolicy) Validate(ctx context.Context, password string, user *User) error {
+	if utf8.RuneCountInString(password) < p.minLength {
+		return authv1.ErrorWeakPassword("password must be at least %d characters long", p.minLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsLetter(r):
+			// Letters without case, such as CJK, are not symbols.
+		default:
+			symbol = true
+		}
+	}
+	switch {
+	case p.c.GetRequireUpper() && !upper:
+		return authv1.ErrorWeakPassword("password must contain an uppercase letter")
+	case p.c.GetRequireLower() && !lower:
+		return authv1.ErrorWeakPassword("password must contain a lowercase letter")
+	case p.c.GetRequireDigit() && !digit:
+		return authv1.ErrorWeakPassword("password must contain a digit")
+	case p.c.GetRequireSymbol() && !symbol:
+		return authv1.ErrorWeakPassword("password must contain a symbol")
+	}
+
+	lowered := strings.ToLower(password)
+	if p.c.GetRejectPersonalInfo() && containsPersonalInfo(lowered, user) {
+		return authv1.ErrorWeakPassword("password must not contain your name or email")
+	}
+
+	if _, ok := p.blocklist[lowered]; ok {
+		return authv1.ErrorWeakPassword("password is too common")
+	}
+
+	return p.checkHistory(ctx, password, user)
+}
+
+// Remember records a password the user has just been given.
+func (p *PasswordPolicy) Remember(ctx context.Context, userID uuid.UUID, hash string) error {
+	if p.c.GetHistorySize() == 0 {
+		return nil
+	}
+	return p.history.Add(ctx, userID, hash, int(p.c.GetHistorySize()))
+}
+
+func (p *PasswordPolicy) checkHistory(ctx context.Context, password string, user *User) error {
+	n := int(p.c.GetHistorySize())
+	if n == 0 || user.ID == uuid.Nil {
+		return nil
+	}
+
+	hashes, err := p.history.ListRecent(ctx, user.ID, n)
+	if err != nil {
+		return err
+	}
+	// Users created before the history was kept only have their current
+	// password to compare with.
+	if user.PasswordHash != "" && len(hashes) == 0 {
+		hashes = append(hashes, user.PasswordHash)
+	}
+
+	for _, hash := range hashes {
+		ok, err := p.hasher.Verify(password, hash)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return authv1.ErrorPasswordReused("password was used recently")
+		}
+	}
+	return nil
+}
+
+func (p *PasswordPolicy) loadBlocklist(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if line := strings.TrimSpace(s.Text()); line != "" {
+			p.blocklist[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	return s.Err()
+}
+
+func containsPersonalInfo(password string, user *User) bool {
+	parts := strings.Fields(strings.ToLower(user.Name))
+	if local, _, ok := strings.Cut(user.Email, "@"); ok {
+		parts = append(parts, strings.ToLower(local))
+	}
+
+	for _, part := range parts {
+		if utf8.RuneCountInString(part) >= personalInfoMinLength && strings.Contains(password, part) {
+			return true
+		}
+	}
+	return false
+}
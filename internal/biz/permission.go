@@ -1,12 +1,58 @@
 package biz
 
+import "context"
+
+// GlobalDomain is the Casbin domain of methods that are not tenant-scoped.
+// Roles and permissions granted in it apply in every tenant.
+const GlobalDomain = "*"
+
 type PermissionChecker interface {
-	Can(sub, obj, act string) (bool, error)
+	Can(sub, dom, obj, act string) (bool, error)
+	// RolesFor returns the roles of the subject in the domain, including
+	// inherited ones.
+	RolesFor(sub, dom string) ([]string, error)
 }
 
 type PermissionManager interface {
-	GrantRole(userID, role string) error
-	RevokeRole(userID, role string) error
-	GrantPermission(subject, object, action string) error
-	RevokePermission(subject, object, action string) error
+	GrantRole(userID, role, dom string) error
+	RevokeRole(userID, role, dom string) error
+	GrantPermission(subject, dom, object, action string) error
+	RevokePermission(subject, dom, object, action string) error
+}
+
+// Permission is a policy rule: subject may do action on object in domain.
+type Permission struct {
+	Subject string
+	Domain  string
+	Object  string
+	Action  string
+}
+
+// PermissionReader lists what has been granted. Results are in no
+// particular order.
+type PermissionReader interface {
+	// Roles returns the roles that carry a permission or are granted to
+	// someone in the domain.
+	Roles(dom string) ([]string, error)
+	// RolesForUser returns the roles granted to the user in the domain.
+	RolesForUser(userID, dom string) ([]string, error)
+	// UsersForRole returns the users the role is granted to in the domain.
+	UsersForRole(role, dom string) ([]string, error)
+	// PermissionsForRole returns the permissions of the role that apply in
+	// the domain.
+	PermissionsForRole(role, dom string) ([]*Permission, error)
+	// ImplicitPermissionsForUser returns every permission the user has in
+	// the domain, directly or through roles.
+	ImplicitPermissionsForUser(userID, dom string) ([]*Permission, error)
+}
+
+// ManagedPolicyRepo records which policies come from the permission
+// annotations, so stale ones can be removed without touching the policies
+// granted by hand.
+type ManagedPolicyRepo interface {
+	List(context.Context) ([]*Permission, error)
+	// Add records permissions as managed, skipping those already recorded.
+	Add(context.Context, []*Permission) error
+	// Replace records permissions as the whole managed set.
+	Replace(context.Context, []*Permission) error
 }
@@ -0,0 +1,126 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+// Session is a Session model. Every login starts a new session and all tokens
+// issued for it carry its ID as the sid claim.
+type Session struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+}
+
+// SessionChecker reports whether a session can no longer be used.
+type SessionChecker interface {
+	IsRevoked(context.Context, uuid.UUID) (bool, error)
+	// Touch records that the session was used.
+	Touch(context.Context, uuid.UUID) error
+}
+
+// SessionRepo is a Session repo.
+type SessionRepo interface {
+	SessionChecker
+	Save(context.Context, *Session) (*Session, error)
+	FindByID(context.Context, uuid.UUID) (*Session, error)
+	// ListActive returns the user's sessions that are not revoked and were
+	// seen within RefreshTokenTTL, oldest first. Older sessions can no longer
+	// be refreshed.
+	ListActive(context.Context, uuid.UUID) ([]*Session, error)
+	Revoke(context.Context, uuid.UUID) error
+	RevokeByUserID(context.Context, uuid.UUID) error
+	// RevokeOthers revokes every session of the user except keep.
+	RevokeOthers(ctx context.Context, userID, keep uuid.UUID) error
+}
+
+// SessionBiz is a Session usecase.
+type SessionBiz struct {
+	repo        SessionRepo
+	refreshRepo RefreshTokenRepo
+	maxPerUser  int
+}
+
+// NewSessionBiz new a Session usecase.
+func NewSessionBiz(repo SessionRepo, refreshRepo RefreshTokenRepo, c *conf.Auth) *SessionBiz {
+	return &SessionBiz{
+		repo:        repo,
+		refreshRepo: refreshRepo,
+		maxPerUser:  int(c.GetSession().GetMaxPerUser()),
+	}
+}
+
+// Create starts a new session for the user. If that takes the user over the
+// session limit, their oldest sessions are revoked.
+func (b *SessionBiz) Create(ctx context.Context, s *Session) (*Session, error) {
+	s.ID = uuid.Must(uuid.NewV7())
+	session, err := b.repo.Save(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.maxPerUser > 0 {
+		active, err := b.repo.ListActive(ctx, s.UserID)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < len(active)-b.maxPerUser; i++ {
+			if active[i].ID == session.ID {
+				continue
+			}
+			if err := b.Logout(ctx, active[i].ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return session, nil
+}
+
+// List returns the user's active sessions, oldest first.
+func (b *SessionBiz) List(ctx context.Context, userID uuid.UUID) ([]*Session, error) {
+	return b.repo.ListActive(ctx, userID)
+}
+
+// Revoke signs out one of the user's sessions.
+func (b *SessionBiz) Revoke(ctx context.Context, userID, sessionID uuid.UUID) error {
+	s, err := b.repo.FindByID(ctx, sessionID)
+	if errors.Is(err, ErrNotFound) {
+		return authv1.ErrorSessionNotFound("session not found")
+	}
+	if err != nil {
+		return err
+	}
+	if s.UserID != userID || s.RevokedAt != nil {
+		return authv1.ErrorSessionNotFound("session not found")
+	}
+
+	return b.Logout(ctx, sessionID)
+}
+
+// Logout revokes a single session together with its refresh tokens.
+func (b *SessionBiz) Logout(ctx context.Context, sessionID uuid.UUID) error {
+	if err := b.repo.Revoke(ctx, sessionID); err != nil {
+		return err
+	}
+	return b.refreshRepo.RevokeBySessionID(ctx, sessionID)
+}
+
+// LogoutAll revokes every session of the user together with their refresh tokens.
+func (b *SessionBiz) LogoutAll(ctx context.Context, userID uuid.UUID) error {
+	if err := b.repo.RevokeByUserID(ctx, userID); err != nil {
+		return err
+	}
+	return b.refreshRepo.RevokeByUserID(ctx, userID)
+}
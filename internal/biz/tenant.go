@@ -0,0 +1,39 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	authzv1 "github.com/tencat-dev/go-base/api/authz/v1"
+)
+
+// Tenant is a Tenant model. Each tenant is a Casbin domain named by its ID.
+type Tenant struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+// TenantRepo is a Tenant repo.
+type TenantRepo interface {
+	Save(context.Context, *Tenant) (*Tenant, error)
+	FindByID(context.Context, uuid.UUID) (*Tenant, error)
+	List(context.Context) ([]*Tenant, error)
+}
+
+// findTenant returns the tenant with the ID given as a string.
+func findTenant(ctx context.Context, repo TenantRepo, tenant string) (*Tenant, error) {
+	id, err := uuid.Parse(tenant)
+	if err != nil {
+		return nil, authzv1.ErrorInvalidTenant("invalid tenant id")
+	}
+
+	t, err := repo.FindByID(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, authzv1.ErrorTenantNotFound("tenant not found")
+	}
+	return t, err
+}
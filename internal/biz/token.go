@@ -1,6 +1,7 @@
 package biz
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,24 +9,83 @@ import (
 
 type TokenMaker interface {
 	CreateAccessToken(payload AccessPayload) (string, error)
+	ParseAccessToken(token string) (*AccessPayload, error)
 	CreateRefreshToken(payload RefreshPayload) (string, error)
+	ParseRefreshToken(token string) (*RefreshPayload, error)
+	CreateMFAToken(payload MFAPayload) (string, error)
+	ParseMFAToken(token string) (*MFAPayload, error)
+	CreateIDToken(payload IDTokenPayload) (string, error)
+	// ParseToken verifies a token of any type.
+	ParseToken(token string) (*TokenInfo, error)
 }
 
 type AccessPayload struct {
 	UserID    uuid.UUID
 	SessionID uuid.UUID
+	// ClientID and Scopes are set on tokens issued to OAuth clients.
+	ClientID  string
+	Scopes    []string
+	ExpiresAt time.Time
 	TTL       time.Duration
+	// Actor is the user impersonating UserID, if any.
+	Actor uuid.UUID
+	// Tenant binds the token to one tenant. Tokens without it name the
+	// tenant per request instead.
+	Tenant string
+}
+
+// TokenInfo holds the claims of a verified token. SessionID is zero for
+// tokens that do not belong to a session, and ID is only set on refresh
+// tokens.
+type TokenInfo struct {
+	ID        uuid.UUID
+	Type      TokenType
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	ClientID  string
+	Scopes    []string
+	Actor     uuid.UUID
+	Tenant    string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
 }
 
 type RefreshPayload struct {
+	ID        uuid.UUID
 	UserID    uuid.UUID
 	SessionID uuid.UUID
-	TTL       time.Duration
+	// Tenant is carried over to the access tokens the refresh token is
+	// exchanged for.
+	Tenant string
+	TTL    time.Duration
+}
+
+// MFAPayload identifies a user who passed the password check but still has
+// to present a second factor. ID identifies the challenge.
+type MFAPayload struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	TTL    time.Duration
+}
+
+// IDTokenPayload is the OpenID Connect ID token handed to an OAuth client.
+// Name and Email are only included when set.
+type IDTokenPayload struct {
+	Issuer        string
+	UserID        uuid.UUID
+	ClientID      string
+	Nonce         string
+	AuthTime      time.Time
+	Name          string
+	Email         string
+	EmailVerified *bool
+	TTL           time.Duration
 }
 
 const (
 	AccessTokenTTL  = 15 * time.Minute
 	RefreshTokenTTL = 7 * 24 * time.Hour
+	MFATokenTTL     = 5 * time.Minute
 )
 
 type TokenType string
@@ -33,4 +93,35 @@ type TokenType string
 const (
 	AccessToken  TokenType = "access"
 	RefreshToken TokenType = "refresh"
+	MFAToken     TokenType = "mfa_pending"
 )
+
+// TokenPair is an access/refresh token pair handed out to a client.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// RefreshTokenRecord is an issued refresh token. All tokens rotated from the
+// same login share a SessionID, which is the token family.
+type RefreshTokenRecord struct {
+	ID        uuid.UUID
+	SessionID uuid.UUID
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// RefreshTokenRepo is a RefreshTokenRecord repo.
+type RefreshTokenRepo interface {
+	Save(context.Context, *RefreshTokenRecord) (*RefreshTokenRecord, error)
+	FindByID(context.Context, uuid.UUID) (*RefreshTokenRecord, error)
+	// Rotate marks the token as used and reports whether it was still active.
+	Rotate(context.Context, uuid.UUID) (bool, error)
+	RevokeBySessionID(context.Context, uuid.UUID) error
+	RevokeByUserID(context.Context, uuid.UUID) error
+	// RevokeOthers revokes the user's tokens of every session except keep.
+	RevokeOthers(ctx context.Context, userID, keep uuid.UUID) error
+}
@@ -0,0 +1,11 @@
+package biz
+
+import "context"
+
+// Transaction runs a unit of work in a single database transaction.
+type Transaction interface {
+	// InTx calls fn with a context that repos use to join the transaction.
+	// The transaction commits if fn returns nil and rolls back otherwise.
+	// Nested calls join the outer transaction.
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
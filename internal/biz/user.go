@@ -3,26 +3,36 @@ package biz
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/matthewhartstonge/argon2"
+
+	userv1 "github.com/tencat-dev/go-base/api/user/v1"
 )
 
 // User is a User model.
 type User struct {
-	ID           uuid.UUID `json:"id,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	Email        string    `json:"email,omitempty"`
-	PasswordHash string    `json:",omitempty"`
-	CreatedAt    time.Time `json:"created_at,omitempty"`
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	ID              uuid.UUID  `json:"id,omitempty"`
+	Name            string     `json:"name,omitempty"`
+	Email           string     `json:"email,omitempty"`
+	PasswordHash    string     `json:",omitempty"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at,omitempty"`
+	UpdatedAt       time.Time  `json:"updated_at,omitempty"`
 }
 
 // UserRepo is a Greater repo.
 type UserRepo interface {
 	Save(context.Context, *User) (*User, error)
+	// Import saves the users, skipping those whose email is taken, and
+	// returns the ones it saved.
+	Import(context.Context, []*User) ([]*User, error)
 	Update(context.Context, *User) (*User, error)
+	UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error
+	// MarkEmailVerified marks the user's email as verified if it is still
+	// the given address.
+	MarkEmailVerified(ctx context.Context, id uuid.UUID, email string) error
 	FindByID(context.Context, uuid.UUID) (*User, error)
 	ListAll(context.Context) ([]*User, error)
 	DeleteByID(context.Context, uuid.UUID) error
@@ -31,29 +41,66 @@ type UserRepo interface {
 
 // UserBiz is a User usecase.
 type UserBiz struct {
-	repo UserRepo
+	tx           Transaction
+	repo         UserRepo
+	throttleRepo LoginThrottleRepo
+	hasher       *PasswordHasher
+	policy       *PasswordPolicy
+	authz        PermissionChecker
 }
 
 // NewUserBiz new a User usecase.
-func NewUserBiz(repo UserRepo) *UserBiz {
-	return &UserBiz{repo: repo}
+func NewUserBiz(
+	tx Transaction,
+	repo UserRepo,
+	throttleRepo LoginThrottleRepo,
+	hasher *PasswordHasher,
+	policy *PasswordPolicy,
+	authz PermissionChecker,
+) *UserBiz {
+	return &UserBiz{
+		tx:           tx,
+		repo:         repo,
+		throttleRepo: throttleRepo,
+		hasher:       hasher,
+		policy:       policy,
+		authz:        authz,
+	}
 }
 
 // CreateUser creates a User, and returns the new User.
 func (b *UserBiz) CreateUser(ctx context.Context, u *User) (*User, error) {
-	argon := argon2.DefaultConfig()
-	passwordHash, err := argon.HashEncoded([]byte(u.PasswordHash))
+	if err := b.policy.Validate(ctx, u.PasswordHash, u); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := b.hasher.Hash(u.PasswordHash)
 	if err != nil {
 		return nil, err
 	}
 
-	u.PasswordHash = string(passwordHash)
+	u.PasswordHash = passwordHash
+
+	var user *User
+	err = b.tx.InTx(ctx, func(ctx context.Context) error {
+		user, err = b.repo.Save(ctx, u)
+		if err != nil {
+			return err
+		}
+		return b.policy.Remember(ctx, user.ID, passwordHash)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return b.repo.Save(ctx, u)
+	return user, nil
 }
 
-// UpdateUser creates a User, and returns the new User.
-func (b *UserBiz) UpdateUser(ctx context.Context, u *User) (*User, error) {
+// UpdateUser updates a User on behalf of actor, and returns the updated
+// User. Users updating their own record may only change the name, since
+// whoever holds a stolen token could otherwise take over the account by
+// changing its email.
+func (b *UserBiz) UpdateUser(ctx context.Context, actor uuid.UUID, u *User) (*User, error) {
 	user, err := b.repo.FindByID(ctx, u.ID)
 	if err != nil {
 		return nil, err
@@ -63,13 +110,76 @@ func (b *UserBiz) UpdateUser(ctx context.Context, u *User) (*User, error) {
 		user.Name = u.Name
 	}
 
-	if u.Email != "" {
+	if u.Email != "" && u.Email != user.Email {
+		if actor == user.ID {
+			allowed, err := b.authz.Can(actor.String(), GlobalDomain, "user", "update")
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				return nil, userv1.ErrorEmailChangeNotAllowed("changing your own email is not allowed")
+			}
+		}
 		user.Email = u.Email
+		// The new address has not been verified yet.
+		user.EmailVerifiedAt = nil
 	}
 
 	return b.repo.Update(ctx, user)
 }
 
+// ImportFailure tells why a user in an import was skipped.
+type ImportFailure struct {
+	Index  int
+	Email  string
+	Reason string
+}
+
+// ImportUsers creates users whose PasswordHash is already hashed, e.g. by the
+// system they come from. It returns how many were created and why the
+// others were skipped.
+func (b *UserBiz) ImportUsers(ctx context.Context, users []*User) (int, []ImportFailure, error) {
+	var (
+		failures []ImportFailure
+		valid    = make([]*User, 0, len(users))
+		index    = make(map[string]int, len(users))
+	)
+	for i, u := range users {
+		_, dup := index[u.Email]
+		switch {
+		case !b.hasher.Recognizes(u.PasswordHash):
+			failures = append(failures, ImportFailure{Index: i, Email: u.Email, Reason: "unsupported password hash format"})
+		case dup:
+			failures = append(failures, ImportFailure{Index: i, Email: u.Email, Reason: "duplicate email in request"})
+		default:
+			index[u.Email] = i
+			valid = append(valid, u)
+		}
+	}
+
+	if len(valid) == 0 {
+		return 0, failures, nil
+	}
+
+	imported, err := b.repo.Import(ctx, valid)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	saved := make(map[string]bool, len(imported))
+	for _, u := range imported {
+		saved[u.Email] = true
+	}
+	for _, u := range valid {
+		if !saved[u.Email] {
+			failures = append(failures, ImportFailure{Index: index[u.Email], Email: u.Email, Reason: "email already exists"})
+		}
+	}
+	slices.SortFunc(failures, func(a, b ImportFailure) int { return a.Index - b.Index })
+
+	return len(imported), failures, nil
+}
+
 // FindByID creates a User, and returns the new User.
 func (b *UserBiz) FindByID(ctx context.Context, id uuid.UUID) (*User, error) {
 	return b.repo.FindByID(ctx, id)
@@ -91,3 +201,16 @@ func (b *UserBiz) DeleteByID(ctx context.Context, id uuid.UUID) error {
 
 	return b.repo.DeleteByID(ctx, id)
 }
+
+// UnlockUser clears the failed login count and lockout of a user's account.
+func (b *UserBiz) UnlockUser(ctx context.Context, id uuid.UUID) error {
+	user, err := b.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := b.throttleRepo.DeleteByKey(ctx, accountThrottleKey(user.Email)); err != nil {
+		return err
+	}
+	return b.throttleRepo.DeleteByKey(ctx, mfaThrottleKey(user.Email))
+}
@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+)
+
+// TokenPurpose tells what a UserToken can be used for.
+type TokenPurpose string
+
+const (
+	PurposePasswordReset     TokenPurpose = "password_reset"
+	PurposeEmailVerification TokenPurpose = "email_verification"
+	PurposeFederatedLogin    TokenPurpose = "federated_login"
+	PurposeMagicLink         TokenPurpose = "magic_link"
+)
+
+// UserToken is a single-use token mailed to a user, e.g. in a password reset
+// link. Only a hash of the token is stored.
+type UserToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Purpose   TokenPurpose
+	TokenHash string
+	// Email is the address the token was mailed to, if it is bound to one.
+	Email     string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// UserTokenRepo is a UserToken repo.
+type UserTokenRepo interface {
+	Save(context.Context, *UserToken) (*UserToken, error)
+	FindByHash(context.Context, string) (*UserToken, error)
+	// Consume marks the token as used and reports whether it was still unused.
+	Consume(context.Context, uuid.UUID) (bool, error)
+	DeleteByUserID(ctx context.Context, userID uuid.UUID, purpose TokenPurpose) error
+}
+
+// issueUserToken replaces any outstanding token of the same purpose with a
+// new one and returns the raw token to send to the user.
+func issueUserToken(ctx context.Context, repo UserTokenRepo, userID uuid.UUID, purpose TokenPurpose, ttl time.Duration) (string, error) {
+	return issueEmailToken(ctx, repo, userID, "", purpose, ttl)
+}
+
+// issueEmailToken is issueUserToken for a token bound to the email address it
+// is mailed to. The token is only good while the user still has that address.
+func issueEmailToken(ctx context.Context, repo UserTokenRepo, userID uuid.UUID, email string, purpose TokenPurpose, ttl time.Duration) (string, error) {
+	if err := repo.DeleteByUserID(ctx, userID, purpose); err != nil {
+		return "", err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	_, err := repo.Save(ctx, &UserToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hashUserToken(token),
+		Email:     email,
+		ExpiresAt: time.Now().UTC().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// consumeUserToken checks the raw token and marks it as used, so it cannot be
+// presented a second time.
+func consumeUserToken(ctx context.Context, repo UserTokenRepo, token string, purpose TokenPurpose) (*UserToken, error) {
+	t, err := findUserToken(ctx, repo, token, purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	return useUserToken(ctx, repo, t)
+}
+
+// findUserToken returns the usable token matching the raw token without
+// using it up.
+func findUserToken(ctx context.Context, repo UserTokenRepo, token string, purpose TokenPurpose) (*UserToken, error) {
+	t, err := repo.FindByHash(ctx, hashUserToken(token))
+	if errors.Is(err, ErrNotFound) {
+		return nil, authv1.ErrorInvalidOneTimeToken("invalid or expired token")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Purpose != purpose || t.UsedAt != nil || time.Now().After(t.ExpiresAt) {
+		return nil, authv1.ErrorInvalidOneTimeToken("invalid or expired token")
+	}
+
+	return t, nil
+}
+
+// useUserToken marks a token returned by findUserToken as used.
+func useUserToken(ctx context.Context, repo UserTokenRepo, t *UserToken) (*UserToken, error) {
+	ok, err := repo.Consume(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, authv1.ErrorInvalidOneTimeToken("invalid or expired token")
+	}
+
+	return t, nil
+}
+
+func hashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomToken returns 32 random bytes, base64url encoded. It is long enough
+// to be used as a PKCE code verifier.
+func randomToken() string {
+	raw := make([]byte, 32)
+	_, _ = rand.Read(raw)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,6 +30,7 @@ type Bootstrap struct {
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Auth          *Auth                  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
 	Authz         *Authz                 `protobuf:"bytes,4,opt,name=authz,proto3" json:"authz,omitempty"`
+	Mail          *Mail                  `protobuf:"bytes,5,opt,name=mail,proto3" json:"mail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -91,6 +93,13 @@ func (x *Bootstrap) GetAuthz() *Authz {
 	return nil
 }
 
+func (x *Bootstrap) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *HTTPServer            `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -152,11 +161,13 @@ func (x *Server) GetPprof() *PprofServer {
 }
 
 type HTTPServer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
-	Network       string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enable  bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	Network string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Serve TLS instead of plaintext.
+	Tls           *TLS `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -219,12 +230,21 @@ func (x *HTTPServer) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *HTTPServer) GetTls() *TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
 type GRPCServer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
-	Network       string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enable  bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	Network string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Serve TLS instead of plaintext.
+	Tls           *TLS `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -287,6 +307,94 @@ func (x *GRPCServer) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *GRPCServer) GetTls() *TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+type TLS struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CertFile string                 `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile  string                 `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// PEM bundle of the CAs client certificates are verified against. Setting
+	// it turns on mutual TLS.
+	ClientCaFile string `protobuf:"bytes,3,opt,name=client_ca_file,json=clientCaFile,proto3" json:"client_ca_file,omitempty"`
+	// Refuse clients that do not present a certificate. Otherwise a
+	// certificate is only verified when one is presented.
+	RequireClientCert bool `protobuf:"varint,4,opt,name=require_client_cert,json=requireClientCert,proto3" json:"require_client_cert,omitempty"`
+	// How often the files are checked for changes. Defaults to 10s.
+	ReloadInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TLS) Reset() {
+	*x = TLS{}
+	mi := &file_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLS) ProtoMessage() {}
+
+func (x *TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLS.ProtoReflect.Descriptor instead.
+func (*TLS) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TLS) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *TLS) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *TLS) GetClientCaFile() string {
+	if x != nil {
+		return x.ClientCaFile
+	}
+	return ""
+}
+
+func (x *TLS) GetRequireClientCert() bool {
+	if x != nil {
+		return x.RequireClientCert
+	}
+	return false
+}
+
+func (x *TLS) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
 type PprofServer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
@@ -297,7 +405,7 @@ type PprofServer struct {
 
 func (x *PprofServer) Reset() {
 	*x = PprofServer{}
-	mi := &file_conf_proto_msgTypes[4]
+	mi := &file_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -309,7 +417,7 @@ func (x *PprofServer) String() string {
 func (*PprofServer) ProtoMessage() {}
 
 func (x *PprofServer) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[4]
+	mi := &file_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +430,7 @@ func (x *PprofServer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PprofServer.ProtoReflect.Descriptor instead.
 func (*PprofServer) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{4}
+	return file_conf_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PprofServer) GetEnable() bool {
@@ -349,7 +457,7 @@ type Data struct {
 
 func (x *Data) Reset() {
 	*x = Data{}
-	mi := &file_conf_proto_msgTypes[5]
+	mi := &file_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +469,7 @@ func (x *Data) String() string {
 func (*Data) ProtoMessage() {}
 
 func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[5]
+	mi := &file_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +482,7 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data.ProtoReflect.Descriptor instead.
 func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{5}
+	return file_conf_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Data) GetDatabase() *DatabaseConfig {
@@ -401,7 +509,7 @@ type DatabaseConfig struct {
 
 func (x *DatabaseConfig) Reset() {
 	*x = DatabaseConfig{}
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +521,7 @@ func (x *DatabaseConfig) String() string {
 func (*DatabaseConfig) ProtoMessage() {}
 
 func (x *DatabaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +534,7 @@ func (x *DatabaseConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatabaseConfig.ProtoReflect.Descriptor instead.
 func (*DatabaseConfig) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{6}
+	return file_conf_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DatabaseConfig) GetDriver() string {
@@ -455,7 +563,7 @@ type RedisConfig struct {
 
 func (x *RedisConfig) Reset() {
 	*x = RedisConfig{}
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +575,7 @@ func (x *RedisConfig) String() string {
 func (*RedisConfig) ProtoMessage() {}
 
 func (x *RedisConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +588,7 @@ func (x *RedisConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RedisConfig.ProtoReflect.Descriptor instead.
 func (*RedisConfig) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{7}
+	return file_conf_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RedisConfig) GetNetwork() string {
@@ -512,15 +620,33 @@ func (x *RedisConfig) GetWriteTimeout() *durationpb.Duration {
 }
 
 type Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jwt           *JWT                   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Jwt     *JWT                   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Session *Session               `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	// Base URL of the web app, used to build the links sent by email.
+	AppUrl string `protobuf:"bytes,3,opt,name=app_url,json=appUrl,proto3" json:"app_url,omitempty"`
+	// Refuse to log in users who have not verified their email address. Users
+	// created before email verification existed count as verified.
+	RequireVerifiedEmail bool            `protobuf:"varint,4,opt,name=require_verified_email,json=requireVerifiedEmail,proto3" json:"require_verified_email,omitempty"`
+	LoginThrottle        *LoginThrottle  `protobuf:"bytes,5,opt,name=login_throttle,json=loginThrottle,proto3" json:"login_throttle,omitempty"`
+	Oauth                *OAuth          `protobuf:"bytes,6,opt,name=oauth,proto3" json:"oauth,omitempty"`
+	Federation           *Federation     `protobuf:"bytes,7,opt,name=federation,proto3" json:"federation,omitempty"`
+	PasswordPolicy       *PasswordPolicy `protobuf:"bytes,8,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`
+	Argon2               *Argon2         `protobuf:"bytes,9,opt,name=argon2,proto3" json:"argon2,omitempty"`
+	Impersonation        *Impersonation  `protobuf:"bytes,10,opt,name=impersonation,proto3" json:"impersonation,omitempty"`
+	Webauthn             *WebAuthn       `protobuf:"bytes,11,opt,name=webauthn,proto3" json:"webauthn,omitempty"`
+	MagicLink            *MagicLink      `protobuf:"bytes,12,opt,name=magic_link,json=magicLink,proto3" json:"magic_link,omitempty"`
+	MailThrottle         *MailThrottle   `protobuf:"bytes,13,opt,name=mail_throttle,json=mailThrottle,proto3" json:"mail_throttle,omitempty"`
+	// Revoke the user's API keys when their password is changed or reset.
+	// Sessions, refresh tokens and OAuth grants are always revoked.
+	RevokeApiKeysOnPasswordChange bool `protobuf:"varint,14,opt,name=revoke_api_keys_on_password_change,json=revokeApiKeysOnPasswordChange,proto3" json:"revoke_api_keys_on_password_change,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
 }
 
 func (x *Auth) Reset() {
 	*x = Auth{}
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -532,7 +658,7 @@ func (x *Auth) String() string {
 func (*Auth) ProtoMessage() {}
 
 func (x *Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +671,7 @@ func (x *Auth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Auth.ProtoReflect.Descriptor instead.
 func (*Auth) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{8}
+	return file_conf_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Auth) GetJwt() *JWT {
@@ -555,28 +681,133 @@ func (x *Auth) GetJwt() *JWT {
 	return nil
 }
 
-type JWT struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+func (x *Auth) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *Auth) GetAppUrl() string {
+	if x != nil {
+		return x.AppUrl
+	}
+	return ""
+}
+
+func (x *Auth) GetRequireVerifiedEmail() bool {
+	if x != nil {
+		return x.RequireVerifiedEmail
+	}
+	return false
+}
+
+func (x *Auth) GetLoginThrottle() *LoginThrottle {
+	if x != nil {
+		return x.LoginThrottle
+	}
+	return nil
+}
+
+func (x *Auth) GetOauth() *OAuth {
+	if x != nil {
+		return x.Oauth
+	}
+	return nil
+}
+
+func (x *Auth) GetFederation() *Federation {
+	if x != nil {
+		return x.Federation
+	}
+	return nil
+}
+
+func (x *Auth) GetPasswordPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.PasswordPolicy
+	}
+	return nil
+}
+
+func (x *Auth) GetArgon2() *Argon2 {
+	if x != nil {
+		return x.Argon2
+	}
+	return nil
+}
+
+func (x *Auth) GetImpersonation() *Impersonation {
+	if x != nil {
+		return x.Impersonation
+	}
+	return nil
+}
+
+func (x *Auth) GetWebauthn() *WebAuthn {
+	if x != nil {
+		return x.Webauthn
+	}
+	return nil
+}
+
+func (x *Auth) GetMagicLink() *MagicLink {
+	if x != nil {
+		return x.MagicLink
+	}
+	return nil
+}
+
+func (x *Auth) GetMailThrottle() *MailThrottle {
+	if x != nil {
+		return x.MailThrottle
+	}
+	return nil
+}
+
+func (x *Auth) GetRevokeApiKeysOnPasswordChange() bool {
+	if x != nil {
+		return x.RevokeApiKeysOnPasswordChange
+	}
+	return false
+}
+
+// Rules new passwords must follow.
+type PasswordPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 8.
+	MinLength     uint32 `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	RequireUpper  bool   `protobuf:"varint,2,opt,name=require_upper,json=requireUpper,proto3" json:"require_upper,omitempty"`
+	RequireLower  bool   `protobuf:"varint,3,opt,name=require_lower,json=requireLower,proto3" json:"require_lower,omitempty"`
+	RequireDigit  bool   `protobuf:"varint,4,opt,name=require_digit,json=requireDigit,proto3" json:"require_digit,omitempty"`
+	RequireSymbol bool   `protobuf:"varint,5,opt,name=require_symbol,json=requireSymbol,proto3" json:"require_symbol,omitempty"`
+	// Reject passwords that contain the user's name or the local part of
+	// their email address.
+	RejectPersonalInfo bool `protobuf:"varint,6,opt,name=reject_personal_info,json=rejectPersonalInfo,proto3" json:"reject_personal_info,omitempty"`
+	// File of common or breached passwords, one per line, that are rejected
+	// regardless of case.
+	BlocklistFile string `protobuf:"bytes,7,opt,name=blocklist_file,json=blocklistFile,proto3" json:"blocklist_file,omitempty"`
+	// Reject the user's last N passwords. 0 disables the check.
+	HistorySize   uint32 `protobuf:"varint,8,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JWT) Reset() {
-	*x = JWT{}
-	mi := &file_conf_proto_msgTypes[9]
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	mi := &file_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JWT) String() string {
+func (x *PasswordPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JWT) ProtoMessage() {}
+func (*PasswordPolicy) ProtoMessage() {}
 
-func (x *JWT) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[9]
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,40 +818,101 @@ func (x *JWT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JWT.ProtoReflect.Descriptor instead.
-func (*JWT) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *JWT) GetSecret() string {
+func (x *PasswordPolicy) GetMinLength() uint32 {
 	if x != nil {
-		return x.Secret
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetRequireUpper() bool {
+	if x != nil {
+		return x.RequireUpper
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireLower() bool {
+	if x != nil {
+		return x.RequireLower
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireDigit() bool {
+	if x != nil {
+		return x.RequireDigit
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireSymbol() bool {
+	if x != nil {
+		return x.RequireSymbol
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRejectPersonalInfo() bool {
+	if x != nil {
+		return x.RejectPersonalInfo
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetBlocklistFile() string {
+	if x != nil {
+		return x.BlocklistFile
 	}
 	return ""
 }
 
-type Authz struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AutoSync      bool                   `protobuf:"varint,1,opt,name=auto_sync,json=autoSync,proto3" json:"auto_sync,omitempty"`
+func (x *PasswordPolicy) GetHistorySize() uint32 {
+	if x != nil {
+		return x.HistorySize
+	}
+	return 0
+}
+
+// Argon2id parameters for new password hashes. Hashes made with weaker
+// parameters are upgraded when their user logs in. Unset fields use the
+// RFC 9106 memory constrained defaults.
+type Argon2 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Memory in KiB. Defaults to 65536.
+	Memory uint32 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	// Defaults to 3.
+	Iterations uint32 `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// Defaults to 4.
+	Parallelism uint32 `protobuf:"varint,3,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// Defaults to 16.
+	SaltLength uint32 `protobuf:"varint,4,opt,name=salt_length,json=saltLength,proto3" json:"salt_length,omitempty"`
+	// Defaults to 32.
+	KeyLength     uint32 `protobuf:"varint,5,opt,name=key_length,json=keyLength,proto3" json:"key_length,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Authz) Reset() {
-	*x = Authz{}
-	mi := &file_conf_proto_msgTypes[10]
+func (x *Argon2) Reset() {
+	*x = Argon2{}
+	mi := &file_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Authz) String() string {
+func (x *Argon2) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Authz) ProtoMessage() {}
+func (*Argon2) ProtoMessage() {}
 
-func (x *Authz) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[10]
+func (x *Argon2) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,49 +923,1211 @@ func (x *Authz) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Authz.ProtoReflect.Descriptor instead.
-func (*Authz) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Argon2.ProtoReflect.Descriptor instead.
+func (*Argon2) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Authz) GetAutoSync() bool {
+func (x *Argon2) GetMemory() uint32 {
 	if x != nil {
-		return x.AutoSync
+		return x.Memory
 	}
-	return false
+	return 0
 }
 
-var File_conf_proto protoreflect.FileDescriptor
+func (x *Argon2) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
 
-const file_conf_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"conf.proto\x12\x04conf\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\"\x94\x01\n" +
-	"\tBootstrap\x12$\n" +
-	"\x06server\x18\x01 \x01(\v2\f.conf.ServerR\x06server\x12\x1e\n" +
-	"\x04data\x18\x02 \x01(\v2\n" +
-	".conf.DataR\x04data\x12\x1e\n" +
-	"\x04auth\x18\x03 \x01(\v2\n" +
-	".conf.AuthR\x04auth\x12!\n" +
-	"\x05authz\x18\x04 \x01(\v2\v.conf.AuthzR\x05authz\"}\n" +
-	"\x06Server\x12$\n" +
-	"\x04http\x18\x01 \x01(\v2\x10.conf.HTTPServerR\x04http\x12$\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x10.conf.GRPCServerR\x04grpc\x12'\n" +
-	"\x05pprof\x18\x03 \x01(\v2\x11.conf.PprofServerR\x05pprof\"\x8f\x01\n" +
-	"\n" +
-	"HTTPServer\x12\x16\n" +
-	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x18\n" +
-	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x1a\n" +
-	"\x04addr\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04addr\x123\n" +
-	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x8f\x01\n" +
-	"\n" +
-	"GRPCServer\x12\x16\n" +
-	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x18\n" +
-	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x1a\n" +
-	"\x04addr\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04addr\x123\n" +
-	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"A\n" +
-	"\vPprofServer\x12\x16\n" +
-	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x1a\n" +
+func (x *Argon2) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *Argon2) GetSaltLength() uint32 {
+	if x != nil {
+		return x.SaltLength
+	}
+	return 0
+}
+
+func (x *Argon2) GetKeyLength() uint32 {
+	if x != nil {
+		return x.KeyLength
+	}
+	return 0
+}
+
+// Support staff acting as another user through Impersonate.
+type Impersonation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lifetime of impersonation tokens, which cannot be refreshed. Defaults
+	// to 15m.
+	TokenTtl *durationpb.Duration `protobuf:"bytes,1,opt,name=token_ttl,json=tokenTtl,proto3" json:"token_ttl,omitempty"`
+	// Operations refused while impersonating, as full method names such as
+	// /auth.v1.AuthService/ChangePassword. Defaults to password, MFA, passkey,
+	// API key, sign-out-everywhere and role changes. Impersonate, SwitchTenant
+	// and OAuth Authorize are always refused.
+	BlockedOperations []string `protobuf:"bytes,2,rep,name=blocked_operations,json=blockedOperations,proto3" json:"blocked_operations,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Impersonation) Reset() {
+	*x = Impersonation{}
+	mi := &file_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Impersonation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Impersonation) ProtoMessage() {}
+
+func (x *Impersonation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Impersonation.ProtoReflect.Descriptor instead.
+func (*Impersonation) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Impersonation) GetTokenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.TokenTtl
+	}
+	return nil
+}
+
+func (x *Impersonation) GetBlockedOperations() []string {
+	if x != nil {
+		return x.BlockedOperations
+	}
+	return nil
+}
+
+// Sign-in with passkeys. Passkeys are disabled when no relying party ID can
+// be derived.
+type WebAuthn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Domain passkeys are bound to. Defaults to the host of app_url.
+	RpId string `protobuf:"bytes,1,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// Shown by the authenticator. Defaults to go-base.
+	RpDisplayName string `protobuf:"bytes,2,opt,name=rp_display_name,json=rpDisplayName,proto3" json:"rp_display_name,omitempty"`
+	// Origins allowed to run ceremonies. Defaults to the origin of app_url.
+	RpOrigins []string `protobuf:"bytes,3,rep,name=rp_origins,json=rpOrigins,proto3" json:"rp_origins,omitempty"`
+	// How long the user has to finish a ceremony. Defaults to 5m.
+	Timeout       *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebAuthn) Reset() {
+	*x = WebAuthn{}
+	mi := &file_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebAuthn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthn) ProtoMessage() {}
+
+func (x *WebAuthn) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthn.ProtoReflect.Descriptor instead.
+func (*WebAuthn) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WebAuthn) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *WebAuthn) GetRpDisplayName() string {
+	if x != nil {
+		return x.RpDisplayName
+	}
+	return ""
+}
+
+func (x *WebAuthn) GetRpOrigins() []string {
+	if x != nil {
+		return x.RpOrigins
+	}
+	return nil
+}
+
+func (x *WebAuthn) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// Passwordless sign-in through a link sent by email.
+type MagicLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lifetime of a link. Defaults to 15m.
+	Ttl *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Links that can be requested for one email address within window.
+	// Defaults to 5.
+	MaxRequests uint32 `protobuf:"varint,2,opt,name=max_requests,json=maxRequests,proto3" json:"max_requests,omitempty"`
+	// Defaults to 1h.
+	Window        *durationpb.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MagicLink) Reset() {
+	*x = MagicLink{}
+	mi := &file_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MagicLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MagicLink) ProtoMessage() {}
+
+func (x *MagicLink) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MagicLink.ProtoReflect.Descriptor instead.
+func (*MagicLink) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MagicLink) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *MagicLink) GetMaxRequests() uint32 {
+	if x != nil {
+		return x.MaxRequests
+	}
+	return 0
+}
+
+func (x *MagicLink) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// Limits on the mails unauthenticated requests can trigger: verification
+// links and password resets. Requests are counted per email address and per client
+// IP, for each kind of mail.
+type MailThrottle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mails that can be requested for one email address within window.
+	// Defaults to 5.
+	MaxPerEmail uint32 `protobuf:"varint,1,opt,name=max_per_email,json=maxPerEmail,proto3" json:"max_per_email,omitempty"`
+	// Mails that can be requested from one client IP within window. Defaults
+	// to 50.
+	MaxPerIp uint32 `protobuf:"varint,2,opt,name=max_per_ip,json=maxPerIp,proto3" json:"max_per_ip,omitempty"`
+	// Defaults to 1h.
+	Window        *durationpb.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MailThrottle) Reset() {
+	*x = MailThrottle{}
+	mi := &file_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MailThrottle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailThrottle) ProtoMessage() {}
+
+func (x *MailThrottle) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailThrottle.ProtoReflect.Descriptor instead.
+func (*MailThrottle) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MailThrottle) GetMaxPerEmail() uint32 {
+	if x != nil {
+		return x.MaxPerEmail
+	}
+	return 0
+}
+
+func (x *MailThrottle) GetMaxPerIp() uint32 {
+	if x != nil {
+		return x.MaxPerIp
+	}
+	return 0
+}
+
+func (x *MailThrottle) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// The built-in OAuth2 / OpenID Connect provider.
+type OAuth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Public base URL of this server, used as the iss of ID tokens. Setting
+	// it requires asymmetric jwt keys, as ID tokens are never signed with the
+	// shared secret.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Page of the web app that signs the user in and calls Authorize.
+	// Defaults to {app_url}/oauth/authorize.
+	LoginUrl string `protobuf:"bytes,2,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
+	// Lifetime of authorization codes. Defaults to 1m.
+	CodeTtl *durationpb.Duration `protobuf:"bytes,3,opt,name=code_ttl,json=codeTtl,proto3" json:"code_ttl,omitempty"`
+	// Lifetime of ID tokens. Defaults to 1h.
+	IdTokenTtl    *durationpb.Duration `protobuf:"bytes,4,opt,name=id_token_ttl,json=idTokenTtl,proto3" json:"id_token_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuth) Reset() {
+	*x = OAuth{}
+	mi := &file_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth) ProtoMessage() {}
+
+func (x *OAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth.ProtoReflect.Descriptor instead.
+func (*OAuth) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OAuth) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OAuth) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *OAuth) GetCodeTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CodeTtl
+	}
+	return nil
+}
+
+func (x *OAuth) GetIdTokenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.IdTokenTtl
+	}
+	return nil
+}
+
+// Sign-in through upstream OpenID Connect providers such as a company SSO.
+type Federation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Public base URL of this server. The redirect URI registered with each
+	// provider is {base_url}/auth/oidc/{id}/callback. Defaults to oauth.issuer.
+	BaseUrl string `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	// Page of the web app the browser returns to after a federated login,
+	// with either ?code= to pass to CompleteFederatedLogin or ?error=.
+	// Defaults to {app_url}/auth/callback.
+	ReturnUrl     string          `protobuf:"bytes,2,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"`
+	Providers     []*OIDCProvider `protobuf:"bytes,3,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Federation) Reset() {
+	*x = Federation{}
+	mi := &file_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Federation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Federation) ProtoMessage() {}
+
+func (x *Federation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Federation.ProtoReflect.Descriptor instead.
+func (*Federation) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Federation) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Federation) GetReturnUrl() string {
+	if x != nil {
+		return x.ReturnUrl
+	}
+	return ""
+}
+
+func (x *Federation) GetProviders() []*OIDCProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type OIDCProvider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Short name used in URLs, e.g. "okta".
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Shown on the login page. Defaults to id.
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer       string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId     string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// Defaults to openid, email and profile.
+	Scopes []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Create a local user the first time an unknown identity signs in.
+	AutoProvision bool `protobuf:"varint,7,opt,name=auto_provision,json=autoProvision,proto3" json:"auto_provision,omitempty"`
+	// Link an unknown identity to the local user with the same email, if the
+	// provider reports the email as verified.
+	LinkByEmail   bool `protobuf:"varint,8,opt,name=link_by_email,json=linkByEmail,proto3" json:"link_by_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCProvider) Reset() {
+	*x = OIDCProvider{}
+	mi := &file_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCProvider) ProtoMessage() {}
+
+func (x *OIDCProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCProvider.ProtoReflect.Descriptor instead.
+func (*OIDCProvider) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OIDCProvider) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OIDCProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OIDCProvider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OIDCProvider) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCProvider) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OIDCProvider) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OIDCProvider) GetAutoProvision() bool {
+	if x != nil {
+		return x.AutoProvision
+	}
+	return false
+}
+
+func (x *OIDCProvider) GetLinkByEmail() bool {
+	if x != nil {
+		return x.LinkByEmail
+	}
+	return false
+}
+
+// Failed logins are counted per account and per client IP. Wrong MFA codes
+// and wrong current passwords in ChangePassword count as failed logins;
+// MFA codes have their own per-account count.
+type LoginThrottle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Failures before an account is locked. Defaults to 10.
+	MaxAccountFailures uint32 `protobuf:"varint,1,opt,name=max_account_failures,json=maxAccountFailures,proto3" json:"max_account_failures,omitempty"`
+	// Failures before a client IP is locked. Defaults to 50.
+	MaxIpFailures uint32 `protobuf:"varint,2,opt,name=max_ip_failures,json=maxIpFailures,proto3" json:"max_ip_failures,omitempty"`
+	// Delay an account must wait after its first failure, doubled on every
+	// further failure. Defaults to 1s.
+	BaseDelay *durationpb.Duration `protobuf:"bytes,3,opt,name=base_delay,json=baseDelay,proto3" json:"base_delay,omitempty"`
+	// Upper bound of the delay. Defaults to 1m.
+	MaxDelay *durationpb.Duration `protobuf:"bytes,4,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	// How long a lock lasts. Defaults to 15m.
+	LockoutDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=lockout_duration,json=lockoutDuration,proto3" json:"lockout_duration,omitempty"`
+	// Failures are forgotten after this long without a new one. Defaults to 1h.
+	ResetAfter    *durationpb.Duration `protobuf:"bytes,6,opt,name=reset_after,json=resetAfter,proto3" json:"reset_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginThrottle) Reset() {
+	*x = LoginThrottle{}
+	mi := &file_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginThrottle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginThrottle) ProtoMessage() {}
+
+func (x *LoginThrottle) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginThrottle.ProtoReflect.Descriptor instead.
+func (*LoginThrottle) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LoginThrottle) GetMaxAccountFailures() uint32 {
+	if x != nil {
+		return x.MaxAccountFailures
+	}
+	return 0
+}
+
+func (x *LoginThrottle) GetMaxIpFailures() uint32 {
+	if x != nil {
+		return x.MaxIpFailures
+	}
+	return 0
+}
+
+func (x *LoginThrottle) GetBaseDelay() *durationpb.Duration {
+	if x != nil {
+		return x.BaseDelay
+	}
+	return nil
+}
+
+func (x *LoginThrottle) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return nil
+}
+
+func (x *LoginThrottle) GetLockoutDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LockoutDuration
+	}
+	return nil
+}
+
+func (x *LoginThrottle) GetResetAfter() *durationpb.Duration {
+	if x != nil {
+		return x.ResetAfter
+	}
+	return nil
+}
+
+type JWT struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shared HS256 secret, only used when no keys are configured.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Asymmetric keys. Every key that has not reached its retire_at is
+	// accepted for verification and published in the JWKS.
+	Keys []*JWTKey `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// The key that signs new tokens. Defaults to the first key. Once it
+	// reaches its retire_at, the first key with a private key that has not
+	// retired signs instead.
+	SigningKeyId string `protobuf:"bytes,3,opt,name=signing_key_id,json=signingKeyId,proto3" json:"signing_key_id,omitempty"`
+	// Set as iss on issued tokens and required when verifying them.
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Set as aud on issued tokens and required when verifying them.
+	Audience string `protobuf:"bytes,5,opt,name=audience,proto3" json:"audience,omitempty"`
+	// Clock skew allowed when checking exp, nbf and iat. Defaults to 0.
+	Leeway        *durationpb.Duration `protobuf:"bytes,6,opt,name=leeway,proto3" json:"leeway,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWT) Reset() {
+	*x = JWT{}
+	mi := &file_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWT) ProtoMessage() {}
+
+func (x *JWT) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWT.ProtoReflect.Descriptor instead.
+func (*JWT) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *JWT) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *JWT) GetKeys() []*JWTKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *JWT) GetSigningKeyId() string {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return ""
+}
+
+func (x *JWT) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *JWT) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *JWT) GetLeeway() *durationpb.Duration {
+	if x != nil {
+		return x.Leeway
+	}
+	return nil
+}
+
+type JWTKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sent as the kid header of tokens signed with this key.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// PEM encoded RSA, P-256 or Ed25519 private key.
+	PrivateKeyFile string `protobuf:"bytes,2,opt,name=private_key_file,json=privateKeyFile,proto3" json:"private_key_file,omitempty"`
+	// PEM encoded public key, for keys that only verify.
+	PublicKeyFile string                 `protobuf:"bytes,3,opt,name=public_key_file,json=publicKeyFile,proto3" json:"public_key_file,omitempty"`
+	RetireAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=retire_at,json=retireAt,proto3" json:"retire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWTKey) Reset() {
+	*x = JWTKey{}
+	mi := &file_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWTKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTKey) ProtoMessage() {}
+
+func (x *JWTKey) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTKey.ProtoReflect.Descriptor instead.
+func (*JWTKey) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *JWTKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JWTKey) GetPrivateKeyFile() string {
+	if x != nil {
+		return x.PrivateKeyFile
+	}
+	return ""
+}
+
+func (x *JWTKey) GetPublicKeyFile() string {
+	if x != nil {
+		return x.PublicKeyFile
+	}
+	return ""
+}
+
+func (x *JWTKey) GetRetireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetireAt
+	}
+	return nil
+}
+
+type Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long a session's revocation state is cached in-process. Defaults to 30s.
+	CacheTtl *durationpb.Duration `protobuf:"bytes,1,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"`
+	// Active sessions a user can have at once. Logging in beyond the limit
+	// signs out the oldest ones. Sessions not used within the refresh token
+	// lifetime no longer count. 0 means no limit.
+	MaxPerUser    uint32 `protobuf:"varint,2,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Session) GetCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+func (x *Session) GetMaxPerUser() uint32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+type Authz struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sync the policies of the authz.v1.permission annotations at startup.
+	AutoSync bool `protobuf:"varint,1,opt,name=auto_sync,json=autoSync,proto3" json:"auto_sync,omitempty"`
+	// Turn verified client certificates into Casbin subjects, so services
+	// can call protected methods without a token. The first match is used.
+	ClientCertificates []*CertificateIdentity `protobuf:"bytes,2,rep,name=client_certificates,json=clientCertificates,proto3" json:"client_certificates,omitempty"`
+	// How auto_sync treats the policies. add, the default, only adds missing
+	// ones. reconcile also removes the ones it added before that no annotation
+	// grants anymore. dry_run logs what reconcile would change. Both add and
+	// reconcile record what they add as managed; a policy that already
+	// existed stays unmanaged and is never removed. adopt records every
+	// annotation policy as managed: run it once when policies were added
+	// without being recorded, then switch to reconcile. Changes are not
+	// atomic: a reconcile that fails midway is completed by the next run.
+	// Unknown modes fail startup.
+	SyncMode string `protobuf:"bytes,3,opt,name=sync_mode,json=syncMode,proto3" json:"sync_mode,omitempty"`
+	// Replicas pass policy changes to each other with Postgres LISTEN/NOTIFY.
+	// They also reload the whole policy this often in case one was missed.
+	// Defaults to 5m.
+	ReloadInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"`
+	// How long an authorization decision is cached. A decision made while a
+	// change was being applied can be stale for at most this long. Defaults
+	// to 30s.
+	CacheTtl      *durationpb.Duration `protobuf:"bytes,5,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Authz) Reset() {
+	*x = Authz{}
+	mi := &file_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Authz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authz) ProtoMessage() {}
+
+func (x *Authz) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authz.ProtoReflect.Descriptor instead.
+func (*Authz) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Authz) GetAutoSync() bool {
+	if x != nil {
+		return x.AutoSync
+	}
+	return false
+}
+
+func (x *Authz) GetClientCertificates() []*CertificateIdentity {
+	if x != nil {
+		return x.ClientCertificates
+	}
+	return nil
+}
+
+func (x *Authz) GetSyncMode() string {
+	if x != nil {
+		return x.SyncMode
+	}
+	return ""
+}
+
+func (x *Authz) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+func (x *Authz) GetCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+type CertificateIdentity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The certificate field to read: uri and dns are subject alternative
+	// names, cn is the subject common name.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Only values starting with prefix match. It is removed from the value.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Put in front of the value to build the subject, e.g. "service:".
+	SubjectPrefix string `protobuf:"bytes,3,opt,name=subject_prefix,json=subjectPrefix,proto3" json:"subject_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateIdentity) Reset() {
+	*x = CertificateIdentity{}
+	mi := &file_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateIdentity) ProtoMessage() {}
+
+func (x *CertificateIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateIdentity.ProtoReflect.Descriptor instead.
+func (*CertificateIdentity) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CertificateIdentity) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CertificateIdentity) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *CertificateIdentity) GetSubjectPrefix() string {
+	if x != nil {
+		return x.SubjectPrefix
+	}
+	return ""
+}
+
+type Mail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// smtp, file or stdout. Defaults to stdout.
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	From   string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Smtp   *SMTP  `protobuf:"bytes,3,opt,name=smtp,proto3" json:"smtp,omitempty"`
+	// Directory the file driver writes .eml files to.
+	Dir           string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mail) Reset() {
+	*x = Mail{}
+	mi := &file_conf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail) ProtoMessage() {}
+
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Mail) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Mail) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Mail) GetSmtp() *SMTP {
+	if x != nil {
+		return x.Smtp
+	}
+	return nil
+}
+
+func (x *Mail) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type SMTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port          uint32                 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMTP) Reset() {
+	*x = SMTP{}
+	mi := &file_conf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMTP) ProtoMessage() {}
+
+func (x *SMTP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMTP.ProtoReflect.Descriptor instead.
+func (*SMTP) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SMTP) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SMTP) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SMTP) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SMTP) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+var File_conf_proto protoreflect.FileDescriptor
+
+const file_conf_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"conf.proto\x12\x04conf\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x01\n" +
+	"\tBootstrap\x12$\n" +
+	"\x06server\x18\x01 \x01(\v2\f.conf.ServerR\x06server\x12\x1e\n" +
+	"\x04data\x18\x02 \x01(\v2\n" +
+	".conf.DataR\x04data\x12\x1e\n" +
+	"\x04auth\x18\x03 \x01(\v2\n" +
+	".conf.AuthR\x04auth\x12!\n" +
+	"\x05authz\x18\x04 \x01(\v2\v.conf.AuthzR\x05authz\x12\x1e\n" +
+	"\x04mail\x18\x05 \x01(\v2\n" +
+	".conf.MailR\x04mail\"}\n" +
+	"\x06Server\x12$\n" +
+	"\x04http\x18\x01 \x01(\v2\x10.conf.HTTPServerR\x04http\x12$\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x10.conf.GRPCServerR\x04grpc\x12'\n" +
+	"\x05pprof\x18\x03 \x01(\v2\x11.conf.PprofServerR\x05pprof\"\xac\x01\n" +
+	"\n" +
+	"HTTPServer\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x18\n" +
+	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x1a\n" +
+	"\x04addr\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04addr\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x1b\n" +
+	"\x03tls\x18\x05 \x01(\v2\t.conf.TLSR\x03tls\"\xac\x01\n" +
+	"\n" +
+	"GRPCServer\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x18\n" +
+	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x1a\n" +
+	"\x04addr\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04addr\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x1b\n" +
+	"\x03tls\x18\x05 \x01(\v2\t.conf.TLSR\x03tls\"\xe7\x01\n" +
+	"\x03TLS\x12#\n" +
+	"\tcert_file\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bcertFile\x12!\n" +
+	"\bkey_file\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\akeyFile\x12$\n" +
+	"\x0eclient_ca_file\x18\x03 \x01(\tR\fclientCaFile\x12.\n" +
+	"\x13require_client_cert\x18\x04 \x01(\bR\x11requireClientCert\x12B\n" +
+	"\x0freload_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0ereloadInterval\"A\n" +
+	"\vPprofServer\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x1a\n" +
 	"\x04addr\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04addr\"a\n" +
 	"\x04Data\x120\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x14.conf.DatabaseConfigR\bdatabase\x12'\n" +
@@ -685,14 +2139,132 @@ const file_conf_proto_rawDesc = "" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"#\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"\xac\x05\n" +
 	"\x04Auth\x12\x1b\n" +
-	"\x03jwt\x18\x01 \x01(\v2\t.conf.JWTR\x03jwt\"%\n" +
-	"\x03JWT\x12\x1e\n" +
-	"\x06secret\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06secret\"$\n" +
+	"\x03jwt\x18\x01 \x01(\v2\t.conf.JWTR\x03jwt\x12'\n" +
+	"\asession\x18\x02 \x01(\v2\r.conf.SessionR\asession\x12\x17\n" +
+	"\aapp_url\x18\x03 \x01(\tR\x06appUrl\x124\n" +
+	"\x16require_verified_email\x18\x04 \x01(\bR\x14requireVerifiedEmail\x12:\n" +
+	"\x0elogin_throttle\x18\x05 \x01(\v2\x13.conf.LoginThrottleR\rloginThrottle\x12!\n" +
+	"\x05oauth\x18\x06 \x01(\v2\v.conf.OAuthR\x05oauth\x120\n" +
+	"\n" +
+	"federation\x18\a \x01(\v2\x10.conf.FederationR\n" +
+	"federation\x12=\n" +
+	"\x0fpassword_policy\x18\b \x01(\v2\x14.conf.PasswordPolicyR\x0epasswordPolicy\x12$\n" +
+	"\x06argon2\x18\t \x01(\v2\f.conf.Argon2R\x06argon2\x129\n" +
+	"\rimpersonation\x18\n" +
+	" \x01(\v2\x13.conf.ImpersonationR\rimpersonation\x12*\n" +
+	"\bwebauthn\x18\v \x01(\v2\x0e.conf.WebAuthnR\bwebauthn\x12.\n" +
+	"\n" +
+	"magic_link\x18\f \x01(\v2\x0f.conf.MagicLinkR\tmagicLink\x127\n" +
+	"\rmail_throttle\x18\r \x01(\v2\x12.conf.MailThrottleR\fmailThrottle\x12I\n" +
+	"\"revoke_api_keys_on_password_change\x18\x0e \x01(\bR\x1drevokeApiKeysOnPasswordChange\"\xc1\x02\n" +
+	"\x0ePasswordPolicy\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\rR\tminLength\x12#\n" +
+	"\rrequire_upper\x18\x02 \x01(\bR\frequireUpper\x12#\n" +
+	"\rrequire_lower\x18\x03 \x01(\bR\frequireLower\x12#\n" +
+	"\rrequire_digit\x18\x04 \x01(\bR\frequireDigit\x12%\n" +
+	"\x0erequire_symbol\x18\x05 \x01(\bR\rrequireSymbol\x120\n" +
+	"\x14reject_personal_info\x18\x06 \x01(\bR\x12rejectPersonalInfo\x12%\n" +
+	"\x0eblocklist_file\x18\a \x01(\tR\rblocklistFile\x12!\n" +
+	"\fhistory_size\x18\b \x01(\rR\vhistorySize\"\xac\x01\n" +
+	"\x06Argon2\x12\x16\n" +
+	"\x06memory\x18\x01 \x01(\rR\x06memory\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x02 \x01(\rR\n" +
+	"iterations\x12*\n" +
+	"\vparallelism\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xff\x01R\vparallelism\x12\x1f\n" +
+	"\vsalt_length\x18\x04 \x01(\rR\n" +
+	"saltLength\x12\x1d\n" +
+	"\n" +
+	"key_length\x18\x05 \x01(\rR\tkeyLength\"v\n" +
+	"\rImpersonation\x126\n" +
+	"\ttoken_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\btokenTtl\x12-\n" +
+	"\x12blocked_operations\x18\x02 \x03(\tR\x11blockedOperations\"\x9b\x01\n" +
+	"\bWebAuthn\x12\x13\n" +
+	"\x05rp_id\x18\x01 \x01(\tR\x04rpId\x12&\n" +
+	"\x0frp_display_name\x18\x02 \x01(\tR\rrpDisplayName\x12\x1d\n" +
+	"\n" +
+	"rp_origins\x18\x03 \x03(\tR\trpOrigins\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x8e\x01\n" +
+	"\tMagicLink\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12!\n" +
+	"\fmax_requests\x18\x02 \x01(\rR\vmaxRequests\x121\n" +
+	"\x06window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06window\"\x83\x01\n" +
+	"\fMailThrottle\x12\"\n" +
+	"\rmax_per_email\x18\x01 \x01(\rR\vmaxPerEmail\x12\x1c\n" +
+	"\n" +
+	"max_per_ip\x18\x02 \x01(\rR\bmaxPerIp\x121\n" +
+	"\x06window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06window\"\xaf\x01\n" +
+	"\x05OAuth\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tlogin_url\x18\x02 \x01(\tR\bloginUrl\x124\n" +
+	"\bcode_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\acodeTtl\x12;\n" +
+	"\fid_token_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"idTokenTtl\"x\n" +
+	"\n" +
+	"Federation\x12\x19\n" +
+	"\bbase_url\x18\x01 \x01(\tR\abaseUrl\x12\x1d\n" +
+	"\n" +
+	"return_url\x18\x02 \x01(\tR\treturnUrl\x120\n" +
+	"\tproviders\x18\x03 \x03(\v2\x12.conf.OIDCProviderR\tproviders\"\x95\x02\n" +
+	"\fOIDCProvider\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\xbaH\x11r\x0f2\r^[a-z0-9_-]+$R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\x06issuer\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06issuer\x12#\n" +
+	"\tclient_id\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bclientId\x12#\n" +
+	"\rclient_secret\x18\x05 \x01(\tR\fclientSecret\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12%\n" +
+	"\x0eauto_provision\x18\a \x01(\bR\rautoProvision\x12\"\n" +
+	"\rlink_by_email\x18\b \x01(\bR\vlinkByEmail\"\xdd\x02\n" +
+	"\rLoginThrottle\x120\n" +
+	"\x14max_account_failures\x18\x01 \x01(\rR\x12maxAccountFailures\x12&\n" +
+	"\x0fmax_ip_failures\x18\x02 \x01(\rR\rmaxIpFailures\x128\n" +
+	"\n" +
+	"base_delay\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\tbaseDelay\x126\n" +
+	"\tmax_delay\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bmaxDelay\x12D\n" +
+	"\x10lockout_duration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0flockoutDuration\x12:\n" +
+	"\vreset_after\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"resetAfter\"\xcc\x01\n" +
+	"\x03JWT\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12 \n" +
+	"\x04keys\x18\x02 \x03(\v2\f.conf.JWTKeyR\x04keys\x12$\n" +
+	"\x0esigning_key_id\x18\x03 \x01(\tR\fsigningKeyId\x12\x16\n" +
+	"\x06issuer\x18\x04 \x01(\tR\x06issuer\x12\x1a\n" +
+	"\baudience\x18\x05 \x01(\tR\baudience\x121\n" +
+	"\x06leeway\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x06leeway\"\xab\x01\n" +
+	"\x06JWTKey\x12\x16\n" +
+	"\x02id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12(\n" +
+	"\x10private_key_file\x18\x02 \x01(\tR\x0eprivateKeyFile\x12&\n" +
+	"\x0fpublic_key_file\x18\x03 \x01(\tR\rpublicKeyFile\x127\n" +
+	"\tretire_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bretireAt\"c\n" +
+	"\aSession\x126\n" +
+	"\tcache_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bcacheTtl\x12 \n" +
+	"\fmax_per_user\x18\x02 \x01(\rR\n" +
+	"maxPerUser\"\xb2\x02\n" +
 	"\x05Authz\x12\x1b\n" +
-	"\tauto_sync\x18\x01 \x01(\bR\bautoSyncBt\n" +
-	"\bcom.confB\tConfProtoP\x01Z-github.com/tencat-dev/go-base/internal/conf\xa2\x02\x03CXX\xaa\x02\x04Conf\xca\x02\x04Conf\xe2\x02\x10Conf\\GPBMetadata\xea\x02\x04Confb\x06proto3"
+	"\tauto_sync\x18\x01 \x01(\bR\bautoSync\x12J\n" +
+	"\x13client_certificates\x18\x02 \x03(\v2\x19.conf.CertificateIdentityR\x12clientCertificates\x12D\n" +
+	"\tsync_mode\x18\x03 \x01(\tB'\xbaH$r\"R\x00R\x03addR\treconcileR\adry_runR\x05adoptR\bsyncMode\x12B\n" +
+	"\x0freload_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0ereloadInterval\x126\n" +
+	"\tcache_ttl\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bcacheTtl\"\x81\x01\n" +
+	"\x13CertificateIdentity\x12+\n" +
+	"\x06source\x18\x01 \x01(\tB\x13\xbaH\x10r\x0eR\x03uriR\x03dnsR\x02cnR\x06source\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12%\n" +
+	"\x0esubject_prefix\x18\x03 \x01(\tR\rsubjectPrefix\"\x81\x01\n" +
+	"\x04Mail\x123\n" +
+	"\x06driver\x18\x01 \x01(\tB\x1b\xbaH\x18r\x16R\x00R\x04smtpR\x04fileR\x06stdoutR\x06driver\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x1e\n" +
+	"\x04smtp\x18\x03 \x01(\v2\n" +
+	".conf.SMTPR\x04smtp\x12\x10\n" +
+	"\x03dir\x18\x04 \x01(\tR\x03dir\"f\n" +
+	"\x04SMTP\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpasswordBr\n" +
+	"\bcom.confB\tConfProtoP\x01Z+github.com/tencat-dev/go-base/internal/conf\xa2\x02\x03CXX\xaa\x02\x04Conf\xca\x02\x04Conf\xe2\x02\x10Conf\\GPBMetadata\xea\x02\x04Confb\x06proto3"
 
 var (
 	file_conf_proto_rawDescOnce sync.Once
@@ -706,41 +2278,92 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: conf.Bootstrap
-	(*Server)(nil),              // 1: conf.Server
-	(*HTTPServer)(nil),          // 2: conf.HTTPServer
-	(*GRPCServer)(nil),          // 3: conf.GRPCServer
-	(*PprofServer)(nil),         // 4: conf.PprofServer
-	(*Data)(nil),                // 5: conf.Data
-	(*DatabaseConfig)(nil),      // 6: conf.DatabaseConfig
-	(*RedisConfig)(nil),         // 7: conf.RedisConfig
-	(*Auth)(nil),                // 8: conf.Auth
-	(*JWT)(nil),                 // 9: conf.JWT
-	(*Authz)(nil),               // 10: conf.Authz
-	(*durationpb.Duration)(nil), // 11: google.protobuf.Duration
+	(*Bootstrap)(nil),             // 0: conf.Bootstrap
+	(*Server)(nil),                // 1: conf.Server
+	(*HTTPServer)(nil),            // 2: conf.HTTPServer
+	(*GRPCServer)(nil),            // 3: conf.GRPCServer
+	(*TLS)(nil),                   // 4: conf.TLS
+	(*PprofServer)(nil),           // 5: conf.PprofServer
+	(*Data)(nil),                  // 6: conf.Data
+	(*DatabaseConfig)(nil),        // 7: conf.DatabaseConfig
+	(*RedisConfig)(nil),           // 8: conf.RedisConfig
+	(*Auth)(nil),                  // 9: conf.Auth
+	(*PasswordPolicy)(nil),        // 10: conf.PasswordPolicy
+	(*Argon2)(nil),                // 11: conf.Argon2
+	(*Impersonation)(nil),         // 12: conf.Impersonation
+	(*WebAuthn)(nil),              // 13: conf.WebAuthn
+	(*MagicLink)(nil),             // 14: conf.MagicLink
+	(*MailThrottle)(nil),          // 15: conf.MailThrottle
+	(*OAuth)(nil),                 // 16: conf.OAuth
+	(*Federation)(nil),            // 17: conf.Federation
+	(*OIDCProvider)(nil),          // 18: conf.OIDCProvider
+	(*LoginThrottle)(nil),         // 19: conf.LoginThrottle
+	(*JWT)(nil),                   // 20: conf.JWT
+	(*JWTKey)(nil),                // 21: conf.JWTKey
+	(*Session)(nil),               // 22: conf.Session
+	(*Authz)(nil),                 // 23: conf.Authz
+	(*CertificateIdentity)(nil),   // 24: conf.CertificateIdentity
+	(*Mail)(nil),                  // 25: conf.Mail
+	(*SMTP)(nil),                  // 26: conf.SMTP
+	(*durationpb.Duration)(nil),   // 27: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 28: google.protobuf.Timestamp
 }
 var file_conf_proto_depIdxs = []int32{
 	1,  // 0: conf.Bootstrap.server:type_name -> conf.Server
-	5,  // 1: conf.Bootstrap.data:type_name -> conf.Data
-	8,  // 2: conf.Bootstrap.auth:type_name -> conf.Auth
-	10, // 3: conf.Bootstrap.authz:type_name -> conf.Authz
-	2,  // 4: conf.Server.http:type_name -> conf.HTTPServer
-	3,  // 5: conf.Server.grpc:type_name -> conf.GRPCServer
-	4,  // 6: conf.Server.pprof:type_name -> conf.PprofServer
-	11, // 7: conf.HTTPServer.timeout:type_name -> google.protobuf.Duration
-	11, // 8: conf.GRPCServer.timeout:type_name -> google.protobuf.Duration
-	6,  // 9: conf.Data.database:type_name -> conf.DatabaseConfig
-	7,  // 10: conf.Data.redis:type_name -> conf.RedisConfig
-	11, // 11: conf.RedisConfig.read_timeout:type_name -> google.protobuf.Duration
-	11, // 12: conf.RedisConfig.write_timeout:type_name -> google.protobuf.Duration
-	9,  // 13: conf.Auth.jwt:type_name -> conf.JWT
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	6,  // 1: conf.Bootstrap.data:type_name -> conf.Data
+	9,  // 2: conf.Bootstrap.auth:type_name -> conf.Auth
+	23, // 3: conf.Bootstrap.authz:type_name -> conf.Authz
+	25, // 4: conf.Bootstrap.mail:type_name -> conf.Mail
+	2,  // 5: conf.Server.http:type_name -> conf.HTTPServer
+	3,  // 6: conf.Server.grpc:type_name -> conf.GRPCServer
+	5,  // 7: conf.Server.pprof:type_name -> conf.PprofServer
+	27, // 8: conf.HTTPServer.timeout:type_name -> google.protobuf.Duration
+	4,  // 9: conf.HTTPServer.tls:type_name -> conf.TLS
+	27, // 10: conf.GRPCServer.timeout:type_name -> google.protobuf.Duration
+	4,  // 11: conf.GRPCServer.tls:type_name -> conf.TLS
+	27, // 12: conf.TLS.reload_interval:type_name -> google.protobuf.Duration
+	7,  // 13: conf.Data.database:type_name -> conf.DatabaseConfig
+	8,  // 14: conf.Data.redis:type_name -> conf.RedisConfig
+	27, // 15: conf.RedisConfig.read_timeout:type_name -> google.protobuf.Duration
+	27, // 16: conf.RedisConfig.write_timeout:type_name -> google.protobuf.Duration
+	20, // 17: conf.Auth.jwt:type_name -> conf.JWT
+	22, // 18: conf.Auth.session:type_name -> conf.Session
+	19, // 19: conf.Auth.login_throttle:type_name -> conf.LoginThrottle
+	16, // 20: conf.Auth.oauth:type_name -> conf.OAuth
+	17, // 21: conf.Auth.federation:type_name -> conf.Federation
+	10, // 22: conf.Auth.password_policy:type_name -> conf.PasswordPolicy
+	11, // 23: conf.Auth.argon2:type_name -> conf.Argon2
+	12, // 24: conf.Auth.impersonation:type_name -> conf.Impersonation
+	13, // 25: conf.Auth.webauthn:type_name -> conf.WebAuthn
+	14, // 26: conf.Auth.magic_link:type_name -> conf.MagicLink
+	15, // 27: conf.Auth.mail_throttle:type_name -> conf.MailThrottle
+	27, // 28: conf.Impersonation.token_ttl:type_name -> google.protobuf.Duration
+	27, // 29: conf.WebAuthn.timeout:type_name -> google.protobuf.Duration
+	27, // 30: conf.MagicLink.ttl:type_name -> google.protobuf.Duration
+	27, // 31: conf.MagicLink.window:type_name -> google.protobuf.Duration
+	27, // 32: conf.MailThrottle.window:type_name -> google.protobuf.Duration
+	27, // 33: conf.OAuth.code_ttl:type_name -> google.protobuf.Duration
+	27, // 34: conf.OAuth.id_token_ttl:type_name -> google.protobuf.Duration
+	18, // 35: conf.Federation.providers:type_name -> conf.OIDCProvider
+	27, // 36: conf.LoginThrottle.base_delay:type_name -> google.protobuf.Duration
+	27, // 37: conf.LoginThrottle.max_delay:type_name -> google.protobuf.Duration
+	27, // 38: conf.LoginThrottle.lockout_duration:type_name -> google.protobuf.Duration
+	27, // 39: conf.LoginThrottle.reset_after:type_name -> google.protobuf.Duration
+	21, // 40: conf.JWT.keys:type_name -> conf.JWTKey
+	27, // 41: conf.JWT.leeway:type_name -> google.protobuf.Duration
+	28, // 42: conf.JWTKey.retire_at:type_name -> google.protobuf.Timestamp
+	27, // 43: conf.Session.cache_ttl:type_name -> google.protobuf.Duration
+	24, // 44: conf.Authz.client_certificates:type_name -> conf.CertificateIdentity
+	27, // 45: conf.Authz.reload_interval:type_name -> google.protobuf.Duration
+	27, // 46: conf.Authz.cache_ttl:type_name -> google.protobuf.Duration
+	26, // 47: conf.Mail.smtp:type_name -> conf.SMTP
+	48, // [48:48] is the sub-list for method output_type
+	48, // [48:48] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -754,7 +2377,7 @@ func file_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,169 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type apiKeyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAPIKeyRepo .
+func NewAPIKeyRepo(data *Data, logger *log.Helper) biz.APIKeyRepo {
+	return &apiKeyRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *apiKeyRepo) Save(ctx context.Context, k *biz.APIKey) (*biz.APIKey, error) {
+	setter := &models.APIKeySetter{
+		UserID:    omit.From(k.UserID),
+		Name:      omit.From(k.Name),
+		Prefix:    omit.From(k.Prefix),
+		KeyHash:   omit.From(k.KeyHash),
+		ExpiresAt: omitnull.FromPtr(k.ExpiresAt),
+	}
+
+	var inserted *models.APIKey
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		tx := r.data.conn(ctx)
+
+		var err error
+		inserted, err = models.APIKeys.Insert(setter).One(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if len(k.Scopes) == 0 {
+			return nil
+		}
+		scopes := make([]*models.APIKeyScopeSetter, 0, len(k.Scopes))
+		for _, s := range k.Scopes {
+			scopes = append(scopes, &models.APIKeyScopeSetter{
+				Object: omit.From(s.Object),
+				Action: omit.From(s.Action),
+			})
+		}
+		return inserted.InsertAPIKeyScopes(ctx, tx, scopes...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizAPIKey(inserted), nil
+}
+
+func (r *apiKeyRepo) FindByHash(ctx context.Context, hash string) (*biz.APIKey, error) {
+	k, err := models.APIKeys.Query(
+		models.SelectWhere.APIKeys.KeyHash.EQ(hash),
+		models.SelectThenLoad.APIKey.APIKeyScopes(),
+	).One(ctx, r.data.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizAPIKey(k), nil
+}
+
+func (r *apiKeyRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*biz.APIKey, error) {
+	keys, err := models.APIKeys.Query(
+		models.SelectWhere.APIKeys.UserID.EQ(userID),
+		models.SelectWhere.APIKeys.RevokedAt.IsNull(),
+		models.SelectThenLoad.APIKey.APIKeyScopes(),
+	).All(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*biz.APIKey, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, toBizAPIKey(k))
+	}
+
+	return out, nil
+}
+
+func (r *apiKeyRepo) Revoke(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	setter := &models.APIKeySetter{
+		RevokedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	rows, err := models.APIKeys.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.APIKeys.ID.EQ(id),
+		models.UpdateWhere.APIKeys.UserID.EQ(userID),
+		models.UpdateWhere.APIKeys.RevokedAt.IsNull(),
+	).Exec(ctx, r.data.db)
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func (r *apiKeyRepo) RevokeByUserID(ctx context.Context, userID uuid.UUID) error {
+	setter := &models.APIKeySetter{
+		RevokedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.APIKeys.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.APIKeys.UserID.EQ(userID),
+		models.UpdateWhere.APIKeys.RevokedAt.IsNull(),
+	).Exec(ctx, r.data.conn(ctx))
+
+	return err
+}
+
+func (r *apiKeyRepo) Touch(ctx context.Context, id uuid.UUID) error {
+	setter := &models.APIKeySetter{
+		LastUsedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.APIKeys.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.APIKeys.ID.EQ(id),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func toBizAPIKey(k *models.APIKey) *biz.APIKey {
+	scopes := make([]biz.APIKeyScope, 0, len(k.R.APIKeyScopes))
+	for _, s := range k.R.APIKeyScopes {
+		scopes = append(scopes, biz.APIKeyScope{
+			Object: s.Object,
+			Action: s.Action,
+		})
+	}
+
+	return &biz.APIKey{
+		ID:         k.ID,
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		KeyHash:    k.KeyHash,
+		Scopes:     scopes,
+		ExpiresAt:  k.ExpiresAt.Ptr(),
+		LastUsedAt: k.LastUsedAt.Ptr(),
+		RevokedAt:  k.RevokedAt.Ptr(),
+		CreatedAt:  k.CreatedAt,
+	}
+}
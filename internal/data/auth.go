@@ -2,6 +2,8 @@ package data
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
@@ -29,14 +31,18 @@ func (r *authRepo) FindByEmail(ctx context.Context, email string) (*biz.Auth, er
 	auth, err := models.Users.Query(
 		sm.Where(models.Users.Columns.Email.EQ(psql.Arg(email))),
 	).One(ctx, r.data.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &biz.Auth{
-		ID:           auth.ID,
-		Name:         auth.Name,
-		Email:        auth.Email,
-		PasswordHash: auth.PasswordHash,
+		ID:              auth.ID,
+		Name:            auth.Name,
+		Email:           auth.Email,
+		PasswordHash:    auth.PasswordHash,
+		EmailVerifiedAt: auth.EmailVerifiedAt.Ptr(),
 	}, nil
 }
@@ -1,16 +1,23 @@
 package data
 
 import (
+	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/casbin/casbin/v3"
+	"github.com/go-kratos/kratos/v2/log"
 	pgxadapter "github.com/noho-digital/casbin-pgx-adapter"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/conf"
 )
 
 var _ biz.PermissionChecker = (*CasbinAuthz)(nil)
 var _ biz.PermissionManager = (*CasbinAuthz)(nil)
+var _ biz.PermissionReader = (*CasbinAuthz)(nil)
 
 func NewPermissionChecker(c *CasbinAuthz) biz.PermissionChecker {
 	return c
@@ -18,58 +25,187 @@ func NewPermissionChecker(c *CasbinAuthz) biz.PermissionChecker {
 func NewPermissionManager(c *CasbinAuthz) biz.PermissionManager {
 	return c
 }
+func NewPermissionReader(c *CasbinAuthz) biz.PermissionReader {
+	return c
+}
 
 type CasbinAuthz struct {
 	enforcer casbin.IEnforcer
 }
 
-func NewCasbinEnforcer(data *Data) (casbin.IEnforcer, error) {
+func NewCasbinEnforcer(data *Data, c *conf.Authz, meterProvider metric.MeterProvider, logHelper *log.Helper) (casbin.IEnforcer, func(), error) {
 	adapter, err := pgxadapter.NewAdapterWithPool(data.db.Pool,
-		pgxadapter.WithTableName("casbin_rules"),        // Optional: custom table name
-		pgxadapter.WithIndex("ptype", "v0", "v1", "v2"), // policy: sub, obj, act
-		pgxadapter.WithIndex("ptype", "v0", "v1"),       // grouping: user -> role
+		pgxadapter.WithTableName("casbin_rules"),              // Optional: custom table name
+		pgxadapter.WithIndex("ptype", "v0", "v1", "v2", "v3"), // policy: sub, dom, obj, act
+		pgxadapter.WithIndex("ptype", "v0", "v1", "v2"),       // grouping: user -> role in dom
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create adapter: %v", err)
+		return nil, nil, fmt.Errorf("failed to create adapter: %v", err)
 	}
+	peer := &peerAdapter{BatchAdapter: adapter}
 
-	e, err := casbin.NewSyncedCachedEnforcer("configs/rbac_model.conf", adapter)
+	watcher, err := newPolicyWatcher(data.db.Pool, peer, c.GetReloadInterval().AsDuration(), meterProvider, logHelper)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if err = watcher.subscribe(context.Background()); err != nil {
+		return nil, nil, fmt.Errorf("listen for policy changes: %w", err)
+	}
+
+	e, err := casbin.NewSyncedCachedEnforcer("configs/rbac_model.conf", peer)
+	if err != nil {
+		watcher.Close()
+		return nil, nil, err
 	}
 
 	if err = e.LoadPolicy(); err != nil {
-		return nil, err
+		watcher.Close()
+		return nil, nil, err
 	}
 
+	// Clearing the cache after a change races with decisions being cached,
+	// so a stale one only lasts until it expires.
+	cacheTTL := c.GetCacheTtl().AsDuration()
+	if cacheTTL <= 0 {
+		cacheTTL = defaultCacheTTL
+	}
+	e.SetExpireTime(cacheTTL)
 	e.EnableAutoSave(true)
-	return e, nil
+	if err = e.SetWatcher(watcher); err != nil {
+		watcher.Close()
+		return nil, nil, err
+	}
+	watcher.start(e)
+
+	cleanup := func() {
+		log.Info("closing the authz policy watcher")
+		watcher.Close()
+	}
+
+	return e, cleanup, nil
 }
 
 func NewCasbinAuthz(enforcer casbin.IEnforcer) (*CasbinAuthz, error) {
 	return &CasbinAuthz{enforcer: enforcer}, nil
 }
 
-func (c *CasbinAuthz) Can(sub, obj, act string) (bool, error) {
-	return c.enforcer.Enforce(sub, obj, act)
+func (c *CasbinAuthz) Can(sub, dom, obj, act string) (bool, error) {
+	return c.enforcer.Enforce(sub, dom, obj, act)
+}
+
+func (c *CasbinAuthz) RolesFor(sub, dom string) ([]string, error) {
+	return c.enforcer.GetImplicitRolesForUser(sub, dom)
 }
 
-func (c *CasbinAuthz) GrantRole(userID, role string) error {
-	_, err := c.enforcer.AddGroupingPolicy(userID, role)
+func (c *CasbinAuthz) GrantRole(userID, role, dom string) error {
+	_, err := c.enforcer.AddGroupingPolicy(userID, role, dom)
 	return err
 }
 
-func (c *CasbinAuthz) RevokeRole(userID, role string) error {
-	_, err := c.enforcer.RemoveGroupingPolicy(userID, role)
+func (c *CasbinAuthz) RevokeRole(userID, role, dom string) error {
+	_, err := c.enforcer.RemoveGroupingPolicy(userID, role, dom)
 	return err
 }
 
-func (c *CasbinAuthz) GrantPermission(sub, obj, act string) error {
-	_, err := c.enforcer.AddPolicy(sub, obj, act)
+func (c *CasbinAuthz) GrantPermission(sub, dom, obj, act string) error {
+	_, err := c.enforcer.AddPolicy(sub, dom, obj, act)
 	return err
 }
 
-func (c *CasbinAuthz) RevokePermission(sub, obj, act string) error {
-	_, err := c.enforcer.RemovePolicy(sub, obj, act)
+func (c *CasbinAuthz) RevokePermission(sub, dom, obj, act string) error {
+	_, err := c.enforcer.RemovePolicy(sub, dom, obj, act)
 	return err
 }
+
+func (c *CasbinAuthz) Roles(dom string) ([]string, error) {
+	seen := make(map[string]struct{})
+
+	grants, err := c.enforcer.GetFilteredGroupingPolicy(2, dom)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range grants {
+		seen[g[1]] = struct{}{}
+	}
+
+	permissions, err := c.permissions(dom)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range permissions {
+		seen[p.Subject] = struct{}{}
+	}
+
+	return slices.Collect(maps.Keys(seen)), nil
+}
+
+func (c *CasbinAuthz) RolesForUser(userID, dom string) ([]string, error) {
+	grants, err := c.enforcer.GetFilteredGroupingPolicy(0, userID, "", dom)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]string, 0, len(grants))
+	for _, g := range grants {
+		roles = append(roles, g[1])
+	}
+	return roles, nil
+}
+
+func (c *CasbinAuthz) UsersForRole(role, dom string) ([]string, error) {
+	grants, err := c.enforcer.GetFilteredGroupingPolicy(1, role, dom)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]string, 0, len(grants))
+	for _, g := range grants {
+		users = append(users, g[0])
+	}
+	return users, nil
+}
+
+func (c *CasbinAuthz) PermissionsForRole(role, dom string) ([]*biz.Permission, error) {
+	return c.permissions(dom, role)
+}
+
+func (c *CasbinAuthz) ImplicitPermissionsForUser(userID, dom string) ([]*biz.Permission, error) {
+	// Mirror the matcher: roles granted in the domain or globally.
+	subjects := []string{userID}
+	for _, d := range []string{dom, biz.GlobalDomain} {
+		roles, err := c.enforcer.GetImplicitRolesForUser(userID, d)
+		if err != nil {
+			return nil, err
+		}
+		subjects = append(subjects, roles...)
+	}
+	slices.Sort(subjects)
+
+	return c.permissions(dom, slices.Compact(subjects)...)
+}
+
+// permissions returns the policies that apply in the domain, which are the
+// domain's own and the global ones, optionally only those of subjects.
+func (c *CasbinAuthz) permissions(dom string, subjects ...string) ([]*biz.Permission, error) {
+	policies, err := c.enforcer.GetPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*biz.Permission
+	for _, p := range policies {
+		if p[1] != dom && p[1] != biz.GlobalDomain {
+			continue
+		}
+		if len(subjects) > 0 && !slices.Contains(subjects, p[0]) {
+			continue
+		}
+		result = append(result, &biz.Permission{
+			Subject: p[0],
+			Domain:  p[1],
+			Object:  p[2],
+			Action:  p[3],
+		})
+	}
+	return result, nil
+}
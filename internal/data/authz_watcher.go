@@ -0,0 +1,426 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/casbin/casbin/v3/model"
+	"github.com/casbin/casbin/v3/persist"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	policyChannel         = "casbin_policy"
+	defaultReloadInterval = 5 * time.Minute
+	defaultCacheTTL       = 30 * time.Second
+	listenRetryDelay      = 5 * time.Second
+	// Postgres rejects NOTIFY payloads of 8000 bytes or more.
+	maxNotifyPayload = 8000
+)
+
+const (
+	policyAdd            = "add"
+	policyRemove         = "remove"
+	policyRemoveFiltered = "remove_filtered"
+	policyReload         = "reload"
+)
+
+var _ persist.WatcherEx = (*policyWatcher)(nil)
+
+// policyChange is the payload of a policy change notification.
+type policyChange struct {
+	Instance    string     `json:"instance"`
+	SentAt      time.Time  `json:"sent_at"`
+	Op          string     `json:"op"`
+	Sec         string     `json:"sec,omitempty"`
+	Ptype       string     `json:"ptype,omitempty"`
+	Rules       [][]string `json:"rules,omitempty"`
+	FieldIndex  int        `json:"field_index,omitempty"`
+	FieldValues []string   `json:"field_values,omitempty"`
+}
+
+// policyWatcher passes policy changes between replicas with Postgres
+// LISTEN/NOTIFY. Every change is published after it is saved, and the
+// changes of other replicas are applied to the local policy without being
+// saved again.
+type policyWatcher struct {
+	pool     *pgxpool.Pool
+	adapter  *peerAdapter
+	enforcer *casbin.SyncedCachedEnforcer
+	instance string
+	interval time.Duration
+	log      *log.Helper
+
+	lag     metric.Float64Histogram
+	applied metric.Int64Counter
+	reloads metric.Int64Counter
+
+	// conn is listening from subscribe until start hands it to listen.
+	conn   *pgx.Conn
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newPolicyWatcher(
+	pool *pgxpool.Pool,
+	adapter *peerAdapter,
+	interval time.Duration,
+	meterProvider metric.MeterProvider,
+	logger *log.Helper,
+) (*policyWatcher, error) {
+	if interval <= 0 {
+		interval = defaultReloadInterval
+	}
+
+	meter := meterProvider.Meter("github.com/tencat-dev/go-base/internal/data")
+
+	lag, err := meter.Float64Histogram("authz.policy.propagation.lag",
+		metric.WithDescription("Time from a policy change on one replica until another replica applied it."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := meter.Int64Counter("authz.policy.changes",
+		metric.WithDescription("Policy changes received from other replicas."),
+	)
+	if err != nil {
+		return nil, err
+	}
+	reloads, err := meter.Int64Counter("authz.policy.reloads",
+		metric.WithDescription("Full policy reloads."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policyWatcher{
+		pool:     pool,
+		adapter:  adapter,
+		instance: uuid.NewString(),
+		interval: interval,
+		log:      logger,
+		lag:      lag,
+		applied:  applied,
+		reloads:  reloads,
+	}, nil
+}
+
+// subscribe starts listening for the changes of other replicas. It is
+// called before the policy is loaded, so that no change made in between is
+// missed: those are applied once start is called.
+func (w *policyWatcher) subscribe(ctx context.Context) error {
+	conn, err := w.connect(ctx)
+	if err != nil {
+		return err
+	}
+	w.conn = conn
+	return nil
+}
+
+// start applies the changes of other replicas until Close is called.
+func (w *policyWatcher) start(e *casbin.SyncedCachedEnforcer) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.enforcer = e
+	w.cancel = cancel
+
+	conn := w.conn
+	w.conn = nil
+
+	w.wg.Add(2)
+	go w.listen(ctx, conn)
+	go w.reloadPeriodically(ctx)
+}
+
+func (w *policyWatcher) listen(ctx context.Context, conn *pgx.Conn) {
+	defer w.wg.Done()
+
+	for {
+		err := w.receive(ctx, conn)
+		_ = conn.Close(context.Background())
+		if ctx.Err() != nil {
+			return
+		}
+		w.log.Errorf("listen for policy changes: %v", err)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(listenRetryDelay):
+			}
+
+			if conn, err = w.connect(ctx); err == nil {
+				break
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			w.log.Errorf("listen for policy changes: %v", err)
+		}
+		// Changes made while the connection was down were missed.
+		w.reload(ctx, "reconnect")
+	}
+}
+
+// connect takes a connection out of the pool and listens on it.
+func (w *policyWatcher) connect(ctx context.Context) (*pgx.Conn, error) {
+	pooled, err := w.pool.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The connection keeps listening, so it must not go back to the pool.
+	conn := pooled.Hijack()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+policyChannel); err != nil {
+		_ = conn.Close(context.Background())
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (w *policyWatcher) receive(ctx context.Context, conn *pgx.Conn) error {
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		w.apply(ctx, n.Payload)
+	}
+}
+
+func (w *policyWatcher) reloadPeriodically(ctx context.Context) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.reload(ctx, "periodic")
+		}
+	}
+}
+
+func (w *policyWatcher) reload(ctx context.Context, reason string) {
+	w.reloads.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+	if err := w.enforcer.LoadPolicy(); err != nil {
+		w.log.Errorf("reload policy (%s): %v", reason, err)
+	}
+}
+
+// apply applies a change made by another replica. The policy is reloaded
+// when the change cannot be applied on its own.
+func (w *policyWatcher) apply(ctx context.Context, payload string) {
+	var c policyChange
+	if err := json.Unmarshal([]byte(payload), &c); err != nil {
+		w.log.Errorf("decode policy change: %v", err)
+		w.reload(ctx, "invalid")
+		return
+	}
+	if c.Instance == w.instance {
+		return
+	}
+
+	if err := w.applyChange(ctx, &c); err != nil {
+		w.log.Errorf("apply policy change %s: %v", c.Op, err)
+		w.reload(ctx, "failed")
+	}
+	if err := w.enforcer.InvalidateCache(); err != nil {
+		w.log.Errorf("invalidate authz cache: %v", err)
+	}
+
+	lag := time.Since(c.SentAt)
+	w.lag.Record(ctx, lag.Seconds(), metric.WithAttributes(attribute.String("op", c.Op)))
+	w.applied.Add(ctx, 1, metric.WithAttributes(attribute.String("op", c.Op)))
+	w.log.Debugf("applied policy change %s from %s after %s", c.Op, c.Instance, lag)
+}
+
+func (w *policyWatcher) applyChange(ctx context.Context, c *policyChange) error {
+	if c.Op == policyReload {
+		w.reloads.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", "peer")))
+		return w.enforcer.LoadPolicy()
+	}
+
+	// The replica that made the change has saved it already.
+	w.adapter.skip(c)
+	defer w.adapter.skip(nil)
+
+	var err error
+	switch c.Op {
+	case policyAdd:
+		_, err = w.enforcer.SelfAddPolicies(c.Sec, c.Ptype, c.Rules)
+	case policyRemove:
+		_, err = w.enforcer.SelfRemovePolicies(c.Sec, c.Ptype, c.Rules)
+	case policyRemoveFiltered:
+		_, err = w.enforcer.SelfRemoveFilteredPolicy(c.Sec, c.Ptype, c.FieldIndex, c.FieldValues...)
+	default:
+		err = fmt.Errorf("unknown op")
+	}
+	return err
+}
+
+// notify tells the other replicas about a change. It is called by the
+// enforcer after the change is saved, so failing to publish it is only
+// logged: the others catch up on their next reload.
+func (w *policyWatcher) notify(c *policyChange) error {
+	// The enforcer only drops the cached decision of a changed policy, not
+	// the decisions a changed role affects.
+	if w.enforcer != nil {
+		if err := w.enforcer.InvalidateCache(); err != nil {
+			w.log.Errorf("invalidate authz cache: %v", err)
+		}
+	}
+
+	c.Instance = w.instance
+	c.SentAt = time.Now().UTC()
+
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if len(payload) >= maxNotifyPayload {
+		payload, err = json.Marshal(&policyChange{Instance: c.Instance, SentAt: c.SentAt, Op: policyReload})
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := w.pool.Exec(ctx, "SELECT pg_notify($1, $2)", policyChannel, string(payload)); err != nil {
+		w.log.Errorf("notify policy change %s: %v", c.Op, err)
+	}
+	return nil
+}
+
+// SetUpdateCallback is not used: the watcher applies the changes itself.
+func (w *policyWatcher) SetUpdateCallback(func(string)) error {
+	return nil
+}
+
+func (w *policyWatcher) Update() error {
+	return w.notify(&policyChange{Op: policyReload})
+}
+
+func (w *policyWatcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	return w.notify(&policyChange{Op: policyAdd, Sec: sec, Ptype: ptype, Rules: [][]string{params}})
+}
+
+func (w *policyWatcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	return w.notify(&policyChange{Op: policyRemove, Sec: sec, Ptype: ptype, Rules: [][]string{params}})
+}
+
+func (w *policyWatcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return w.notify(&policyChange{
+		Op:          policyRemoveFiltered,
+		Sec:         sec,
+		Ptype:       ptype,
+		FieldIndex:  fieldIndex,
+		FieldValues: fieldValues,
+	})
+}
+
+func (w *policyWatcher) UpdateForSavePolicy(model.Model) error {
+	return w.notify(&policyChange{Op: policyReload})
+}
+
+func (w *policyWatcher) UpdateForAddPolicies(sec, ptype string, rules ...[]string) error {
+	return w.notify(&policyChange{Op: policyAdd, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+func (w *policyWatcher) UpdateForRemovePolicies(sec, ptype string, rules ...[]string) error {
+	return w.notify(&policyChange{Op: policyRemove, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+func (w *policyWatcher) Close() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+
+	if w.conn != nil {
+		_ = w.conn.Close(context.Background())
+		w.conn = nil
+	}
+}
+
+// peerAdapter does not save the change of another replica that is being
+// applied. Any other write goes to the wrapped adapter.
+type peerAdapter struct {
+	persist.BatchAdapter
+
+	mu      sync.Mutex
+	skipped *policyChange
+}
+
+func (a *peerAdapter) skip(c *policyChange) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.skipped = c
+}
+
+// skips reports whether the write is the change being applied. Writing the
+// same change again is not needed either way, as it is already saved.
+func (a *peerAdapter) skips(op, sec, ptype string, rules [][]string, fieldIndex int, fieldValues []string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	c := a.skipped
+	if c == nil || c.Op != op || c.Sec != sec || c.Ptype != ptype || c.FieldIndex != fieldIndex ||
+		!slices.Equal(c.FieldValues, fieldValues) ||
+		!slices.EqualFunc(c.Rules, rules, slices.Equal[[]string, string]) {
+		return false
+	}
+	a.skipped = nil
+	return true
+}
+
+func (a *peerAdapter) AddPolicy(sec, ptype string, rule []string) error {
+	if a.skips(policyAdd, sec, ptype, [][]string{rule}, 0, nil) {
+		return nil
+	}
+	return a.BatchAdapter.AddPolicy(sec, ptype, rule)
+}
+
+func (a *peerAdapter) AddPolicies(sec, ptype string, rules [][]string) error {
+	if a.skips(policyAdd, sec, ptype, rules, 0, nil) {
+		return nil
+	}
+	return a.BatchAdapter.AddPolicies(sec, ptype, rules)
+}
+
+func (a *peerAdapter) RemovePolicy(sec, ptype string, rule []string) error {
+	if a.skips(policyRemove, sec, ptype, [][]string{rule}, 0, nil) {
+		return nil
+	}
+	return a.BatchAdapter.RemovePolicy(sec, ptype, rule)
+}
+
+func (a *peerAdapter) RemovePolicies(sec, ptype string, rules [][]string) error {
+	if a.skips(policyRemove, sec, ptype, rules, 0, nil) {
+		return nil
+	}
+	return a.BatchAdapter.RemovePolicies(sec, ptype, rules)
+}
+
+func (a *peerAdapter) RemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	if a.skips(policyRemoveFiltered, sec, ptype, nil, fieldIndex, fieldValues) {
+		return nil
+	}
+	return a.BatchAdapter.RemoveFilteredPolicy(sec, ptype, fieldIndex, fieldValues...)
+}
@@ -16,12 +16,28 @@ import (
 // ProviderSetData is data providers.
 var ProviderSetData = wire.NewSet(
 	NewData,
+	NewTransaction,
 	NewCasbinEnforcer,
 	NewCasbinAuthz,
 	NewPermissionChecker,
 	NewPermissionManager,
+	NewPermissionReader,
 	NewUserRepo,
 	NewAuthRepo,
+	NewRefreshTokenRepo,
+	NewSessionRepo,
+	NewSessionChecker,
+	NewMFARepo,
+	NewUserTokenRepo,
+	NewLoginThrottleRepo,
+	NewAPIKeyRepo,
+	NewOAuthClientRepo,
+	NewOAuthRepo,
+	NewExternalIdentityRepo,
+	NewPasswordHistoryRepo,
+	NewPasskeyRepo,
+	NewTenantRepo,
+	NewManagedPolicyRepo,
 )
 
 // Data wraps database client.
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type externalIdentityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewExternalIdentityRepo .
+func NewExternalIdentityRepo(data *Data, logger *log.Helper) biz.ExternalIdentityRepo {
+	return &externalIdentityRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *externalIdentityRepo) FindBySubject(ctx context.Context, provider, subject string) (*biz.ExternalIdentity, error) {
+	identity, err := models.ExternalIdentities.Query(
+		models.SelectWhere.ExternalIdentities.Provider.EQ(provider),
+		models.SelectWhere.ExternalIdentities.Subject.EQ(subject),
+	).One(ctx, r.data.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizExternalIdentity(identity), nil
+}
+
+func (r *externalIdentityRepo) Save(ctx context.Context, e *biz.ExternalIdentity) (*biz.ExternalIdentity, error) {
+	inserted, err := models.ExternalIdentities.Insert(externalIdentitySetter(e)).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizExternalIdentity(inserted), nil
+}
+
+func (r *externalIdentityRepo) SaveWithUser(ctx context.Context, u *biz.User, e *biz.ExternalIdentity) (*biz.User, error) {
+	var user *models.User
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		tx := r.data.conn(ctx)
+
+		var err error
+		user, err = models.Users.Insert(&models.UserSetter{
+			Name:            omit.From(u.Name),
+			Email:           omit.From(u.Email),
+			PasswordHash:    omit.From(u.PasswordHash),
+			EmailVerifiedAt: omitnull.FromPtr(u.EmailVerifiedAt),
+		}).One(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		return user.InsertExternalIdentities(ctx, tx, externalIdentitySetter(e))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.ID = user.ID
+	u.CreatedAt = user.CreatedAt
+	u.UpdatedAt = user.UpdatedAt
+
+	return u, nil
+}
+
+func (r *externalIdentityRepo) Touch(ctx context.Context, id uuid.UUID, email string) error {
+	setter := &models.ExternalIdentitySetter{
+		Email:       omit.From(email),
+		LastLoginAt: omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.ExternalIdentities.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.ExternalIdentities.ID.EQ(id),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func externalIdentitySetter(e *biz.ExternalIdentity) *models.ExternalIdentitySetter {
+	return &models.ExternalIdentitySetter{
+		UserID:      omit.From(e.UserID),
+		Provider:    omit.From(e.Provider),
+		Subject:     omit.From(e.Subject),
+		Email:       omit.From(e.Email),
+		LastLoginAt: omitnull.From(time.Now().UTC()),
+	}
+}
+
+func toBizExternalIdentity(e *models.ExternalIdentity) *biz.ExternalIdentity {
+	return &biz.ExternalIdentity{
+		ID:          e.ID,
+		UserID:      e.UserID,
+		Provider:    e.Provider,
+		Subject:     e.Subject,
+		Email:       e.Email,
+		LastLoginAt: e.LastLoginAt.Ptr(),
+		CreatedAt:   e.CreatedAt,
+	}
+}
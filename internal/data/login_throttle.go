@@ -0,0 +1,99 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type loginThrottleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLoginThrottleRepo .
+func NewLoginThrottleRepo(data *Data, logger *log.Helper) biz.LoginThrottleRepo {
+	return &loginThrottleRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *loginThrottleRepo) FindByKey(ctx context.Context, key string) (*biz.LoginThrottle, error) {
+	t, err := models.FindLoginThrottle(ctx, r.data.db, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizLoginThrottle(t), nil
+}
+
+func (r *loginThrottleRepo) RecordFailure(ctx context.Context, key string, resetAfter time.Duration) (*biz.LoginThrottle, error) {
+	now := time.Now().UTC()
+	setter := &models.LoginThrottleSetter{
+		Key:           omit.From(key),
+		Failures:      omit.From(int32(1)),
+		LastFailureAt: omit.From(now),
+	}
+
+	// Counting happens in a single upsert so concurrent failures are not lost.
+	t, err := models.LoginThrottles.Insert(
+		setter,
+		im.OnConflict("key").DoUpdate(
+			im.SetCol("failures").To(psql.Raw(
+				"CASE WHEN login_throttles.last_failure_at < ? THEN 1 ELSE login_throttles.failures + 1 END",
+				now.Add(-resetAfter),
+			)),
+			im.SetExcluded("last_failure_at"),
+		),
+	).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizLoginThrottle(t), nil
+}
+
+func (r *loginThrottleRepo) Lock(ctx context.Context, key string, until time.Time) error {
+	setter := &models.LoginThrottleSetter{
+		LockedUntil: omitnull.From(until),
+	}
+
+	_, err := models.LoginThrottles.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.LoginThrottles.Key.EQ(key),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func (r *loginThrottleRepo) DeleteByKey(ctx context.Context, key string) error {
+	_, err := models.LoginThrottles.Delete(
+		models.DeleteWhere.LoginThrottles.Key.EQ(key),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func toBizLoginThrottle(t *models.LoginThrottle) *biz.LoginThrottle {
+	return &biz.LoginThrottle{
+		Key:           t.Key,
+		Failures:      t.Failures,
+		LastFailureAt: t.LastFailureAt,
+		LockedUntil:   t.LockedUntil.Ptr(),
+	}
+}
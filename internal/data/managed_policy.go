@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+)
+
+type managedPolicyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewManagedPolicyRepo .
+func NewManagedPolicyRepo(data *Data, logger *log.Helper) biz.ManagedPolicyRepo {
+	return &managedPolicyRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *managedPolicyRepo) List(ctx context.Context) ([]*biz.Permission, error) {
+	policies, err := models.AuthzManagedPolicies.Query().All(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.Permission, 0, len(policies))
+	for _, p := range policies {
+		result = append(result, &biz.Permission{
+			Subject: p.Subject,
+			Domain:  p.Domain,
+			Object:  p.Object,
+			Action:  p.Action,
+		})
+	}
+	return result, nil
+}
+
+func (r *managedPolicyRepo) Add(ctx context.Context, permissions []*biz.Permission) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	_, err := models.AuthzManagedPolicies.Insert(
+		bob.ToMods(managedPolicySetters(permissions)...),
+		im.OnConflict().DoNothing(),
+	).Exec(ctx, r.data.conn(ctx))
+	return err
+}
+
+func (r *managedPolicyRepo) Replace(ctx context.Context, permissions []*biz.Permission) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		tx := r.data.conn(ctx)
+
+		if _, err := models.AuthzManagedPolicies.Delete().Exec(ctx, tx); err != nil {
+			return err
+		}
+
+		if len(permissions) > 0 {
+			if _, err := models.AuthzManagedPolicies.Insert(bob.ToMods(managedPolicySetters(permissions)...)).Exec(ctx, tx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func managedPolicySetters(permissions []*biz.Permission) []*models.AuthzManagedPolicySetter {
+	setters := make([]*models.AuthzManagedPolicySetter, 0, len(permissions))
+	for _, p := range permissions {
+		setters = append(setters, &models.AuthzManagedPolicySetter{
+			Subject: omit.From(p.Subject),
+			Domain:  omit.From(p.Domain),
+			Object:  omit.From(p.Object),
+			Action:  omit.From(p.Action),
+		})
+	}
+	return setters
+}
@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type mfaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMFARepo .
+func NewMFARepo(data *Data, logger *log.Helper) biz.MFARepo {
+	return &mfaRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *mfaRepo) Save(ctx context.Context, m *biz.MFA) (*biz.MFA, error) {
+	setter := &models.UserMfaSetter{
+		UserID: omit.From(m.UserID),
+		Secret: omit.From(m.Secret),
+	}
+
+	inserted, err := models.UserMfas.Insert(setter).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizMFA(inserted), nil
+}
+
+func (r *mfaRepo) FindByUserID(ctx context.Context, userID uuid.UUID) (*biz.MFA, error) {
+	m, err := models.FindUserMfa(ctx, r.data.db, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizMFA(m), nil
+}
+
+func (r *mfaRepo) Enable(ctx context.Context, userID uuid.UUID, hashes []string) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		tx := r.data.conn(ctx)
+
+		_, err := models.MfaRecoveryCodes.Delete(
+			models.DeleteWhere.MfaRecoveryCodes.UserID.EQ(userID),
+		).Exec(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		setters := make([]*models.MfaRecoveryCodeSetter, 0, len(hashes))
+		for _, h := range hashes {
+			setters = append(setters, &models.MfaRecoveryCodeSetter{
+				UserID:   omit.From(userID),
+				CodeHash: omit.From(h),
+			})
+		}
+
+		_, err = models.MfaRecoveryCodes.Insert(bob.ToMods(setters...)).Exec(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		setter := &models.UserMfaSetter{
+			EnabledAt: omitnull.From(time.Now().UTC()),
+		}
+
+		_, err = models.UserMfas.Update(
+			setter.UpdateMod(),
+			models.UpdateWhere.UserMfas.UserID.EQ(userID),
+		).Exec(ctx, tx)
+		return err
+	})
+}
+
+func (r *mfaRepo) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := models.UserMfas.Delete(
+		models.DeleteWhere.UserMfas.UserID.EQ(userID),
+	).Exec(ctx, r.data.db)
+	if err != nil {
+		return err
+	}
+
+	_, err = models.MfaRecoveryCodes.Delete(
+		models.DeleteWhere.MfaRecoveryCodes.UserID.EQ(userID),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func (r *mfaRepo) UseStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	setter := &models.UserMfaSetter{
+		LastUsedStep: omit.From(step),
+	}
+
+	rows, err := models.UserMfas.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.UserMfas.UserID.EQ(userID),
+		models.UpdateWhere.UserMfas.LastUsedStep.LT(step),
+	).Exec(ctx, r.data.db)
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func (r *mfaRepo) ListUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]*biz.RecoveryCode, error) {
+	codes, err := models.MfaRecoveryCodes.Query(
+		models.SelectWhere.MfaRecoveryCodes.UserID.EQ(userID),
+		models.SelectWhere.MfaRecoveryCodes.UsedAt.IsNull(),
+	).All(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*biz.RecoveryCode, 0, len(codes))
+	for _, c := range codes {
+		out = append(out, &biz.RecoveryCode{
+			ID:       c.ID,
+			UserID:   c.UserID,
+			CodeHash: c.CodeHash,
+		})
+	}
+
+	return out, nil
+}
+
+func (r *mfaRepo) UseRecoveryCode(ctx context.Context, id uuid.UUID) (bool, error) {
+	setter := &models.MfaRecoveryCodeSetter{
+		UsedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	rows, err := models.MfaRecoveryCodes.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.MfaRecoveryCodes.ID.EQ(id),
+		models.UpdateWhere.MfaRecoveryCodes.UsedAt.IsNull(),
+	).Exec(ctx, r.data.db)
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func toBizMFA(m *models.UserMfa) *biz.MFA {
+	return &biz.MFA{
+		UserID:       m.UserID,
+		Secret:       m.Secret,
+		LastUsedStep: m.LastUsedStep,
+		EnabledAt:    m.EnabledAt.Ptr(),
+		CreatedAt:    m.CreatedAt,
+	}
+}
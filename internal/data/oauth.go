@@ -0,0 +1,252 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type oauthClientRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOAuthClientRepo .
+func NewOAuthClientRepo(data *Data, logger *log.Helper) biz.OAuthClientRepo {
+	return &oauthClientRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *oauthClientRepo) Save(ctx context.Context, c *biz.OAuthClient) (*biz.OAuthClient, error) {
+	setter := &models.OauthClientSetter{
+		ID:           omit.From(c.ID),
+		Name:         omit.From(c.Name),
+		SecretHash:   secretHash(c),
+		RedirectUris: omit.From(strings.Join(c.RedirectURIs, " ")),
+		Scopes:       omit.From(strings.Join(c.Scopes, " ")),
+	}
+
+	inserted, err := models.OauthClients.Insert(setter).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizOAuthClient(inserted), nil
+}
+
+func (r *oauthClientRepo) Update(ctx context.Context, c *biz.OAuthClient) (*biz.OAuthClient, error) {
+	setter := &models.OauthClientSetter{
+		Name:         omit.From(c.Name),
+		SecretHash:   secretHash(c),
+		RedirectUris: omit.From(strings.Join(c.RedirectURIs, " ")),
+		Scopes:       omit.From(strings.Join(c.Scopes, " ")),
+		UpdatedAt:    omit.From(time.Now().UTC()),
+	}
+
+	updated, err := models.OauthClients.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.OauthClients.ID.EQ(c.ID),
+	).One(ctx, r.data.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizOAuthClient(updated), nil
+}
+
+func (r *oauthClientRepo) FindByID(ctx context.Context, id string) (*biz.OAuthClient, error) {
+	c, err := models.FindOauthClient(ctx, r.data.db, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizOAuthClient(c), nil
+}
+
+func (r *oauthClientRepo) ListAll(ctx context.Context) ([]*biz.OAuthClient, error) {
+	clients, err := models.OauthClients.Query().All(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*biz.OAuthClient, 0, len(clients))
+	for _, c := range clients {
+		out = append(out, toBizOAuthClient(c))
+	}
+
+	return out, nil
+}
+
+func (r *oauthClientRepo) DeleteByID(ctx context.Context, id string) error {
+	_, err := models.OauthClients.Delete(
+		models.DeleteWhere.OauthClients.ID.EQ(id),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+type oauthRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOAuthRepo .
+func NewOAuthRepo(data *Data, logger *log.Helper) biz.OAuthRepo {
+	return &oauthRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *oauthRepo) SaveCode(ctx context.Context, c *biz.AuthorizationCode) error {
+	setter := &models.OauthAuthorizationCodeSetter{
+		CodeHash:      omit.From(c.CodeHash),
+		ClientID:      omit.From(c.ClientID),
+		UserID:        omit.From(c.UserID),
+		RedirectURI:   omit.From(c.RedirectURI),
+		Scopes:        omit.From(strings.Join(c.Scopes, " ")),
+		CodeChallenge: omit.From(c.CodeChallenge),
+		Nonce:         omit.From(c.Nonce),
+		AuthTime:      omit.From(c.AuthTime),
+		ExpiresAt:     omit.From(c.ExpiresAt),
+	}
+
+	_, err := models.OauthAuthorizationCodes.Insert(setter).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func (r *oauthRepo) FindCodeByHash(ctx context.Context, hash string) (*biz.AuthorizationCode, error) {
+	c, err := models.FindOauthAuthorizationCode(ctx, r.data.db, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.AuthorizationCode{
+		CodeHash:      c.CodeHash,
+		ClientID:      c.ClientID,
+		UserID:        c.UserID,
+		RedirectURI:   c.RedirectURI,
+		Scopes:        strings.Fields(c.Scopes),
+		CodeChallenge: c.CodeChallenge,
+		Nonce:         c.Nonce,
+		AuthTime:      c.AuthTime,
+		SessionID:     c.SessionID.Ptr(),
+		ExpiresAt:     c.ExpiresAt,
+		UsedAt:        c.UsedAt.Ptr(),
+	}, nil
+}
+
+func (r *oauthRepo) ConsumeCode(ctx context.Context, hash string, sessionID uuid.UUID) (bool, error) {
+	setter := &models.OauthAuthorizationCodeSetter{
+		SessionID: omitnull.From(sessionID),
+		UsedAt:    omitnull.From(time.Now().UTC()),
+	}
+
+	rows, err := models.OauthAuthorizationCodes.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.OauthAuthorizationCodes.CodeHash.EQ(hash),
+		models.UpdateWhere.OauthAuthorizationCodes.UsedAt.IsNull(),
+	).Exec(ctx, r.data.conn(ctx))
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func (r *oauthRepo) SaveGrant(ctx context.Context, g *biz.OAuthGrant) error {
+	setter := &models.OauthGrantSetter{
+		SessionID: omit.From(g.SessionID),
+		ClientID:  omit.From(g.ClientID),
+		Scopes:    omit.From(strings.Join(g.Scopes, " ")),
+		AuthTime:  omit.From(g.AuthTime),
+	}
+
+	_, err := models.OauthGrants.Insert(setter).Exec(ctx, r.data.conn(ctx))
+
+	return err
+}
+
+func (r *oauthRepo) FindGrantBySessionID(ctx context.Context, sessionID uuid.UUID) (*biz.OAuthGrant, error) {
+	g, err := models.FindOauthGrant(ctx, r.data.db, sessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.OAuthGrant{
+		SessionID: g.SessionID,
+		ClientID:  g.ClientID,
+		Scopes:    strings.Fields(g.Scopes),
+		AuthTime:  g.AuthTime,
+	}, nil
+}
+
+func (r *oauthRepo) DeleteGrants(ctx context.Context, userID, keep uuid.UUID) error {
+	sessions, err := models.Sessions.Query(
+		models.SelectWhere.Sessions.UserID.EQ(userID),
+		models.SelectWhere.Sessions.ID.NE(keep),
+	).All(ctx, r.data.conn(ctx))
+	if err != nil {
+		return err
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(sessions))
+	for _, s := range sessions {
+		ids = append(ids, s.ID)
+	}
+
+	_, err = models.OauthGrants.Delete(
+		models.DeleteWhere.OauthGrants.SessionID.In(ids...),
+	).Exec(ctx, r.data.conn(ctx))
+
+	return err
+}
+
+// secretHash leaves the column untouched for public clients.
+func secretHash(c *biz.OAuthClient) omitnull.Val[string] {
+	if !c.Confidential() {
+		return omitnull.Val[string]{}
+	}
+	return omitnull.From(c.SecretHash)
+}
+
+func toBizOAuthClient(c *models.OauthClient) *biz.OAuthClient {
+	return &biz.OAuthClient{
+		ID:           c.ID,
+		Name:         c.Name,
+		SecretHash:   c.SecretHash.GetOrZero(),
+		RedirectURIs: strings.Fields(c.RedirectUris),
+		Scopes:       strings.Fields(c.Scopes),
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
@@ -0,0 +1,191 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+)
+
+type passkeyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPasskeyRepo .
+func NewPasskeyRepo(data *Data, logger *log.Helper) biz.PasskeyRepo {
+	return &passkeyRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *passkeyRepo) Save(ctx context.Context, p *biz.Passkey) (*biz.Passkey, error) {
+	setter := &models.PasskeySetter{
+		UserID:          omit.From(p.UserID),
+		Name:            omit.From(p.Name),
+		CredentialID:    omit.From(p.CredentialID),
+		PublicKey:       omit.From(p.PublicKey),
+		AttestationType: omit.From(p.AttestationType),
+		Aaguid:          omit.From(p.AAGUID),
+		SignCount:       omit.From(int64(p.SignCount)),
+		Transports:      omit.From(strings.Join(p.Transports, " ")),
+		BackupEligible:  omit.From(p.BackupEligible),
+		BackupState:     omit.From(p.BackupState),
+	}
+
+	inserted, err := models.Passkeys.Insert(setter).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizPasskey(inserted), nil
+}
+
+func (r *passkeyRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*biz.Passkey, error) {
+	passkeys, err := models.Passkeys.Query(
+		models.SelectWhere.Passkeys.UserID.EQ(userID),
+		sm.OrderBy(models.Passkeys.Columns.CreatedAt),
+	).All(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.Passkey, 0, len(passkeys))
+	for _, p := range passkeys {
+		result = append(result, toBizPasskey(p))
+	}
+	return result, nil
+}
+
+func (r *passkeyRepo) FindByCredentialID(ctx context.Context, credentialID []byte) (*biz.Passkey, error) {
+	p, err := models.Passkeys.Query(
+		models.SelectWhere.Passkeys.CredentialID.EQ(credentialID),
+	).One(ctx, r.data.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizPasskey(p), nil
+}
+
+func (r *passkeyRepo) RecordUse(ctx context.Context, id uuid.UUID, signCount uint32, backupState bool) error {
+	setter := &models.PasskeySetter{
+		SignCount:   omit.From(int64(signCount)),
+		BackupState: omit.From(backupState),
+		LastUsedAt:  omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.Passkeys.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.Passkeys.ID.EQ(id),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func (r *passkeyRepo) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	rows, err := models.Passkeys.Delete(
+		models.DeleteWhere.Passkeys.ID.EQ(id),
+		models.DeleteWhere.Passkeys.UserID.EQ(userID),
+	).Exec(ctx, r.data.db)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return biz.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *passkeyRepo) SaveSession(ctx context.Context, s *biz.WebAuthnSession) (*biz.WebAuthnSession, error) {
+	data, err := json.Marshal(s.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = models.WebauthnSessions.Delete(
+		models.DeleteWhere.WebauthnSessions.ExpiresAt.LT(time.Now().UTC()),
+	).Exec(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	setter := &models.WebauthnSessionSetter{
+		Ceremony:    omit.From(string(s.Ceremony)),
+		SessionData: omit.From(data),
+		ExpiresAt:   omit.From(s.ExpiresAt),
+	}
+	if s.UserID != uuid.Nil {
+		setter.UserID = omitnull.From(s.UserID)
+	}
+
+	inserted, err := models.WebauthnSessions.Insert(setter).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizWebAuthnSession(inserted)
+}
+
+func (r *passkeyRepo) ConsumeSession(ctx context.Context, id uuid.UUID, ceremony biz.WebAuthnCeremony) (*biz.WebAuthnSession, error) {
+	s, err := models.WebauthnSessions.Delete(
+		models.DeleteWhere.WebauthnSessions.ID.EQ(id),
+		models.DeleteWhere.WebauthnSessions.Ceremony.EQ(string(ceremony)),
+		models.DeleteWhere.WebauthnSessions.ExpiresAt.GT(time.Now().UTC()),
+	).One(ctx, r.data.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizWebAuthnSession(s)
+}
+
+func toBizPasskey(p *models.Passkey) *biz.Passkey {
+	return &biz.Passkey{
+		ID:              p.ID,
+		UserID:          p.UserID,
+		Name:            p.Name,
+		CredentialID:    p.CredentialID,
+		PublicKey:       p.PublicKey,
+		AttestationType: p.AttestationType,
+		AAGUID:          p.Aaguid,
+		SignCount:       uint32(p.SignCount),
+		Transports:      strings.Fields(p.Transports),
+		BackupEligible:  p.BackupEligible,
+		BackupState:     p.BackupState,
+		LastUsedAt:      p.LastUsedAt.Ptr(),
+		CreatedAt:       p.CreatedAt,
+	}
+}
+
+func toBizWebAuthnSession(s *models.WebauthnSession) (*biz.WebAuthnSession, error) {
+	result := &biz.WebAuthnSession{
+		ID:        s.ID,
+		UserID:    s.UserID.GetOrZero(),
+		Ceremony:  biz.WebAuthnCeremony(s.Ceremony),
+		ExpiresAt: s.ExpiresAt,
+	}
+	if err := json.Unmarshal(s.SessionData, &result.Data); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type passwordHistoryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPasswordHistoryRepo .
+func NewPasswordHistoryRepo(data *Data, logger *log.Helper) biz.PasswordHistoryRepo {
+	return &passwordHistoryRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *passwordHistoryRepo) ListRecent(ctx context.Context, userID uuid.UUID, n int) ([]string, error) {
+	entries, err := models.PasswordHistories.Query(
+		models.SelectWhere.PasswordHistories.UserID.EQ(userID),
+		sm.OrderBy(models.PasswordHistories.Columns.CreatedAt).Desc(),
+		sm.Limit(n),
+	).All(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(entries))
+	for _, e := range entries {
+		hashes = append(hashes, e.PasswordHash)
+	}
+
+	return hashes, nil
+}
+
+func (r *passwordHistoryRepo) Add(ctx context.Context, userID uuid.UUID, hash string, keep int) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		tx := r.data.conn(ctx)
+
+		_, err := models.PasswordHistories.Insert(&models.PasswordHistorySetter{
+			UserID:       omit.From(userID),
+			PasswordHash: omit.From(hash),
+		}).Exec(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		stale, err := models.PasswordHistories.Query(
+			models.SelectWhere.PasswordHistories.UserID.EQ(userID),
+			sm.OrderBy(models.PasswordHistories.Columns.CreatedAt).Desc(),
+			sm.Offset(keep),
+		).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if len(stale) > 0 {
+			ids := make([]uuid.UUID, 0, len(stale))
+			for _, e := range stale {
+				ids = append(ids, e.ID)
+			}
+			_, err = models.PasswordHistories.Delete(
+				models.DeleteWhere.PasswordHistories.ID.In(ids...),
+			).Exec(ctx, tx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
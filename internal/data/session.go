@@ -0,0 +1,276 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/conf"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSessionCacheTTL = 30 * time.Second
+	// sessionTouchInterval limits how often a session's last_seen_at is
+	// written while it is in use.
+	sessionTouchInterval = time.Minute
+)
+
+type sessionCacheEntry struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+type sessionRepo struct {
+	data *Data
+	log  *log.Helper
+
+	// cache holds the revocation state of recently checked sessions so the
+	// authz middleware does not hit the database on every request.
+	cacheTTL time.Duration
+	mu       sync.Mutex
+	cache    map[uuid.UUID]sessionCacheEntry
+	touched  map[uuid.UUID]time.Time
+}
+
+// NewSessionRepo .
+func NewSessionRepo(data *Data, c *conf.Auth, logger *log.Helper) biz.SessionRepo {
+	ttl := defaultSessionCacheTTL
+	if c.GetSession().GetCacheTtl() != nil {
+		ttl = c.GetSession().GetCacheTtl().AsDuration()
+	}
+
+	return &sessionRepo{
+		data:     data,
+		log:      logger,
+		cacheTTL: ttl,
+		cache:    make(map[uuid.UUID]sessionCacheEntry),
+		touched:  make(map[uuid.UUID]time.Time),
+	}
+}
+
+// NewSessionChecker .
+func NewSessionChecker(r biz.SessionRepo) biz.SessionChecker {
+	return r
+}
+
+func (r *sessionRepo) Save(ctx context.Context, s *biz.Session) (*biz.Session, error) {
+	setter := &models.SessionSetter{
+		ID:        omit.From(s.ID),
+		UserID:    omit.From(s.UserID),
+		UserAgent: omit.From(s.UserAgent),
+		IP:        omit.From(s.IP),
+	}
+
+	inserted, err := models.Sessions.Insert(setter).One(ctx, r.data.conn(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizSession(inserted), nil
+}
+
+func (r *sessionRepo) FindByID(ctx context.Context, id uuid.UUID) (*biz.Session, error) {
+	session, err := models.FindSession(ctx, r.data.db, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizSession(session), nil
+}
+
+func (r *sessionRepo) ListActive(ctx context.Context, userID uuid.UUID) ([]*biz.Session, error) {
+	sessions, err := models.Sessions.Query(
+		models.SelectWhere.Sessions.UserID.EQ(userID),
+		models.SelectWhere.Sessions.RevokedAt.IsNull(),
+		models.SelectWhere.Sessions.LastSeenAt.GT(time.Now().Add(-biz.RefreshTokenTTL)),
+		sm.OrderBy(models.Sessions.Columns.CreatedAt),
+	).All(ctx, r.data.conn(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.Session, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, toBizSession(s))
+	}
+	return result, nil
+}
+
+// Touch updates last_seen_at, at most once per sessionTouchInterval for
+// each session on this instance.
+func (r *sessionRepo) Touch(ctx context.Context, id uuid.UUID) error {
+	now := time.Now()
+	if !r.shouldTouch(id, now) {
+		return nil
+	}
+
+	setter := &models.SessionSetter{
+		LastSeenAt: omit.From(now.UTC()),
+	}
+
+	_, err := models.Sessions.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.Sessions.ID.EQ(id),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func (r *sessionRepo) shouldTouch(id uuid.UUID, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now.Sub(r.touched[id]) < sessionTouchInterval {
+		return false
+	}
+	r.touched[id] = now
+
+	if len(r.touched) > 10_000 {
+		for k, v := range r.touched {
+			if now.Sub(v) >= sessionTouchInterval {
+				delete(r.touched, k)
+			}
+		}
+	}
+	return true
+}
+
+func (r *sessionRepo) Revoke(ctx context.Context, id uuid.UUID) error {
+	setter := &models.SessionSetter{
+		RevokedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.Sessions.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.Sessions.ID.EQ(id),
+		models.UpdateWhere.Sessions.RevokedAt.IsNull(),
+	).Exec(ctx, r.data.conn(ctx))
+	if err != nil {
+		return err
+	}
+
+	r.setCached(id, true)
+	return nil
+}
+
+func (r *sessionRepo) RevokeByUserID(ctx context.Context, userID uuid.UUID) error {
+	setter := &models.SessionSetter{
+		RevokedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	revoked, err := models.Sessions.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.Sessions.UserID.EQ(userID),
+		models.UpdateWhere.Sessions.RevokedAt.IsNull(),
+	).All(ctx, r.data.conn(ctx))
+	if err != nil {
+		return err
+	}
+
+	for _, s := range revoked {
+		r.setCached(s.ID, true)
+	}
+	return nil
+}
+
+func (r *sessionRepo) RevokeOthers(ctx context.Context, userID, keep uuid.UUID) error {
+	setter := &models.SessionSetter{
+		RevokedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	revoked, err := models.Sessions.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.Sessions.UserID.EQ(userID),
+		models.UpdateWhere.Sessions.ID.NE(keep),
+		models.UpdateWhere.Sessions.RevokedAt.IsNull(),
+	).All(ctx, r.data.conn(ctx))
+	if err != nil {
+		return err
+	}
+
+	for _, s := range revoked {
+		r.setCached(s.ID, true)
+	}
+	return nil
+}
+
+// IsRevoked reports whether the session is revoked or unknown. Results are
+// cached for cacheTTL, so a revocation made by another instance can take
+// that long to be observed here.
+func (r *sessionRepo) IsRevoked(ctx context.Context, id uuid.UUID) (bool, error) {
+	if revoked, ok := r.getCached(id); ok {
+		return revoked, nil
+	}
+
+	session, err := models.FindSession(ctx, r.data.db, id, "revoked_at")
+	if errors.Is(err, sql.ErrNoRows) {
+		r.setCached(id, true)
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	revoked := session.RevokedAt.IsValue()
+	r.setCached(id, revoked)
+	return revoked, nil
+}
+
+func (r *sessionRepo) getCached(id uuid.UUID) (bool, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.cache[id]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return false, false
+	}
+	return entry.revoked, true
+}
+
+func (r *sessionRepo) setCached(id uuid.UUID, revoked bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	ttl := r.cacheTTL
+	if revoked {
+		// A revoked session never becomes valid again, so keep it until
+		// every access token issued for it has expired.
+		ttl = max(ttl, biz.AccessTokenTTL)
+	}
+	r.cache[id] = sessionCacheEntry{revoked: revoked, expiresAt: now.Add(ttl)}
+
+	if len(r.cache) > 10_000 {
+		for k, v := range r.cache {
+			if now.After(v.expiresAt) {
+				delete(r.cache, k)
+			}
+		}
+	}
+}
+
+func toBizSession(s *models.Session) *biz.Session {
+	return &biz.Session{
+		ID:         s.ID,
+		UserID:     s.UserID,
+		UserAgent:  s.UserAgent,
+		IP:         s.IP,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		RevokedAt:  s.RevokedAt.Ptr(),
+	}
+}
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+)
+
+type tenantRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTenantRepo .
+func NewTenantRepo(data *Data, logger *log.Helper) biz.TenantRepo {
+	return &tenantRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *tenantRepo) Save(ctx context.Context, t *biz.Tenant) (*biz.Tenant, error) {
+	setter := &models.TenantSetter{
+		Name: omit.From(t.Name),
+	}
+
+	inserted, err := models.Tenants.Insert(setter).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizTenant(inserted), nil
+}
+
+func (r *tenantRepo) FindByID(ctx context.Context, id uuid.UUID) (*biz.Tenant, error) {
+	t, err := models.FindTenant(ctx, r.data.db, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizTenant(t), nil
+}
+
+func (r *tenantRepo) List(ctx context.Context) ([]*biz.Tenant, error) {
+	tenants, err := models.Tenants.Query(
+		sm.OrderBy(models.Tenants.Columns.CreatedAt),
+	).All(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.Tenant, 0, len(tenants))
+	for _, t := range tenants {
+		result = append(result, toBizTenant(t))
+	}
+	return result, nil
+}
+
+func toBizTenant(t *models.Tenant) *biz.Tenant {
+	return &biz.Tenant{
+		ID:        t.ID,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+	}
+}
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type refreshTokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRefreshTokenRepo .
+func NewRefreshTokenRepo(data *Data, logger *log.Helper) biz.RefreshTokenRepo {
+	return &refreshTokenRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *refreshTokenRepo) Save(ctx context.Context, t *biz.RefreshTokenRecord) (*biz.RefreshTokenRecord, error) {
+	setter := &models.RefreshTokenSetter{
+		ID:        omit.From(t.ID),
+		SessionID: omit.From(t.SessionID),
+		UserID:    omit.From(t.UserID),
+		ExpiresAt: omit.From(t.ExpiresAt),
+	}
+
+	inserted, err := models.RefreshTokens.Insert(setter).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	t.CreatedAt = inserted.CreatedAt
+
+	return t, nil
+}
+
+func (r *refreshTokenRepo) FindByID(ctx context.Context, id uuid.UUID) (*biz.RefreshTokenRecord, error) {
+	token, err := models.FindRefreshToken(ctx, r.data.db, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.RefreshTokenRecord{
+		ID:        token.ID,
+		SessionID: token.SessionID,
+		UserID:    token.UserID,
+		ExpiresAt: token.ExpiresAt,
+		RotatedAt: token.RotatedAt.Ptr(),
+		RevokedAt: token.RevokedAt.Ptr(),
+		CreatedAt: token.CreatedAt,
+	}, nil
+}
+
+func (r *refreshTokenRepo) Rotate(ctx context.Context, id uuid.UUID) (bool, error) {
+	setter := &models.RefreshTokenSetter{
+		RotatedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	rows, err := models.RefreshTokens.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.RefreshTokens.ID.EQ(id),
+		models.UpdateWhere.RefreshTokens.RotatedAt.IsNull(),
+		models.UpdateWhere.RefreshTokens.RevokedAt.IsNull(),
+	).Exec(ctx, r.data.db)
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func (r *refreshTokenRepo) RevokeBySessionID(ctx context.Context, sessionID uuid.UUID) error {
+	setter := &models.RefreshTokenSetter{
+		RevokedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.RefreshTokens.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.RefreshTokens.SessionID.EQ(sessionID),
+		models.UpdateWhere.RefreshTokens.RevokedAt.IsNull(),
+	).Exec(ctx, r.data.conn(ctx))
+
+	return err
+}
+
+func (r *refreshTokenRepo) RevokeByUserID(ctx context.Context, userID uuid.UUID) error {
+	setter := &models.RefreshTokenSetter{
+		RevokedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.RefreshTokens.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.RefreshTokens.UserID.EQ(userID),
+		models.UpdateWhere.RefreshTokens.RevokedAt.IsNull(),
+	).Exec(ctx, r.data.conn(ctx))
+
+	return err
+}
+
+func (r *refreshTokenRepo) RevokeOthers(ctx context.Context, userID, keep uuid.UUID) error {
+	setter := &models.RefreshTokenSetter{
+		RevokedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.RefreshTokens.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.RefreshTokens.UserID.EQ(userID),
+		models.UpdateWhere.RefreshTokens.SessionID.NE(keep),
+		models.UpdateWhere.RefreshTokens.RevokedAt.IsNull(),
+	).Exec(ctx, r.data.conn(ctx))
+
+	return err
+}
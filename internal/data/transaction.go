@@ -0,0 +1,43 @@
+package data
+
+import (
+	"context"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/drivers/pgx"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+type txKey struct{}
+
+// NewTransaction .
+func NewTransaction(data *Data) biz.Transaction {
+	return data
+}
+
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// conn returns the transaction started by InTx, if ctx carries one, or else
+// the pool.
+func (d *Data) conn(ctx context.Context) bob.Executor {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return d.db
+}
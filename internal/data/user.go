@@ -5,9 +5,12 @@ import (
 	"time"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 
 	"github.com/tencat-dev/go-base/internal/biz"
@@ -36,7 +39,7 @@ func (r *userRepo) Save(ctx context.Context, u *biz.User) (*biz.User, error) {
 		PasswordHash: omit.From(u.PasswordHash),
 	}
 
-	insertedUser, err := models.Users.Insert(setter).One(ctx, r.data.db)
+	insertedUser, err := models.Users.Insert(setter).One(ctx, r.data.conn(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -49,11 +52,46 @@ func (r *userRepo) Save(ctx context.Context, u *biz.User) (*biz.User, error) {
 	return u, nil
 }
 
+func (r *userRepo) Import(ctx context.Context, users []*biz.User) ([]*biz.User, error) {
+	setters := make([]*models.UserSetter, 0, len(users))
+	for _, u := range users {
+		setters = append(setters, &models.UserSetter{
+			Name:            omit.From(u.Name),
+			Email:           omit.From(u.Email),
+			PasswordHash:    omit.From(u.PasswordHash),
+			EmailVerifiedAt: omitnull.FromPtr(u.EmailVerifiedAt),
+		})
+	}
+
+	inserted, err := models.Users.Insert(
+		bob.ToMods(setters...),
+		im.OnConflict("email").DoNothing(),
+	).All(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*biz.User, 0, len(inserted))
+	for _, user := range inserted {
+		out = append(out, &biz.User{
+			ID:              user.ID,
+			Name:            user.Name,
+			Email:           user.Email,
+			EmailVerifiedAt: user.EmailVerifiedAt.Ptr(),
+			CreatedAt:       user.CreatedAt,
+			UpdatedAt:       user.UpdatedAt,
+		})
+	}
+
+	return out, nil
+}
+
 func (r *userRepo) Update(ctx context.Context, u *biz.User) (*biz.User, error) {
 	setter := &models.UserSetter{
-		Name:      omit.From(u.Name),
-		Email:     omit.From(u.Email),
-		UpdatedAt: omit.From(time.Now().UTC()),
+		Name:            omit.From(u.Name),
+		Email:           omit.From(u.Email),
+		EmailVerifiedAt: omitnull.FromPtr(u.EmailVerifiedAt),
+		UpdatedAt:       omit.From(time.Now().UTC()),
 	}
 
 	_, err := models.Users.Update(
@@ -67,6 +105,35 @@ func (r *userRepo) Update(ctx context.Context, u *biz.User) (*biz.User, error) {
 	return u, nil
 }
 
+func (r *userRepo) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	setter := &models.UserSetter{
+		PasswordHash: omit.From(passwordHash),
+		UpdatedAt:    omit.From(time.Now().UTC()),
+	}
+
+	_, err := models.Users.Update(
+		models.UpdateWhere.Users.ID.EQ(id),
+		setter.UpdateMod(),
+	).Exec(ctx, r.data.conn(ctx))
+
+	return err
+}
+
+func (r *userRepo) MarkEmailVerified(ctx context.Context, id uuid.UUID, email string) error {
+	setter := &models.UserSetter{
+		EmailVerifiedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	_, err := models.Users.Update(
+		models.UpdateWhere.Users.ID.EQ(id),
+		models.UpdateWhere.Users.Email.EQ(email),
+		models.UpdateWhere.Users.EmailVerifiedAt.IsNull(),
+		setter.UpdateMod(),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
 func (r *userRepo) FindByID(ctx context.Context, id uuid.UUID) (*biz.User, error) {
 	user, err := models.FindUser(ctx, r.data.db, id)
 	if err != nil {
@@ -74,11 +141,13 @@ func (r *userRepo) FindByID(ctx context.Context, id uuid.UUID) (*biz.User, error
 	}
 
 	return &biz.User{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:              user.ID,
+		Name:            user.Name,
+		Email:           user.Email,
+		PasswordHash:    user.PasswordHash,
+		EmailVerifiedAt: user.EmailVerifiedAt.Ptr(),
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
 	}, nil
 }
 
@@ -91,9 +160,10 @@ func (r *userRepo) ListAll(ctx context.Context) ([]*biz.User, error) {
 	var users []*biz.User
 	for _, user := range userslice {
 		users = append(users, &biz.User{
-			ID:    user.ID,
-			Name:  user.Name,
-			Email: user.Email,
+			ID:              user.ID,
+			Name:            user.Name,
+			Email:           user.Email,
+			EmailVerifiedAt: user.EmailVerifiedAt.Ptr(),
 		})
 	}
 
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/persistence/postgres/bob/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type userTokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUserTokenRepo .
+func NewUserTokenRepo(data *Data, logger *log.Helper) biz.UserTokenRepo {
+	return &userTokenRepo{
+		data: data,
+		log:  logger,
+	}
+}
+
+func (r *userTokenRepo) Save(ctx context.Context, t *biz.UserToken) (*biz.UserToken, error) {
+	setter := &models.UserTokenSetter{
+		UserID:    omit.From(t.UserID),
+		Purpose:   omit.From(string(t.Purpose)),
+		TokenHash: omit.From(t.TokenHash),
+		Email:     omitnull.From(t.Email),
+		ExpiresAt: omit.From(t.ExpiresAt),
+	}
+
+	inserted, err := models.UserTokens.Insert(setter).One(ctx, r.data.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizUserToken(inserted), nil
+}
+
+func (r *userTokenRepo) FindByHash(ctx context.Context, hash string) (*biz.UserToken, error) {
+	t, err := models.UserTokens.Query(
+		models.SelectWhere.UserTokens.TokenHash.EQ(hash),
+	).One(ctx, r.data.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizUserToken(t), nil
+}
+
+func (r *userTokenRepo) Consume(ctx context.Context, id uuid.UUID) (bool, error) {
+	setter := &models.UserTokenSetter{
+		UsedAt: omitnull.From(time.Now().UTC()),
+	}
+
+	rows, err := models.UserTokens.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.UserTokens.ID.EQ(id),
+		models.UpdateWhere.UserTokens.UsedAt.IsNull(),
+		models.UpdateWhere.UserTokens.ExpiresAt.GT(time.Now().UTC()),
+	).Exec(ctx, r.data.conn(ctx))
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func (r *userTokenRepo) DeleteByUserID(ctx context.Context, userID uuid.UUID, purpose biz.TokenPurpose) error {
+	_, err := models.UserTokens.Delete(
+		models.DeleteWhere.UserTokens.UserID.EQ(userID),
+		models.DeleteWhere.UserTokens.Purpose.EQ(string(purpose)),
+	).Exec(ctx, r.data.db)
+
+	return err
+}
+
+func toBizUserToken(t *models.UserToken) *biz.UserToken {
+	return &biz.UserToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Purpose:   biz.TokenPurpose(t.Purpose),
+		TokenHash: t.TokenHash,
+		Email:     t.Email.GetOrZero(),
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt.Ptr(),
+		CreatedAt: t.CreatedAt,
+	}
+}
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+)
+
+// ClaimsFromContext returns the claims of the token verified by the authz
+// middleware.
+func ClaimsFromContext(ctx context.Context) (*JWTClaims, bool) {
+	token, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	claims, ok := token.(*JWTClaims)
+	return claims, ok
+}
@@ -1,27 +1,58 @@
 package auth
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"github.com/tencat-dev/go-base/internal/biz"
-	"github.com/tencat-dev/go-base/internal/conf"
 )
 
 type JWTClaims struct {
 	SessionID string        `json:"sid,omitempty"`
 	Role      string        `json:"role,omitempty"`
 	Type      biz.TokenType `json:"type,omitempty"`
+	ClientID  string        `json:"client_id,omitempty"`
+	Scope     string        `json:"scope,omitempty"`
+	Actor     *ActorClaims  `json:"act,omitempty"`
+	Tenant    string        `json:"tenant,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// ActorClaims is the RFC 8693 act claim of a token issued to a user acting
+// as its subject.
+type ActorClaims struct {
+	Subject string `json:"sub"`
+}
+
+// actor parses the act claim, which is absent on most tokens.
+func (c *JWTClaims) actor() (uuid.UUID, error) {
+	if c.Actor == nil {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(c.Actor.Subject)
+}
+
+// IDTokenClaims are the claims of an OpenID Connect ID token.
+type IDTokenClaims struct {
+	Nonce         string `json:"nonce,omitempty"`
+	AuthTime      int64  `json:"auth_time,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
 	jwt.RegisteredClaims
 }
 
 type JWTMaker struct {
-	secretKey string
+	keys *KeySet
 }
 
-func NewJWTMaker(c *conf.JWT) biz.TokenMaker {
-	return &JWTMaker{secretKey: c.Secret}
+func NewJWTMaker(keys *KeySet) biz.TokenMaker {
+	return &JWTMaker{keys: keys}
 }
 
 func (j *JWTMaker) CreateAccessToken(payload biz.AccessPayload) (string, error) {
@@ -30,16 +61,60 @@ func (j *JWTMaker) CreateAccessToken(payload biz.AccessPayload) (string, error)
 	claims := JWTClaims{
 		SessionID: payload.SessionID.String(),
 		Type:      biz.AccessToken,
+		ClientID:  payload.ClientID,
+		Scope:     strings.Join(payload.Scopes, " "),
+		Tenant:    payload.Tenant,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   payload.UserID.String(),
 			ExpiresAt: jwt.NewNumericDate(now.Add(payload.TTL)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    j.keys.issuer,
+			Audience:  j.keys.audiences(),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secretKey))
+	if payload.Actor != uuid.Nil {
+		claims.Actor = &ActorClaims{Subject: payload.Actor.String()}
+	}
+
+	return j.keys.Sign(claims)
+}
+
+func (j *JWTMaker) ParseAccessToken(token string) (*biz.AccessPayload, error) {
+	claims, err := j.parse(token, biz.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := claims.actor()
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &biz.AccessPayload{
+		UserID:    userID,
+		SessionID: sessionID,
+		ClientID:  claims.ClientID,
+		Scopes:    strings.Fields(claims.Scope),
+		Actor:     actor,
+		Tenant:    claims.Tenant,
+	}
+	if claims.ExpiresAt != nil {
+		payload.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	return payload, nil
 }
 
 func (j *JWTMaker) CreateRefreshToken(payload biz.RefreshPayload) (string, error) {
@@ -48,14 +123,169 @@ func (j *JWTMaker) CreateRefreshToken(payload biz.RefreshPayload) (string, error
 	claims := JWTClaims{
 		SessionID: payload.SessionID.String(),
 		Type:      biz.RefreshToken,
+		Tenant:    payload.Tenant,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        payload.ID.String(),
 			Subject:   payload.UserID.String(),
 			ExpiresAt: jwt.NewNumericDate(now.Add(payload.TTL)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    j.keys.issuer,
+			Audience:  j.keys.audiences(),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secretKey))
+	return j.keys.Sign(claims)
+}
+
+func (j *JWTMaker) ParseRefreshToken(token string) (*biz.RefreshPayload, error) {
+	claims, err := j.parse(token, biz.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.RefreshPayload{
+		ID:        id,
+		UserID:    userID,
+		SessionID: sessionID,
+		Tenant:    claims.Tenant,
+	}, nil
+}
+
+func (j *JWTMaker) CreateMFAToken(payload biz.MFAPayload) (string, error) {
+	now := time.Now().UTC()
+
+	claims := JWTClaims{
+		Type: biz.MFAToken,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        payload.ID.String(),
+			Subject:   payload.UserID.String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(payload.TTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    j.keys.issuer,
+			Audience:  j.keys.audiences(),
+		},
+	}
+
+	return j.keys.Sign(claims)
+}
+
+func (j *JWTMaker) ParseMFAToken(token string) (*biz.MFAPayload, error) {
+	claims, err := j.parse(token, biz.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.MFAPayload{ID: id, UserID: userID}, nil
+}
+
+func (j *JWTMaker) CreateIDToken(payload biz.IDTokenPayload) (string, error) {
+	// Clients verify ID tokens with the published keys, so they are never
+	// signed with the shared secret.
+	if j.keys.Symmetric() {
+		return "", errors.New("jwt: ID tokens require an asymmetric signing key")
+	}
+
+	now := time.Now().UTC()
+
+	claims := IDTokenClaims{
+		Nonce:         payload.Nonce,
+		AuthTime:      payload.AuthTime.Unix(),
+		Name:          payload.Name,
+		Email:         payload.Email,
+		EmailVerified: payload.EmailVerified,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    payload.Issuer,
+			Subject:   payload.UserID.String(),
+			Audience:  jwt.ClaimStrings{payload.ClientID},
+			ExpiresAt: jwt.NewNumericDate(now.Add(payload.TTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+
+	return j.keys.Sign(claims)
+}
+
+func (j *JWTMaker) ParseToken(token string) (*biz.TokenInfo, error) {
+	claims := &JWTClaims{}
+	if _, err := j.keys.Parse(token, claims); err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := claims.actor()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &biz.TokenInfo{
+		Type:     claims.Type,
+		UserID:   userID,
+		ClientID: claims.ClientID,
+		Scopes:   strings.Fields(claims.Scope),
+		Actor:    actor,
+		Tenant:   claims.Tenant,
+	}
+	if claims.SessionID != "" {
+		if info.SessionID, err = uuid.Parse(claims.SessionID); err != nil {
+			return nil, err
+		}
+	}
+	if claims.ID != "" {
+		if info.ID, err = uuid.Parse(claims.ID); err != nil {
+			return nil, err
+		}
+	}
+	if claims.IssuedAt != nil {
+		info.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		info.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	return info, nil
+}
+
+// parse verifies the token and checks that it is of the expected type.
+func (j *JWTMaker) parse(token string, typ biz.TokenType) (*JWTClaims, error) {
+	claims := &JWTClaims{}
+	if _, err := j.keys.Parse(token, claims); err != nil {
+		return nil, err
+	}
+
+	if claims.Type != typ {
+		return nil, fmt.Errorf("unexpected token type %q", claims.Type)
+	}
+
+	return claims, nil
 }
@@ -0,0 +1,306 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+// Key is a JWT signing or verification key.
+type Key struct {
+	ID       string
+	Method   jwt.SigningMethod
+	Private  crypto.PrivateKey
+	Public   crypto.PublicKey
+	RetireAt time.Time
+}
+
+func (k *Key) retired(now time.Time) bool {
+	return !k.RetireAt.IsZero() && !now.Before(k.RetireAt)
+}
+
+// KeySet holds the keys tokens are signed and verified with.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+	order   []*Key
+	methods []string
+
+	issuer   string
+	audience string
+	leeway   time.Duration
+}
+
+// NewKeySet loads the keys configured in c. Without keys it falls back to a
+// single HS256 key built from the shared secret.
+func NewKeySet(c *conf.JWT) (*KeySet, error) {
+	s := &KeySet{
+		keys:     make(map[string]*Key),
+		issuer:   c.GetIssuer(),
+		audience: c.GetAudience(),
+		leeway:   c.GetLeeway().AsDuration(),
+	}
+
+	if len(c.GetKeys()) == 0 {
+		if c.GetSecret() == "" {
+			return nil, errors.New("jwt: either keys or secret must be configured")
+		}
+		s.add(&Key{
+			Method:  jwt.SigningMethodHS256,
+			Private: []byte(c.GetSecret()),
+			Public:  []byte(c.GetSecret()),
+		})
+		s.signing = s.order[0]
+		return s, nil
+	}
+
+	for _, kc := range c.GetKeys() {
+		if _, ok := s.keys[kc.GetId()]; ok {
+			return nil, fmt.Errorf("jwt: duplicate key id %q", kc.GetId())
+		}
+		key, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: key %q: %w", kc.GetId(), err)
+		}
+		s.add(key)
+	}
+
+	signingID := c.GetSigningKeyId()
+	if signingID == "" {
+		signingID = s.order[0].ID
+	}
+	signing, ok := s.keys[signingID]
+	if !ok {
+		return nil, fmt.Errorf("jwt: signing key %q is not configured", signingID)
+	}
+	if signing.Private == nil {
+		return nil, fmt.Errorf("jwt: signing key %q has no private key", signingID)
+	}
+	if signing.retired(time.Now()) {
+		return nil, fmt.Errorf("jwt: signing key %q is retired", signingID)
+	}
+	s.signing = signing
+
+	return s, nil
+}
+
+func (s *KeySet) add(k *Key) {
+	s.keys[k.ID] = k
+	s.order = append(s.order, k)
+	for _, m := range s.methods {
+		if m == k.Method.Alg() {
+			return
+		}
+	}
+	s.methods = append(s.methods, k.Method.Alg())
+}
+
+// signer returns the key that signs tokens at now: the configured signing
+// key until it retires, then the first configured key that can sign and has
+// not retired.
+func (s *KeySet) signer(now time.Time) (*Key, error) {
+	if !s.signing.retired(now) {
+		return s.signing, nil
+	}
+	for _, k := range s.order {
+		if k.Private != nil && !k.retired(now) {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("jwt: signing key %q is retired and no other key can sign", s.signing.ID)
+}
+
+// Sign signs the claims with the current signing key.
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	key, err := s.signer(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+	return token.SignedString(key.Private)
+}
+
+// Symmetric reports whether tokens are signed with the shared HS256 secret.
+func (s *KeySet) Symmetric() bool {
+	return s.signing.Method == jwt.SigningMethodHS256
+}
+
+// SigningAlgorithm is the alg of newly signed tokens.
+func (s *KeySet) SigningAlgorithm() string {
+	if key, err := s.signer(time.Now()); err == nil {
+		return key.Method.Alg()
+	}
+	return s.signing.Method.Alg()
+}
+
+// Parse verifies the token against the key named by its kid header and
+// decodes it into claims. The token must carry an exp claim, and the
+// configured issuer and audience when they are set.
+func (s *KeySet) Parse(token string, claims jwt.Claims, opts ...jwt.ParserOption) (*jwt.Token, error) {
+	opts = append(opts,
+		jwt.WithValidMethods(s.methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(s.leeway),
+	)
+	if s.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(s.issuer))
+	}
+	if s.audience != "" {
+		opts = append(opts, jwt.WithAudience(s.audience))
+	}
+	return jwt.ParseWithClaims(token, claims, s.keyfunc, opts...)
+}
+
+func (s *KeySet) keyfunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if key.retired(time.Now()) {
+		return nil, fmt.Errorf("key %q is retired", kid)
+	}
+	// Reject tokens whose alg does not belong to the key to rule out
+	// algorithm confusion between keys.
+	if t.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %q for key %q", t.Method.Alg(), kid)
+	}
+	return key.Public, nil
+}
+
+// audiences is the aud claim of newly signed tokens.
+func (s *KeySet) audiences() jwt.ClaimStrings {
+	if s.audience == "" {
+		return nil
+	}
+	return jwt.ClaimStrings{s.audience}
+}
+
+// PublicKeys returns the asymmetric keys that are still accepted.
+func (s *KeySet) PublicKeys() []*Key {
+	now := time.Now()
+	keys := make([]*Key, 0, len(s.order))
+	for _, k := range s.order {
+		if k.Method == jwt.SigningMethodHS256 || k.retired(now) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func loadKey(c *conf.JWTKey) (*Key, error) {
+	key := &Key{ID: c.GetId()}
+	if c.GetRetireAt() != nil {
+		key.RetireAt = c.GetRetireAt().AsTime()
+	}
+
+	switch {
+	case c.GetPrivateKeyFile() != "":
+		block, err := readPEM(c.GetPrivateKeyFile())
+		if err != nil {
+			return nil, err
+		}
+		priv, err := parsePrivateKey(block)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := priv.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", priv)
+		}
+		key.Private = priv
+		key.Public = signer.Public()
+	case c.GetPublicKeyFile() != "":
+		block, err := readPEM(c.GetPublicKeyFile())
+		if err != nil {
+			return nil, err
+		}
+		pub, err := parsePublicKey(block)
+		if err != nil {
+			return nil, err
+		}
+		key.Public = pub
+	default:
+		return nil, errors.New("private_key_file or public_key_file is required")
+	}
+
+	method, err := signingMethod(key.Public)
+	if err != nil {
+		return nil, err
+	}
+	key.Method = method
+
+	return key, nil
+}
+
+func signingMethod(pub crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("unsupported curve %s", k.Curve.Params().Name)
+		}
+		return jwt.SigningMethodES256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", pub)
+	}
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data found", path)
+	}
+	return block, nil
+}
+
+func parsePrivateKey(block *pem.Block) (crypto.PrivateKey, error) {
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+}
+
+func parsePublicKey(block *pem.Block) (crypto.PublicKey, error) {
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	default:
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	}
+}
@@ -0,0 +1,154 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const defaultReloadInterval = 10 * time.Second
+
+// NewServerTLSConfig returns the TLS config of a server, or nil when c is nil
+// and the server listens in plaintext. The certificate and client CAs are
+// read again whenever their files change, so they can be rotated without a
+// restart.
+func NewServerTLSConfig(c *conf.TLS, logger *log.Helper) (*tls.Config, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	r := &reloader{
+		c:        c,
+		interval: defaultReloadInterval,
+		log:      logger,
+	}
+	if c.GetReloadInterval() != nil {
+		r.interval = c.GetReloadInterval().AsDuration()
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: r.configForClient,
+	}, nil
+}
+
+type reloader struct {
+	c        *conf.TLS
+	interval time.Duration
+	log      *log.Helper
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	modTimes  []time.Time
+	config    *tls.Config
+}
+
+// configForClient returns the config for a new connection, reloading the
+// files first if they changed since they were last read.
+func (r *reloader) configForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) >= r.interval {
+		r.checkedAt = time.Now()
+		if r.changed() {
+			if err := r.loadLocked(); err != nil {
+				// Keep serving the previous certificate until the files
+				// are fixed.
+				r.log.Errorf("reload tls certificate: %v", err)
+			} else {
+				r.log.Infof("reloaded tls certificate %s", r.c.GetCertFile())
+			}
+		}
+	}
+
+	return r.config, nil
+}
+
+func (r *reloader) load() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checkedAt = time.Now()
+	return r.loadLocked()
+}
+
+func (r *reloader) loadLocked() error {
+	modTimes, err := r.stat()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.c.GetCertFile(), r.c.GetKeyFile())
+	if err != nil {
+		return fmt.Errorf("tls: load key pair: %w", err)
+	}
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if caFile := r.c.GetClientCaFile(); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return fmt.Errorf("tls: read client ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return errors.New("tls: no certificates found in client ca file")
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+		if r.c.GetRequireClientCert() {
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+
+	r.config = config
+	r.modTimes = modTimes
+	return nil
+}
+
+func (r *reloader) changed() bool {
+	modTimes, err := r.stat()
+	if err != nil {
+		r.log.Errorf("check tls certificate: %v", err)
+		return false
+	}
+	for i, t := range modTimes {
+		if !t.Equal(r.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *reloader) stat() ([]time.Time, error) {
+	files := []string{r.c.GetCertFile(), r.c.GetKeyFile()}
+	if r.c.GetClientCaFile() != "" {
+		files = append(files, r.c.GetClientCaFile())
+	}
+
+	modTimes := make([]time.Time, 0, len(files))
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, fmt.Errorf("tls: %w", err)
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
@@ -4,9 +4,18 @@ import (
 	"github.com/goforj/wire"
 
 	"github.com/tencat-dev/go-base/internal/infra/auth"
+	"github.com/tencat-dev/go-base/internal/infra/mail"
+	"github.com/tencat-dev/go-base/internal/infra/metrics"
+	"github.com/tencat-dev/go-base/internal/infra/oidc"
+	"github.com/tencat-dev/go-base/internal/infra/password"
 )
 
 // ProviderSetInfra is infra providers.
 var ProviderSetInfra = wire.NewSet(
+	auth.NewKeySet,
 	auth.NewJWTMaker,
+	mail.NewMailer,
+	metrics.NewMeterProvider,
+	oidc.NewIdentityProviders,
+	password.NewVerifiers,
 )
@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+// FileMailer writes every mail as an .eml file into a directory. It is meant
+// for local development and tests.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer .
+func NewFileMailer(dir, from string) (*FileMailer, error) {
+	if dir == "" {
+		dir = "mail"
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &FileMailer{dir: dir, from: from}, nil
+}
+
+func (m *FileMailer) Send(_ context.Context, mail *biz.Mail) error {
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405"), uuid.Must(uuid.NewV7()))
+	return os.WriteFile(filepath.Join(m.dir, name), message(m.from, mail), 0o640)
+}
+
+// StdoutMailer logs every mail instead of sending it.
+type StdoutMailer struct {
+	from string
+	log  *log.Helper
+}
+
+// NewStdoutMailer .
+func NewStdoutMailer(from string, logger *log.Helper) *StdoutMailer {
+	return &StdoutMailer{from: from, log: logger}
+}
+
+func (m *StdoutMailer) Send(_ context.Context, mail *biz.Mail) error {
+	m.log.Infof("mail:\n%s", message(m.from, mail))
+	return nil
+}
@@ -0,0 +1,48 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+const defaultFrom = "no-reply@localhost"
+
+// NewMailer returns the mailer selected by the driver in c.
+func NewMailer(c *conf.Mail, logger *log.Helper) (biz.Mailer, error) {
+	from := c.GetFrom()
+	if from == "" {
+		from = defaultFrom
+	}
+
+	switch c.GetDriver() {
+	case "smtp":
+		return NewSMTPMailer(c.GetSmtp(), from)
+	case "file":
+		return NewFileMailer(c.GetDir(), from)
+	case "", "stdout":
+		return NewStdoutMailer(from, logger), nil
+	default:
+		return nil, fmt.Errorf("mail: unknown driver %q", c.GetDriver())
+	}
+}
+
+// message renders m as an RFC 5322 message.
+func message(from string, m *biz.Mail) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(m.Body)
+	return b.Bytes()
+}
@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/smtp"
+	"strconv"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+// SMTPMailer sends mail through an SMTP server.
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTPMailer .
+func NewSMTPMailer(c *conf.SMTP, from string) (*SMTPMailer, error) {
+	if c.GetHost() == "" {
+		return nil, errors.New("mail: smtp host is required")
+	}
+
+	port := c.GetPort()
+	if port == 0 {
+		port = 587
+	}
+
+	m := &SMTPMailer{
+		addr: net.JoinHostPort(c.GetHost(), strconv.Itoa(int(port))),
+		from: from,
+	}
+	if c.GetUsername() != "" {
+		m.auth = smtp.PlainAuth("", c.GetUsername(), c.GetPassword(), c.GetHost())
+	}
+
+	return m, nil
+}
+
+func (m *SMTPMailer) Send(_ context.Context, mail *biz.Mail) error {
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{mail.To}, message(m.from, mail))
+}
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// NewMeterProvider returns a meter provider whose metrics are collected by
+// the default Prometheus registry, and installs it as the global one. The
+// pprof server exposes the registry at /metrics.
+func NewMeterProvider() (metric.MeterProvider, func(), error) {
+	exporter, err := prometheus.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter))
+	otel.SetMeterProvider(provider)
+
+	cleanup := func() {
+		_ = provider.Shutdown(context.Background())
+	}
+	return provider, cleanup, nil
+}
@@ -0,0 +1,128 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/conf"
+)
+
+var defaultScopes = []string{gooidc.ScopeOpenID, "email", "profile"}
+
+// Provider is an upstream OpenID Connect provider. Its discovery document is
+// fetched on first use, so the server starts even if the provider is down.
+type Provider struct {
+	c *conf.OIDCProvider
+
+	mu       sync.Mutex
+	provider *gooidc.Provider
+}
+
+// NewIdentityProviders returns the providers configured for federation.
+func NewIdentityProviders(c *conf.Auth) []biz.IdentityProvider {
+	providers := make([]biz.IdentityProvider, 0, len(c.GetFederation().GetProviders()))
+	for _, p := range c.GetFederation().GetProviders() {
+		providers = append(providers, NewProvider(p))
+	}
+	return providers
+}
+
+// NewProvider returns the provider described by c.
+func NewProvider(c *conf.OIDCProvider) *Provider {
+	return &Provider{c: c}
+}
+
+func (p *Provider) Config() *conf.OIDCProvider {
+	return p.c
+}
+
+func (p *Provider) AuthCodeURL(ctx context.Context, redirectURL string, s *biz.FederationState) (string, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	config := p.oauth2Config(provider, redirectURL)
+	return config.AuthCodeURL(s.State, gooidc.Nonce(s.Nonce), oauth2.S256ChallengeOption(s.Verifier)), nil
+}
+
+func (p *Provider) Exchange(ctx context.Context, redirectURL, code string, s *biz.FederationState) (*biz.ExternalClaims, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config := p.oauth2Config(provider, redirectURL)
+	token, err := config.Exchange(ctx, code, oauth2.VerifierOption(s.Verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("token response has no id_token")
+	}
+
+	idToken, err := provider.Verifier(&gooidc.Config{ClientID: p.c.GetClientId()}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	if idToken.Nonce != s.Nonce {
+		return nil, errors.New("id_token nonce does not match")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	return &biz.ExternalClaims{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+func (p *Provider) oauth2Config(provider *gooidc.Provider, redirectURL string) *oauth2.Config {
+	scopes := p.c.GetScopes()
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.c.GetClientId(),
+		ClientSecret: p.c.GetClientSecret(),
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+	}
+}
+
+// discover fetches the discovery document once it succeeds. An HTTP client
+// can be passed in ctx with oauth2.HTTPClient, e.g. to reach a mock provider.
+func (p *Provider) discover(ctx context.Context) (*gooidc.Provider, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider != nil {
+		return p.provider, nil
+	}
+
+	provider, err := gooidc.NewProvider(ctx, p.c.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+	p.provider = provider
+
+	return provider, nil
+}
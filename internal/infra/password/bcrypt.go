@@ -0,0 +1,71 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	bcryptHashLength = 60
+	// maxBcryptCost bounds the work a single login can cause.
+	maxBcryptCost = 16
+)
+
+// Bcrypt verifies $2a$, $2b$ and $2y$ bcrypt hashes.
+type Bcrypt struct{}
+
+func (Bcrypt) Recognizes(hash string) bool {
+	return parseBcrypt(hash) == nil
+}
+
+func (Bcrypt) Verify(password, hash string) (bool, error) {
+	if err := parseBcrypt(hash); err != nil {
+		return false, err
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func parseBcrypt(hash string) error {
+	if !strings.HasPrefix(hash, "$2a$") &&
+		!strings.HasPrefix(hash, "$2b$") &&
+		!strings.HasPrefix(hash, "$2y$") {
+		return fmt.Errorf("bcrypt: unknown prefix")
+	}
+	if len(hash) != bcryptHashLength {
+		return fmt.Errorf("bcrypt: malformed hash")
+	}
+
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return fmt.Errorf("bcrypt: %w", err)
+	}
+	if cost > maxBcryptCost {
+		return fmt.Errorf("bcrypt: cost %d exceeds %d", cost, maxBcryptCost)
+	}
+
+	// The salt and checksum are encoded with bcrypt's own base64 alphabet.
+	for _, c := range hash[7:] {
+		if !isBcryptBase64(c) {
+			return fmt.Errorf("bcrypt: malformed hash")
+		}
+	}
+	return nil
+}
+
+func isBcryptBase64(c rune) bool {
+	return c == '.' || c == '/' ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= '0' && c <= '9')
+}
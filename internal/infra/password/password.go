@@ -0,0 +1,37 @@
+// Package password verifies password hashes made by other systems, so users
+// imported from them can log in and have their hash upgraded to Argon2id.
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+// maxKeyLength bounds the derived key length of PBKDF2 and scrypt hashes.
+const maxKeyLength = 64
+
+// NewVerifiers returns the supported legacy hash formats.
+func NewVerifiers() []biz.PasswordVerifier {
+	return []biz.PasswordVerifier{
+		Bcrypt{},
+		PBKDF2{},
+		Scrypt{},
+	}
+}
+
+// decodeBase64 accepts standard and URL-safe base64, with or without
+// padding, as well as passlib's variant that uses "." instead of "+".
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.ReplaceAll(s, ".", "+"), "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+func equal(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
@@ -0,0 +1,95 @@
+package password
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	djangoPBKDF2Prefix  = "pbkdf2_sha256$"
+	passlibPBKDF2Prefix = "$pbkdf2-sha256$"
+
+	// maxPBKDF2Iterations bounds the work a single login can cause.
+	maxPBKDF2Iterations = 2_000_000
+)
+
+// PBKDF2 verifies PBKDF2-SHA256 hashes in the Django format
+// (pbkdf2_sha256$iterations$salt$hash) and the passlib format
+// ($pbkdf2-sha256$iterations$salt$hash).
+type PBKDF2 struct{}
+
+type pbkdf2Hash struct {
+	iterations int
+	salt       []byte
+	key        []byte
+}
+
+func (PBKDF2) Recognizes(hash string) bool {
+	_, err := parsePBKDF2(hash)
+	return err == nil
+}
+
+func (PBKDF2) Verify(password, hash string) (bool, error) {
+	h, err := parsePBKDF2(hash)
+	if err != nil {
+		return false, err
+	}
+
+	got, err := pbkdf2.Key(sha256.New, password, h.salt, h.iterations, len(h.key))
+	if err != nil {
+		return false, err
+	}
+
+	return equal(got, h.key), nil
+}
+
+func parsePBKDF2(hash string) (*pbkdf2Hash, error) {
+	var (
+		rest   string
+		django bool
+	)
+	if r, ok := strings.CutPrefix(hash, djangoPBKDF2Prefix); ok {
+		rest, django = r, true
+	} else if r, ok := strings.CutPrefix(hash, passlibPBKDF2Prefix); ok {
+		rest = r
+	} else {
+		return nil, fmt.Errorf("pbkdf2: unknown prefix")
+	}
+
+	parts := strings.Split(rest, "$")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("pbkdf2: malformed hash")
+	}
+
+	iterations, err := strconv.Atoi(parts[0])
+	if err != nil || iterations <= 0 {
+		return nil, fmt.Errorf("pbkdf2: invalid iteration count")
+	}
+	if iterations > maxPBKDF2Iterations {
+		return nil, fmt.Errorf("pbkdf2: iteration count %d exceeds %d", iterations, maxPBKDF2Iterations)
+	}
+
+	// Django uses the salt as is; passlib stores it base64 encoded.
+	salt := []byte(parts[1])
+	if !django {
+		if salt, err = decodeBase64(parts[1]); err != nil {
+			return nil, fmt.Errorf("pbkdf2: invalid salt: %w", err)
+		}
+	}
+	if len(salt) == 0 {
+		return nil, fmt.Errorf("pbkdf2: empty salt")
+	}
+
+	key, err := decodeBase64(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("pbkdf2: invalid hash: %w", err)
+	}
+	if len(key) == 0 || len(key) > maxKeyLength {
+		return nil, fmt.Errorf("pbkdf2: invalid hash length")
+	}
+
+	return &pbkdf2Hash{iterations: iterations, salt: salt, key: key}, nil
+}
@@ -0,0 +1,90 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	scryptPrefix = "$scrypt$"
+
+	// maxScryptMemory and maxScryptParallelism bound the work a single
+	// login can cause. scrypt needs 128*r*N bytes.
+	maxScryptMemory      = 256 << 20
+	maxScryptParallelism = 16
+)
+
+// Scrypt verifies scrypt hashes in the passlib format
+// ($scrypt$ln=16,r=8,p=1$salt$hash).
+type Scrypt struct{}
+
+type scryptHash struct {
+	n, r, p int
+	salt    []byte
+	key     []byte
+}
+
+func (Scrypt) Recognizes(hash string) bool {
+	_, err := parseScrypt(hash)
+	return err == nil
+}
+
+func (Scrypt) Verify(password, hash string) (bool, error) {
+	h, err := parseScrypt(hash)
+	if err != nil {
+		return false, err
+	}
+
+	got, err := scrypt.Key([]byte(password), h.salt, h.n, h.r, h.p, len(h.key))
+	if err != nil {
+		return false, err
+	}
+
+	return equal(got, h.key), nil
+}
+
+func parseScrypt(hash string) (*scryptHash, error) {
+	rest, ok := strings.CutPrefix(hash, scryptPrefix)
+	if !ok {
+		return nil, fmt.Errorf("scrypt: unknown prefix")
+	}
+
+	parts := strings.Split(rest, "$")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("scrypt: malformed hash")
+	}
+
+	var ln, r, p int
+	if n, err := fmt.Sscanf(parts[0], "ln=%d,r=%d,p=%d", &ln, &r, &p); err != nil || n != 3 {
+		return nil, fmt.Errorf("scrypt: invalid parameters")
+	}
+	if fmt.Sprintf("ln=%d,r=%d,p=%d", ln, r, p) != parts[0] {
+		return nil, fmt.Errorf("scrypt: invalid parameters")
+	}
+	if ln <= 0 || ln >= 32 || r <= 0 || p <= 0 {
+		return nil, fmt.Errorf("scrypt: invalid cost")
+	}
+	if 128*r > maxScryptMemory>>ln || p > maxScryptParallelism {
+		return nil, fmt.Errorf("scrypt: cost exceeds the limit")
+	}
+
+	salt, err := decodeBase64(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("scrypt: invalid salt: %w", err)
+	}
+	if len(salt) == 0 {
+		return nil, fmt.Errorf("scrypt: empty salt")
+	}
+
+	key, err := decodeBase64(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("scrypt: invalid hash: %w", err)
+	}
+	if len(key) == 0 || len(key) > maxKeyLength {
+		return nil, fmt.Errorf("scrypt: invalid hash length")
+	}
+
+	return &scryptHash{n: 1 << ln, r: r, p: p, salt: salt, key: key}, nil
+}
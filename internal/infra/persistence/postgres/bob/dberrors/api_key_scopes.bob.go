@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var APIKeyScopeErrors = &apiKeyScopeErrors{
+	ErrUniqueApiKeyScopesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "api_key_scopes",
+		columns: []string{"api_key_id", "object", "action"},
+		s:       "api_key_scopes_pkey",
+	},
+}
+
+type apiKeyScopeErrors struct {
+	ErrUniqueApiKeyScopesPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var AuthzManagedPolicyErrors = &authzManagedPolicyErrors{
+	ErrUniqueAuthzManagedPoliciesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "authz_managed_policies",
+		columns: []string{"subject", "domain", "object", "action"},
+		s:       "authz_managed_policies_pkey",
+	},
+}
+
+type authzManagedPolicyErrors struct {
+	ErrUniqueAuthzManagedPoliciesPkey *UniqueConstraintError
+}
@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var ExternalIdentityErrors = &externalIdentityErrors{
+	ErrUniqueExternalIdentitiesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "external_identities",
+		columns: []string{"id"},
+		s:       "external_identities_pkey",
+	},
+
+	ErrUniqueExternalIdentitiesProviderSubjectKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "external_identities",
+		columns: []string{"provider", "subject"},
+		s:       "external_identities_provider_subject_key",
+	},
+}
+
+type externalIdentityErrors struct {
+	ErrUniqueExternalIdentitiesPkey *UniqueConstraintError
+
+	ErrUniqueExternalIdentitiesProviderSubjectKey *UniqueConstraintError
+}
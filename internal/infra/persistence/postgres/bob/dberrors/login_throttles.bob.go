@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var LoginThrottleErrors = &loginThrottleErrors{
+	ErrUniqueLoginThrottlesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "login_throttles",
+		columns: []string{"key"},
+		s:       "login_throttles_pkey",
+	},
+}
+
+type loginThrottleErrors struct {
+	ErrUniqueLoginThrottlesPkey *UniqueConstraintError
+}
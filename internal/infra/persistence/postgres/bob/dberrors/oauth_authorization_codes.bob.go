@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OauthAuthorizationCodeErrors = &oauthAuthorizationCodeErrors{
+	ErrUniqueOauthAuthorizationCodesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oauth_authorization_codes",
+		columns: []string{"code_hash"},
+		s:       "oauth_authorization_codes_pkey",
+	},
+}
+
+type oauthAuthorizationCodeErrors struct {
+	ErrUniqueOauthAuthorizationCodesPkey *UniqueConstraintError
+}
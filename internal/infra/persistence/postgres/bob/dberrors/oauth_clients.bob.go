@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OauthClientErrors = &oauthClientErrors{
+	ErrUniqueOauthClientsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oauth_clients",
+		columns: []string{"id"},
+		s:       "oauth_clients_pkey",
+	},
+}
+
+type oauthClientErrors struct {
+	ErrUniqueOauthClientsPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OauthGrantErrors = &oauthGrantErrors{
+	ErrUniqueOauthGrantsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oauth_grants",
+		columns: []string{"session_id"},
+		s:       "oauth_grants_pkey",
+	},
+}
+
+type oauthGrantErrors struct {
+	ErrUniqueOauthGrantsPkey *UniqueConstraintError
+}
@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var PasskeyErrors = &passkeyErrors{
+	ErrUniquePasskeysPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "passkeys",
+		columns: []string{"id"},
+		s:       "passkeys_pkey",
+	},
+
+	ErrUniquePasskeysCredentialIdKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "passkeys",
+		columns: []string{"credential_id"},
+		s:       "passkeys_credential_id_key",
+	},
+}
+
+type passkeyErrors struct {
+	ErrUniquePasskeysPkey *UniqueConstraintError
+
+	ErrUniquePasskeysCredentialIdKey *UniqueConstraintError
+}
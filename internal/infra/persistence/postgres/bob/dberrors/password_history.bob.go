@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var PasswordHistoryErrors = &passwordHistoryErrors{
+	ErrUniquePasswordHistoryPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "password_history",
+		columns: []string{"id"},
+		s:       "password_history_pkey",
+	},
+}
+
+type passwordHistoryErrors struct {
+	ErrUniquePasswordHistoryPkey *UniqueConstraintError
+}
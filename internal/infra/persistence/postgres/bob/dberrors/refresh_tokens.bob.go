@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var RefreshTokenErrors = &refreshTokenErrors{
+	ErrUniqueRefreshTokensPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "refresh_tokens",
+		columns: []string{"id"},
+		s:       "refresh_tokens_pkey",
+	},
+}
+
+type refreshTokenErrors struct {
+	ErrUniqueRefreshTokensPkey *UniqueConstraintError
+}
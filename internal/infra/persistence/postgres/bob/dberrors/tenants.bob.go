@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var TenantErrors = &tenantErrors{
+	ErrUniqueTenantsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "tenants",
+		columns: []string{"id"},
+		s:       "tenants_pkey",
+	},
+}
+
+type tenantErrors struct {
+	ErrUniqueTenantsPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var UserMfaErrors = &userMfaErrors{
+	ErrUniqueUserMfaPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "user_mfa",
+		columns: []string{"user_id"},
+		s:       "user_mfa_pkey",
+	},
+}
+
+type userMfaErrors struct {
+	ErrUniqueUserMfaPkey *UniqueConstraintError
+}
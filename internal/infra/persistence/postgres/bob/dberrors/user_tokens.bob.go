@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var UserTokenErrors = &userTokenErrors{
+	ErrUniqueUserTokensPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "user_tokens",
+		columns: []string{"id"},
+		s:       "user_tokens_pkey",
+	},
+
+	ErrUniqueUserTokensTokenHashKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "user_tokens",
+		columns: []string{"token_hash"},
+		s:       "user_tokens_token_hash_key",
+	},
+}
+
+type userTokenErrors struct {
+	ErrUniqueUserTokensPkey *UniqueConstraintError
+
+	ErrUniqueUserTokensTokenHashKey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var WebauthnSessionErrors = &webauthnSessionErrors{
+	ErrUniqueWebauthnSessionsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "webauthn_sessions",
+		columns: []string{"id"},
+		s:       "webauthn_sessions_pkey",
+	},
+}
+
+type webauthnSessionErrors struct {
+	ErrUniqueWebauthnSessionsPkey *UniqueConstraintError
+}
@@ -0,0 +1,646 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// APIKeyScope is an object representing the database table.
+type APIKeyScope struct {
+	APIKeyID uuid.UUID `db:"api_key_id,pk" `
+	Object   string    `db:"object,pk" `
+	Action   string    `db:"action,pk" `
+
+	R apiKeyScopeR `db:"-" `
+}
+
+// APIKeyScopeSlice is an alias for a slice of pointers to APIKeyScope.
+// This should almost always be used instead of []*APIKeyScope.
+type APIKeyScopeSlice []*APIKeyScope
+
+// APIKeyScopes contains methods to work with the api_key_scopes table
+var APIKeyScopes = psql.NewTablex[*APIKeyScope, APIKeyScopeSlice, *APIKeyScopeSetter]("", "api_key_scopes", buildAPIKeyScopeColumns("api_key_scopes"))
+
+// APIKeyScopesQuery is a query on the api_key_scopes table
+type APIKeyScopesQuery = *psql.ViewQuery[*APIKeyScope, APIKeyScopeSlice]
+
+// apiKeyScopeR is where relationships are stored.
+type apiKeyScopeR struct {
+	APIKey *APIKey // api_key_scopes_api_key_id_fkey
+}
+
+func buildAPIKeyScopeColumns(alias string) apiKeyScopeColumns {
+	return apiKeyScopeColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"api_key_id", "object", "action",
+		).WithParent("api_key_scopes"),
+		tableAlias: alias,
+		APIKeyID:   psql.Quote(alias, "api_key_id"),
+		Object:     psql.Quote(alias, "object"),
+		Action:     psql.Quote(alias, "action"),
+	}
+}
+
+type apiKeyScopeColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	APIKeyID   psql.Expression
+	Object     psql.Expression
+	Action     psql.Expression
+}
+
+func (c apiKeyScopeColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (apiKeyScopeColumns) AliasedAs(alias string) apiKeyScopeColumns {
+	return buildAPIKeyScopeColumns(alias)
+}
+
+// APIKeyScopeSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type APIKeyScopeSetter struct {
+	APIKeyID omit.Val[uuid.UUID] `db:"api_key_id,pk" `
+	Object   omit.Val[string]    `db:"object,pk" `
+	Action   omit.Val[string]    `db:"action,pk" `
+}
+
+func (s APIKeyScopeSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.APIKeyID.IsValue() {
+		vals = append(vals, "api_key_id")
+	}
+	if s.Object.IsValue() {
+		vals = append(vals, "object")
+	}
+	if s.Action.IsValue() {
+		vals = append(vals, "action")
+	}
+	return vals
+}
+
+func (s APIKeyScopeSetter) Overwrite(t *APIKeyScope) {
+	if s.APIKeyID.IsValue() {
+		t.APIKeyID = s.APIKeyID.MustGet()
+	}
+	if s.Object.IsValue() {
+		t.Object = s.Object.MustGet()
+	}
+	if s.Action.IsValue() {
+		t.Action = s.Action.MustGet()
+	}
+}
+
+func (s *APIKeyScopeSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return APIKeyScopes.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.APIKeyID.IsValue() {
+			vals[0] = psql.Arg(s.APIKeyID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Object.IsValue() {
+			vals[1] = psql.Arg(s.Object.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Action.IsValue() {
+			vals[2] = psql.Arg(s.Action.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s APIKeyScopeSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s APIKeyScopeSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.APIKeyID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "api_key_id")...),
+			psql.Arg(s.APIKeyID),
+		}})
+	}
+
+	if s.Object.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "object")...),
+			psql.Arg(s.Object),
+		}})
+	}
+
+	if s.Action.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "action")...),
+			psql.Arg(s.Action),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAPIKeyScope retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAPIKeyScope(ctx context.Context, exec bob.Executor, APIKeyIDPK uuid.UUID, ObjectPK string, ActionPK string, cols ...string) (*APIKeyScope, error) {
+	if len(cols) == 0 {
+		return APIKeyScopes.Query(
+			sm.Where(APIKeyScopes.Columns.APIKeyID.EQ(psql.Arg(APIKeyIDPK))),
+			sm.Where(APIKeyScopes.Columns.Object.EQ(psql.Arg(ObjectPK))),
+			sm.Where(APIKeyScopes.Columns.Action.EQ(psql.Arg(ActionPK))),
+		).One(ctx, exec)
+	}
+
+	return APIKeyScopes.Query(
+		sm.Where(APIKeyScopes.Columns.APIKeyID.EQ(psql.Arg(APIKeyIDPK))),
+		sm.Where(APIKeyScopes.Columns.Object.EQ(psql.Arg(ObjectPK))),
+		sm.Where(APIKeyScopes.Columns.Action.EQ(psql.Arg(ActionPK))),
+		sm.Columns(APIKeyScopes.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// APIKeyScopeExists checks the presence of a single record by primary key
+func APIKeyScopeExists(ctx context.Context, exec bob.Executor, APIKeyIDPK uuid.UUID, ObjectPK string, ActionPK string) (bool, error) {
+	return APIKeyScopes.Query(
+		sm.Where(APIKeyScopes.Columns.APIKeyID.EQ(psql.Arg(APIKeyIDPK))),
+		sm.Where(APIKeyScopes.Columns.Object.EQ(psql.Arg(ObjectPK))),
+		sm.Where(APIKeyScopes.Columns.Action.EQ(psql.Arg(ActionPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after APIKeyScope is retrieved from the database
+func (o *APIKeyScope) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = APIKeyScopes.AfterSelectHooks.RunHooks(ctx, exec, APIKeyScopeSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = APIKeyScopes.AfterInsertHooks.RunHooks(ctx, exec, APIKeyScopeSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = APIKeyScopes.AfterUpdateHooks.RunHooks(ctx, exec, APIKeyScopeSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = APIKeyScopes.AfterDeleteHooks.RunHooks(ctx, exec, APIKeyScopeSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the APIKeyScope
+func (o *APIKeyScope) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.APIKeyID,
+		o.Object,
+		o.Action,
+	)
+}
+
+func (o *APIKeyScope) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("api_key_scopes", "api_key_id"), psql.Quote("api_key_scopes", "object"), psql.Quote("api_key_scopes", "action")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the APIKeyScope
+func (o *APIKeyScope) Update(ctx context.Context, exec bob.Executor, s *APIKeyScopeSetter) error {
+	v, err := APIKeyScopes.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single APIKeyScope record with an executor
+func (o *APIKeyScope) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := APIKeyScopes.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the APIKeyScope using the executor
+func (o *APIKeyScope) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := APIKeyScopes.Query(
+		sm.Where(APIKeyScopes.Columns.APIKeyID.EQ(psql.Arg(o.APIKeyID))),
+		sm.Where(APIKeyScopes.Columns.Object.EQ(psql.Arg(o.Object))),
+		sm.Where(APIKeyScopes.Columns.Action.EQ(psql.Arg(o.Action))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after APIKeyScopeSlice is retrieved from the database
+func (o APIKeyScopeSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = APIKeyScopes.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = APIKeyScopes.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = APIKeyScopes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = APIKeyScopes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o APIKeyScopeSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("api_key_scopes", "api_key_id"), psql.Quote("api_key_scopes", "object"), psql.Quote("api_key_scopes", "action")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o APIKeyScopeSlice) copyMatchingRows(from ...*APIKeyScope) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.APIKeyID != old.APIKeyID {
+				continue
+			}
+			if new.Object != old.Object {
+				continue
+			}
+			if new.Action != old.Action {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o APIKeyScopeSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return APIKeyScopes.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *APIKeyScope:
+				o.copyMatchingRows(retrieved)
+			case []*APIKeyScope:
+				o.copyMatchingRows(retrieved...)
+			case APIKeyScopeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a APIKeyScope or a slice of APIKeyScope
+				// then run the AfterUpdateHooks on the slice
+				_, err = APIKeyScopes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o APIKeyScopeSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return APIKeyScopes.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *APIKeyScope:
+				o.copyMatchingRows(retrieved)
+			case []*APIKeyScope:
+				o.copyMatchingRows(retrieved...)
+			case APIKeyScopeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a APIKeyScope or a slice of APIKeyScope
+				// then run the AfterDeleteHooks on the slice
+				_, err = APIKeyScopes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o APIKeyScopeSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals APIKeyScopeSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := APIKeyScopes.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o APIKeyScopeSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := APIKeyScopes.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o APIKeyScopeSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := APIKeyScopes.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// APIKey starts a query for related objects on api_keys
+func (o *APIKeyScope) APIKey(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	return APIKeys.Query(append(mods,
+		sm.Where(APIKeys.Columns.ID.EQ(psql.Arg(o.APIKeyID))),
+	)...)
+}
+
+func (os APIKeyScopeSlice) APIKey(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	pkAPIKeyID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkAPIKeyID = append(pkAPIKeyID, o.APIKeyID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkAPIKeyID), "uuid[]")),
+	))
+
+	return APIKeys.Query(append(mods,
+		sm.Where(psql.Group(APIKeys.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachAPIKeyScopeAPIKey0(ctx context.Context, exec bob.Executor, count int, apiKeyScope0 *APIKeyScope, apiKey1 *APIKey) (*APIKeyScope, error) {
+	setter := &APIKeyScopeSetter{
+		APIKeyID: omit.From(apiKey1.ID),
+	}
+
+	err := apiKeyScope0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAPIKeyScopeAPIKey0: %w", err)
+	}
+
+	return apiKeyScope0, nil
+}
+
+func (apiKeyScope0 *APIKeyScope) InsertAPIKey(ctx context.Context, exec bob.Executor, related *APIKeySetter) error {
+	var err error
+
+	apiKey1, err := APIKeys.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAPIKeyScopeAPIKey0(ctx, exec, 1, apiKeyScope0, apiKey1)
+	if err != nil {
+		return err
+	}
+
+	apiKeyScope0.R.APIKey = apiKey1
+
+	apiKey1.R.APIKeyScopes = append(apiKey1.R.APIKeyScopes, apiKeyScope0)
+
+	return nil
+}
+
+func (apiKeyScope0 *APIKeyScope) AttachAPIKey(ctx context.Context, exec bob.Executor, apiKey1 *APIKey) error {
+	var err error
+
+	_, err = attachAPIKeyScopeAPIKey0(ctx, exec, 1, apiKeyScope0, apiKey1)
+	if err != nil {
+		return err
+	}
+
+	apiKeyScope0.R.APIKey = apiKey1
+
+	apiKey1.R.APIKeyScopes = append(apiKey1.R.APIKeyScopes, apiKeyScope0)
+
+	return nil
+}
+
+type apiKeyScopeWhere[Q psql.Filterable] struct {
+	APIKeyID psql.WhereMod[Q, uuid.UUID]
+	Object   psql.WhereMod[Q, string]
+	Action   psql.WhereMod[Q, string]
+}
+
+func (apiKeyScopeWhere[Q]) AliasedAs(alias string) apiKeyScopeWhere[Q] {
+	return buildAPIKeyScopeWhere[Q](buildAPIKeyScopeColumns(alias))
+}
+
+func buildAPIKeyScopeWhere[Q psql.Filterable](cols apiKeyScopeColumns) apiKeyScopeWhere[Q] {
+	return apiKeyScopeWhere[Q]{
+		APIKeyID: psql.Where[Q, uuid.UUID](cols.APIKeyID),
+		Object:   psql.Where[Q, string](cols.Object),
+		Action:   psql.Where[Q, string](cols.Action),
+	}
+}
+
+func (o *APIKeyScope) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "APIKey":
+		rel, ok := retrieved.(*APIKey)
+		if !ok {
+			return fmt.Errorf("apiKeyScope cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.APIKey = rel
+
+		if rel != nil {
+			rel.R.APIKeyScopes = APIKeyScopeSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("apiKeyScope has no relationship %q", name)
+	}
+}
+
+type apiKeyScopePreloader struct {
+	APIKey func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildAPIKeyScopePreloader() apiKeyScopePreloader {
+	return apiKeyScopePreloader{
+		APIKey: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*APIKey, APIKeySlice](psql.PreloadRel{
+				Name: "APIKey",
+				Sides: []psql.PreloadSide{
+					{
+						From:        APIKeyScopes,
+						To:          APIKeys,
+						FromColumns: []string{"api_key_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, APIKeys.Columns.Names(), opts...)
+		},
+	}
+}
+
+type apiKeyScopeThenLoader[Q orm.Loadable] struct {
+	APIKey func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildAPIKeyScopeThenLoader[Q orm.Loadable]() apiKeyScopeThenLoader[Q] {
+	type APIKeyLoadInterface interface {
+		LoadAPIKey(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return apiKeyScopeThenLoader[Q]{
+		APIKey: thenLoadBuilder[Q](
+			"APIKey",
+			func(ctx context.Context, exec bob.Executor, retrieved APIKeyLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAPIKey(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAPIKey loads the apiKeyScope's APIKey into the .R struct
+func (o *APIKeyScope) LoadAPIKey(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.APIKey = nil
+
+	related, err := o.APIKey(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.APIKeyScopes = APIKeyScopeSlice{o}
+
+	o.R.APIKey = related
+	return nil
+}
+
+// LoadAPIKey loads the apiKeyScope's APIKey into the .R struct
+func (os APIKeyScopeSlice) LoadAPIKey(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	apiKeys, err := os.APIKey(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range apiKeys {
+
+			if !(o.APIKeyID == rel.ID) {
+				continue
+			}
+
+			rel.R.APIKeyScopes = append(rel.R.APIKeyScopes, o)
+
+			o.R.APIKey = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type apiKeyScopeJoins[Q dialect.Joinable] struct {
+	typ    string
+	APIKey modAs[Q, apiKeyColumns]
+}
+
+func (j apiKeyScopeJoins[Q]) aliasedAs(alias string) apiKeyScopeJoins[Q] {
+	return buildAPIKeyScopeJoins[Q](buildAPIKeyScopeColumns(alias), j.typ)
+}
+
+func buildAPIKeyScopeJoins[Q dialect.Joinable](cols apiKeyScopeColumns, typ string) apiKeyScopeJoins[Q] {
+	return apiKeyScopeJoins[Q]{
+		typ: typ,
+		APIKey: modAs[Q, apiKeyColumns]{
+			c: APIKeys.Columns,
+			f: func(to apiKeyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, APIKeys.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.APIKeyID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,449 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// AuthzManagedPolicy is an object representing the database table.
+type AuthzManagedPolicy struct {
+	Subject string `db:"subject,pk" `
+	Domain  string `db:"domain,pk" `
+	Object  string `db:"object,pk" `
+	Action  string `db:"action,pk" `
+}
+
+// AuthzManagedPolicySlice is an alias for a slice of pointers to AuthzManagedPolicy.
+// This should almost always be used instead of []*AuthzManagedPolicy.
+type AuthzManagedPolicySlice []*AuthzManagedPolicy
+
+// AuthzManagedPolicies contains methods to work with the authz_managed_policies table
+var AuthzManagedPolicies = psql.NewTablex[*AuthzManagedPolicy, AuthzManagedPolicySlice, *AuthzManagedPolicySetter]("", "authz_managed_policies", buildAuthzManagedPolicyColumns("authz_managed_policies"))
+
+// AuthzManagedPoliciesQuery is a query on the authz_managed_policies table
+type AuthzManagedPoliciesQuery = *psql.ViewQuery[*AuthzManagedPolicy, AuthzManagedPolicySlice]
+
+func buildAuthzManagedPolicyColumns(alias string) authzManagedPolicyColumns {
+	return authzManagedPolicyColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"subject", "domain", "object", "action",
+		).WithParent("authz_managed_policies"),
+		tableAlias: alias,
+		Subject:    psql.Quote(alias, "subject"),
+		Domain:     psql.Quote(alias, "domain"),
+		Object:     psql.Quote(alias, "object"),
+		Action:     psql.Quote(alias, "action"),
+	}
+}
+
+type authzManagedPolicyColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	Subject    psql.Expression
+	Domain     psql.Expression
+	Object     psql.Expression
+	Action     psql.Expression
+}
+
+func (c authzManagedPolicyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (authzManagedPolicyColumns) AliasedAs(alias string) authzManagedPolicyColumns {
+	return buildAuthzManagedPolicyColumns(alias)
+}
+
+// AuthzManagedPolicySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type AuthzManagedPolicySetter struct {
+	Subject omit.Val[string] `db:"subject,pk" `
+	Domain  omit.Val[string] `db:"domain,pk" `
+	Object  omit.Val[string] `db:"object,pk" `
+	Action  omit.Val[string] `db:"action,pk" `
+}
+
+func (s AuthzManagedPolicySetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.Subject.IsValue() {
+		vals = append(vals, "subject")
+	}
+	if s.Domain.IsValue() {
+		vals = append(vals, "domain")
+	}
+	if s.Object.IsValue() {
+		vals = append(vals, "object")
+	}
+	if s.Action.IsValue() {
+		vals = append(vals, "action")
+	}
+	return vals
+}
+
+func (s AuthzManagedPolicySetter) Overwrite(t *AuthzManagedPolicy) {
+	if s.Subject.IsValue() {
+		t.Subject = s.Subject.MustGet()
+	}
+	if s.Domain.IsValue() {
+		t.Domain = s.Domain.MustGet()
+	}
+	if s.Object.IsValue() {
+		t.Object = s.Object.MustGet()
+	}
+	if s.Action.IsValue() {
+		t.Action = s.Action.MustGet()
+	}
+}
+
+func (s *AuthzManagedPolicySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return AuthzManagedPolicies.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.Subject.IsValue() {
+			vals[0] = psql.Arg(s.Subject.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Domain.IsValue() {
+			vals[1] = psql.Arg(s.Domain.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Object.IsValue() {
+			vals[2] = psql.Arg(s.Object.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Action.IsValue() {
+			vals[3] = psql.Arg(s.Action.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s AuthzManagedPolicySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s AuthzManagedPolicySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.Subject.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "subject")...),
+			psql.Arg(s.Subject),
+		}})
+	}
+
+	if s.Domain.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "domain")...),
+			psql.Arg(s.Domain),
+		}})
+	}
+
+	if s.Object.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "object")...),
+			psql.Arg(s.Object),
+		}})
+	}
+
+	if s.Action.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "action")...),
+			psql.Arg(s.Action),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAuthzManagedPolicy retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAuthzManagedPolicy(ctx context.Context, exec bob.Executor, SubjectPK string, DomainPK string, ObjectPK string, ActionPK string, cols ...string) (*AuthzManagedPolicy, error) {
+	if len(cols) == 0 {
+		return AuthzManagedPolicies.Query(
+			sm.Where(AuthzManagedPolicies.Columns.Subject.EQ(psql.Arg(SubjectPK))),
+			sm.Where(AuthzManagedPolicies.Columns.Domain.EQ(psql.Arg(DomainPK))),
+			sm.Where(AuthzManagedPolicies.Columns.Object.EQ(psql.Arg(ObjectPK))),
+			sm.Where(AuthzManagedPolicies.Columns.Action.EQ(psql.Arg(ActionPK))),
+		).One(ctx, exec)
+	}
+
+	return AuthzManagedPolicies.Query(
+		sm.Where(AuthzManagedPolicies.Columns.Subject.EQ(psql.Arg(SubjectPK))),
+		sm.Where(AuthzManagedPolicies.Columns.Domain.EQ(psql.Arg(DomainPK))),
+		sm.Where(AuthzManagedPolicies.Columns.Object.EQ(psql.Arg(ObjectPK))),
+		sm.Where(AuthzManagedPolicies.Columns.Action.EQ(psql.Arg(ActionPK))),
+		sm.Columns(AuthzManagedPolicies.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// AuthzManagedPolicyExists checks the presence of a single record by primary key
+func AuthzManagedPolicyExists(ctx context.Context, exec bob.Executor, SubjectPK string, DomainPK string, ObjectPK string, ActionPK string) (bool, error) {
+	return AuthzManagedPolicies.Query(
+		sm.Where(AuthzManagedPolicies.Columns.Subject.EQ(psql.Arg(SubjectPK))),
+		sm.Where(AuthzManagedPolicies.Columns.Domain.EQ(psql.Arg(DomainPK))),
+		sm.Where(AuthzManagedPolicies.Columns.Object.EQ(psql.Arg(ObjectPK))),
+		sm.Where(AuthzManagedPolicies.Columns.Action.EQ(psql.Arg(ActionPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after AuthzManagedPolicy is retrieved from the database
+func (o *AuthzManagedPolicy) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AuthzManagedPolicies.AfterSelectHooks.RunHooks(ctx, exec, AuthzManagedPolicySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = AuthzManagedPolicies.AfterInsertHooks.RunHooks(ctx, exec, AuthzManagedPolicySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = AuthzManagedPolicies.AfterUpdateHooks.RunHooks(ctx, exec, AuthzManagedPolicySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = AuthzManagedPolicies.AfterDeleteHooks.RunHooks(ctx, exec, AuthzManagedPolicySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the AuthzManagedPolicy
+func (o *AuthzManagedPolicy) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.Subject,
+		o.Domain,
+		o.Object,
+		o.Action,
+	)
+}
+
+func (o *AuthzManagedPolicy) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("authz_managed_policies", "subject"), psql.Quote("authz_managed_policies", "domain"), psql.Quote("authz_managed_policies", "object"), psql.Quote("authz_managed_policies", "action")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the AuthzManagedPolicy
+func (o *AuthzManagedPolicy) Update(ctx context.Context, exec bob.Executor, s *AuthzManagedPolicySetter) error {
+	v, err := AuthzManagedPolicies.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single AuthzManagedPolicy record with an executor
+func (o *AuthzManagedPolicy) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := AuthzManagedPolicies.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the AuthzManagedPolicy using the executor
+func (o *AuthzManagedPolicy) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := AuthzManagedPolicies.Query(
+		sm.Where(AuthzManagedPolicies.Columns.Subject.EQ(psql.Arg(o.Subject))),
+		sm.Where(AuthzManagedPolicies.Columns.Domain.EQ(psql.Arg(o.Domain))),
+		sm.Where(AuthzManagedPolicies.Columns.Object.EQ(psql.Arg(o.Object))),
+		sm.Where(AuthzManagedPolicies.Columns.Action.EQ(psql.Arg(o.Action))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after AuthzManagedPolicySlice is retrieved from the database
+func (o AuthzManagedPolicySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AuthzManagedPolicies.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = AuthzManagedPolicies.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = AuthzManagedPolicies.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = AuthzManagedPolicies.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o AuthzManagedPolicySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("authz_managed_policies", "subject"), psql.Quote("authz_managed_policies", "domain"), psql.Quote("authz_managed_policies", "object"), psql.Quote("authz_managed_policies", "action")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o AuthzManagedPolicySlice) copyMatchingRows(from ...*AuthzManagedPolicy) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.Subject != old.Subject {
+				continue
+			}
+			if new.Domain != old.Domain {
+				continue
+			}
+			if new.Object != old.Object {
+				continue
+			}
+			if new.Action != old.Action {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o AuthzManagedPolicySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AuthzManagedPolicies.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AuthzManagedPolicy:
+				o.copyMatchingRows(retrieved)
+			case []*AuthzManagedPolicy:
+				o.copyMatchingRows(retrieved...)
+			case AuthzManagedPolicySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AuthzManagedPolicy or a slice of AuthzManagedPolicy
+				// then run the AfterUpdateHooks on the slice
+				_, err = AuthzManagedPolicies.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o AuthzManagedPolicySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AuthzManagedPolicies.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AuthzManagedPolicy:
+				o.copyMatchingRows(retrieved)
+			case []*AuthzManagedPolicy:
+				o.copyMatchingRows(retrieved...)
+			case AuthzManagedPolicySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AuthzManagedPolicy or a slice of AuthzManagedPolicy
+				// then run the AfterDeleteHooks on the slice
+				_, err = AuthzManagedPolicies.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o AuthzManagedPolicySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals AuthzManagedPolicySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AuthzManagedPolicies.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o AuthzManagedPolicySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AuthzManagedPolicies.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o AuthzManagedPolicySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := AuthzManagedPolicies.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type authzManagedPolicyWhere[Q psql.Filterable] struct {
+	Subject psql.WhereMod[Q, string]
+	Domain  psql.WhereMod[Q, string]
+	Object  psql.WhereMod[Q, string]
+	Action  psql.WhereMod[Q, string]
+}
+
+func (authzManagedPolicyWhere[Q]) AliasedAs(alias string) authzManagedPolicyWhere[Q] {
+	return buildAuthzManagedPolicyWhere[Q](buildAuthzManagedPolicyColumns(alias))
+}
+
+func buildAuthzManagedPolicyWhere[Q psql.Filterable](cols authzManagedPolicyColumns) authzManagedPolicyWhere[Q] {
+	return authzManagedPolicyWhere[Q]{
+		Subject: psql.Where[Q, string](cols.Subject),
+		Domain:  psql.Where[Q, string](cols.Domain),
+		Object:  psql.Where[Q, string](cols.Object),
+		Action:  psql.Where[Q, string](cols.Action),
+	}
+}
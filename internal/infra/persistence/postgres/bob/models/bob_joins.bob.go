@@ -31,7 +31,23 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 	}
 }
 
-type joins[Q dialect.Joinable] struct{}
+type joins[Q dialect.Joinable] struct {
+	Users                   joinSet[userJoins[Q]]
+	RefreshTokens           joinSet[refreshTokenJoins[Q]]
+	Sessions                joinSet[sessionJoins[Q]]
+	UserMfas                joinSet[userMfaJoins[Q]]
+	MfaRecoveryCodes        joinSet[mfaRecoveryCodeJoins[Q]]
+	UserTokens              joinSet[userTokenJoins[Q]]
+	APIKeys                 joinSet[apiKeyJoins[Q]]
+	APIKeyScopes            joinSet[apiKeyScopeJoins[Q]]
+	OauthClients            joinSet[oauthClientJoins[Q]]
+	OauthAuthorizationCodes joinSet[oauthAuthorizationCodeJoins[Q]]
+	OauthGrants             joinSet[oauthGrantJoins[Q]]
+	ExternalIdentities      joinSet[externalIdentityJoins[Q]]
+	PasswordHistories       joinSet[passwordHistoryJoins[Q]]
+	Passkeys                joinSet[passkeyJoins[Q]]
+	WebauthnSessions        joinSet[webauthnSessionJoins[Q]]
+}
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
 	return joinSet[Q]{
@@ -42,7 +58,23 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 }
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
-	return joins[Q]{}
+	return joins[Q]{
+		Users:                   buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
+		RefreshTokens:           buildJoinSet[refreshTokenJoins[Q]](RefreshTokens.Columns, buildRefreshTokenJoins),
+		Sessions:                buildJoinSet[sessionJoins[Q]](Sessions.Columns, buildSessionJoins),
+		UserMfas:                buildJoinSet[userMfaJoins[Q]](UserMfas.Columns, buildUserMfaJoins),
+		MfaRecoveryCodes:        buildJoinSet[mfaRecoveryCodeJoins[Q]](MfaRecoveryCodes.Columns, buildMfaRecoveryCodeJoins),
+		UserTokens:              buildJoinSet[userTokenJoins[Q]](UserTokens.Columns, buildUserTokenJoins),
+		APIKeys:                 buildJoinSet[apiKeyJoins[Q]](APIKeys.Columns, buildAPIKeyJoins),
+		APIKeyScopes:            buildJoinSet[apiKeyScopeJoins[Q]](APIKeyScopes.Columns, buildAPIKeyScopeJoins),
+		OauthClients:            buildJoinSet[oauthClientJoins[Q]](OauthClients.Columns, buildOauthClientJoins),
+		OauthAuthorizationCodes: buildJoinSet[oauthAuthorizationCodeJoins[Q]](OauthAuthorizationCodes.Columns, buildOauthAuthorizationCodeJoins),
+		OauthGrants:             buildJoinSet[oauthGrantJoins[Q]](OauthGrants.Columns, buildOauthGrantJoins),
+		ExternalIdentities:      buildJoinSet[externalIdentityJoins[Q]](ExternalIdentities.Columns, buildExternalIdentityJoins),
+		PasswordHistories:       buildJoinSet[passwordHistoryJoins[Q]](PasswordHistories.Columns, buildPasswordHistoryJoins),
+		Passkeys:                buildJoinSet[passkeyJoins[Q]](Passkeys.Columns, buildPasskeyJoins),
+		WebauthnSessions:        buildJoinSet[webauthnSessionJoins[Q]](WebauthnSessions.Columns, buildWebauthnSessionJoins),
+	}
 }
 
 type modAs[Q any, C interface{ AliasedAs(string) C }] struct {
@@ -16,10 +16,42 @@ import (
 
 var Preload = getPreloaders()
 
-type preloaders struct{}
+type preloaders struct {
+	User                   userPreloader
+	RefreshToken           refreshTokenPreloader
+	Session                sessionPreloader
+	UserMfa                userMfaPreloader
+	MfaRecoveryCode        mfaRecoveryCodePreloader
+	UserToken              userTokenPreloader
+	APIKey                 apiKeyPreloader
+	APIKeyScope            apiKeyScopePreloader
+	OauthClient            oauthClientPreloader
+	OauthAuthorizationCode oauthAuthorizationCodePreloader
+	OauthGrant             oauthGrantPreloader
+	ExternalIdentity       externalIdentityPreloader
+	PasswordHistory        passwordHistoryPreloader
+	Passkey                passkeyPreloader
+	WebauthnSession        webauthnSessionPreloader
+}
 
 func getPreloaders() preloaders {
-	return preloaders{}
+	return preloaders{
+		User:                   buildUserPreloader(),
+		RefreshToken:           buildRefreshTokenPreloader(),
+		Session:                buildSessionPreloader(),
+		UserMfa:                buildUserMfaPreloader(),
+		MfaRecoveryCode:        buildMfaRecoveryCodePreloader(),
+		UserToken:              buildUserTokenPreloader(),
+		APIKey:                 buildAPIKeyPreloader(),
+		APIKeyScope:            buildAPIKeyScopePreloader(),
+		OauthClient:            buildOauthClientPreloader(),
+		OauthAuthorizationCode: buildOauthAuthorizationCodePreloader(),
+		OauthGrant:             buildOauthGrantPreloader(),
+		ExternalIdentity:       buildExternalIdentityPreloader(),
+		PasswordHistory:        buildPasswordHistoryPreloader(),
+		Passkey:                buildPasskeyPreloader(),
+		WebauthnSession:        buildWebauthnSessionPreloader(),
+	}
 }
 
 var (
@@ -28,10 +60,42 @@ var (
 	UpdateThenLoad = getThenLoaders[*dialect.UpdateQuery]()
 )
 
-type thenLoaders[Q orm.Loadable] struct{}
+type thenLoaders[Q orm.Loadable] struct {
+	User                   userThenLoader[Q]
+	RefreshToken           refreshTokenThenLoader[Q]
+	Session                sessionThenLoader[Q]
+	UserMfa                userMfaThenLoader[Q]
+	MfaRecoveryCode        mfaRecoveryCodeThenLoader[Q]
+	UserToken              userTokenThenLoader[Q]
+	APIKey                 apiKeyThenLoader[Q]
+	APIKeyScope            apiKeyScopeThenLoader[Q]
+	OauthClient            oauthClientThenLoader[Q]
+	OauthAuthorizationCode oauthAuthorizationCodeThenLoader[Q]
+	OauthGrant             oauthGrantThenLoader[Q]
+	ExternalIdentity       externalIdentityThenLoader[Q]
+	PasswordHistory        passwordHistoryThenLoader[Q]
+	Passkey                passkeyThenLoader[Q]
+	WebauthnSession        webauthnSessionThenLoader[Q]
+}
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
-	return thenLoaders[Q]{}
+	return thenLoaders[Q]{
+		User:                   buildUserThenLoader[Q](),
+		RefreshToken:           buildRefreshTokenThenLoader[Q](),
+		Session:                buildSessionThenLoader[Q](),
+		UserMfa:                buildUserMfaThenLoader[Q](),
+		MfaRecoveryCode:        buildMfaRecoveryCodeThenLoader[Q](),
+		UserToken:              buildUserTokenThenLoader[Q](),
+		APIKey:                 buildAPIKeyThenLoader[Q](),
+		APIKeyScope:            buildAPIKeyScopeThenLoader[Q](),
+		OauthClient:            buildOauthClientThenLoader[Q](),
+		OauthAuthorizationCode: buildOauthAuthorizationCodeThenLoader[Q](),
+		OauthGrant:             buildOauthGrantThenLoader[Q](),
+		ExternalIdentity:       buildExternalIdentityThenLoader[Q](),
+		PasswordHistory:        buildPasswordHistoryThenLoader[Q](),
+		Passkey:                buildPasskeyThenLoader[Q](),
+		WebauthnSession:        buildWebauthnSessionThenLoader[Q](),
+	}
 }
 
 func thenLoadBuilder[Q orm.Loadable, T any](name string, f func(context.Context, bob.Executor, T, ...bob.Mod[*dialect.SelectQuery]) error) func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q] {
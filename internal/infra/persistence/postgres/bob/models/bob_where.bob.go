@@ -17,11 +17,62 @@ var (
 )
 
 func Where[Q psql.Filterable]() struct {
-	Users userWhere[Q]
+	Users                   userWhere[Q]
+	RefreshTokens           refreshTokenWhere[Q]
+	Sessions                sessionWhere[Q]
+	UserMfas                userMfaWhere[Q]
+	MfaRecoveryCodes        mfaRecoveryCodeWhere[Q]
+	UserTokens              userTokenWhere[Q]
+	LoginThrottles          loginThrottleWhere[Q]
+	APIKeys                 apiKeyWhere[Q]
+	APIKeyScopes            apiKeyScopeWhere[Q]
+	OauthClients            oauthClientWhere[Q]
+	OauthAuthorizationCodes oauthAuthorizationCodeWhere[Q]
+	OauthGrants             oauthGrantWhere[Q]
+	ExternalIdentities      externalIdentityWhere[Q]
+	PasswordHistories       passwordHistoryWhere[Q]
+	Passkeys                passkeyWhere[Q]
+	WebauthnSessions        webauthnSessionWhere[Q]
+	Tenants                 tenantWhere[Q]
+	AuthzManagedPolicies    authzManagedPolicyWhere[Q]
 } {
 	return struct {
-		Users userWhere[Q]
+		Users                   userWhere[Q]
+		RefreshTokens           refreshTokenWhere[Q]
+		Sessions                sessionWhere[Q]
+		UserMfas                userMfaWhere[Q]
+		MfaRecoveryCodes        mfaRecoveryCodeWhere[Q]
+		UserTokens              userTokenWhere[Q]
+		LoginThrottles          loginThrottleWhere[Q]
+		APIKeys                 apiKeyWhere[Q]
+		APIKeyScopes            apiKeyScopeWhere[Q]
+		OauthClients            oauthClientWhere[Q]
+		OauthAuthorizationCodes oauthAuthorizationCodeWhere[Q]
+		OauthGrants             oauthGrantWhere[Q]
+		ExternalIdentities      externalIdentityWhere[Q]
+		PasswordHistories       passwordHistoryWhere[Q]
+		Passkeys                passkeyWhere[Q]
+		WebauthnSessions        webauthnSessionWhere[Q]
+		Tenants                 tenantWhere[Q]
+		AuthzManagedPolicies    authzManagedPolicyWhere[Q]
 	}{
-		Users: buildUserWhere[Q](Users.Columns),
+		Users:                   buildUserWhere[Q](Users.Columns),
+		RefreshTokens:           buildRefreshTokenWhere[Q](RefreshTokens.Columns),
+		Sessions:                buildSessionWhere[Q](Sessions.Columns),
+		UserMfas:                buildUserMfaWhere[Q](UserMfas.Columns),
+		MfaRecoveryCodes:        buildMfaRecoveryCodeWhere[Q](MfaRecoveryCodes.Columns),
+		UserTokens:              buildUserTokenWhere[Q](UserTokens.Columns),
+		LoginThrottles:          buildLoginThrottleWhere[Q](LoginThrottles.Columns),
+		APIKeys:                 buildAPIKeyWhere[Q](APIKeys.Columns),
+		APIKeyScopes:            buildAPIKeyScopeWhere[Q](APIKeyScopes.Columns),
+		OauthClients:            buildOauthClientWhere[Q](OauthClients.Columns),
+		OauthAuthorizationCodes: buildOauthAuthorizationCodeWhere[Q](OauthAuthorizationCodes.Columns),
+		OauthGrants:             buildOauthGrantWhere[Q](OauthGrants.Columns),
+		ExternalIdentities:      buildExternalIdentityWhere[Q](ExternalIdentities.Columns),
+		PasswordHistories:       buildPasswordHistoryWhere[Q](PasswordHistories.Columns),
+		Passkeys:                buildPasskeyWhere[Q](Passkeys.Columns),
+		WebauthnSessions:        buildWebauthnSessionWhere[Q](WebauthnSessions.Columns),
+		Tenants:                 buildTenantWhere[Q](Tenants.Columns),
+		AuthzManagedPolicies:    buildAuthzManagedPolicyWhere[Q](AuthzManagedPolicies.Columns),
 	}
 }
@@ -0,0 +1,731 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// ExternalIdentity is an object representing the database table.
+type ExternalIdentity struct {
+	ID          uuid.UUID           `db:"id,pk" `
+	UserID      uuid.UUID           `db:"user_id" `
+	Provider    string              `db:"provider" `
+	Subject     string              `db:"subject" `
+	Email       string              `db:"email" `
+	LastLoginAt null.Val[time.Time] `db:"last_login_at" `
+	CreatedAt   time.Time           `db:"created_at" `
+
+	R externalIdentityR `db:"-" `
+}
+
+// ExternalIdentitySlice is an alias for a slice of pointers to ExternalIdentity.
+// This should almost always be used instead of []*ExternalIdentity.
+type ExternalIdentitySlice []*ExternalIdentity
+
+// ExternalIdentities contains methods to work with the external_identities table
+var ExternalIdentities = psql.NewTablex[*ExternalIdentity, ExternalIdentitySlice, *ExternalIdentitySetter]("", "external_identities", buildExternalIdentityColumns("external_identities"))
+
+// ExternalIdentitiesQuery is a query on the external_identities table
+type ExternalIdentitiesQuery = *psql.ViewQuery[*ExternalIdentity, ExternalIdentitySlice]
+
+// externalIdentityR is where relationships are stored.
+type externalIdentityR struct {
+	User *User // external_identities_user_id_fkey
+}
+
+func buildExternalIdentityColumns(alias string) externalIdentityColumns {
+	return externalIdentityColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "provider", "subject", "email", "last_login_at", "created_at",
+		).WithParent("external_identities"),
+		tableAlias:  alias,
+		ID:          psql.Quote(alias, "id"),
+		UserID:      psql.Quote(alias, "user_id"),
+		Provider:    psql.Quote(alias, "provider"),
+		Subject:     psql.Quote(alias, "subject"),
+		Email:       psql.Quote(alias, "email"),
+		LastLoginAt: psql.Quote(alias, "last_login_at"),
+		CreatedAt:   psql.Quote(alias, "created_at"),
+	}
+}
+
+type externalIdentityColumns struct {
+	expr.ColumnsExpr
+	tableAlias  string
+	ID          psql.Expression
+	UserID      psql.Expression
+	Provider    psql.Expression
+	Subject     psql.Expression
+	Email       psql.Expression
+	LastLoginAt psql.Expression
+	CreatedAt   psql.Expression
+}
+
+func (c externalIdentityColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (externalIdentityColumns) AliasedAs(alias string) externalIdentityColumns {
+	return buildExternalIdentityColumns(alias)
+}
+
+// ExternalIdentitySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ExternalIdentitySetter struct {
+	ID          omit.Val[uuid.UUID]     `db:"id,pk" `
+	UserID      omit.Val[uuid.UUID]     `db:"user_id" `
+	Provider    omit.Val[string]        `db:"provider" `
+	Subject     omit.Val[string]        `db:"subject" `
+	Email       omit.Val[string]        `db:"email" `
+	LastLoginAt omitnull.Val[time.Time] `db:"last_login_at" `
+	CreatedAt   omit.Val[time.Time]     `db:"created_at" `
+}
+
+func (s ExternalIdentitySetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Provider.IsValue() {
+		vals = append(vals, "provider")
+	}
+	if s.Subject.IsValue() {
+		vals = append(vals, "subject")
+	}
+	if s.Email.IsValue() {
+		vals = append(vals, "email")
+	}
+	if !s.LastLoginAt.IsUnset() {
+		vals = append(vals, "last_login_at")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s ExternalIdentitySetter) Overwrite(t *ExternalIdentity) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Provider.IsValue() {
+		t.Provider = s.Provider.MustGet()
+	}
+	if s.Subject.IsValue() {
+		t.Subject = s.Subject.MustGet()
+	}
+	if s.Email.IsValue() {
+		t.Email = s.Email.MustGet()
+	}
+	if !s.LastLoginAt.IsUnset() {
+		t.LastLoginAt = s.LastLoginAt.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *ExternalIdentitySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return ExternalIdentities.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 7)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Provider.IsValue() {
+			vals[2] = psql.Arg(s.Provider.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Subject.IsValue() {
+			vals[3] = psql.Arg(s.Subject.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Email.IsValue() {
+			vals[4] = psql.Arg(s.Email.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if !s.LastLoginAt.IsUnset() {
+			vals[5] = psql.Arg(s.LastLoginAt.MustGetNull())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[6] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ExternalIdentitySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ExternalIdentitySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Provider.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "provider")...),
+			psql.Arg(s.Provider),
+		}})
+	}
+
+	if s.Subject.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "subject")...),
+			psql.Arg(s.Subject),
+		}})
+	}
+
+	if s.Email.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "email")...),
+			psql.Arg(s.Email),
+		}})
+	}
+
+	if !s.LastLoginAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_login_at")...),
+			psql.Arg(s.LastLoginAt),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindExternalIdentity retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindExternalIdentity(ctx context.Context, exec bob.Executor, IDPK uuid.UUID, cols ...string) (*ExternalIdentity, error) {
+	if len(cols) == 0 {
+		return ExternalIdentities.Query(
+			sm.Where(ExternalIdentities.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return ExternalIdentities.Query(
+		sm.Where(ExternalIdentities.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(ExternalIdentities.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ExternalIdentityExists checks the presence of a single record by primary key
+func ExternalIdentityExists(ctx context.Context, exec bob.Executor, IDPK uuid.UUID) (bool, error) {
+	return ExternalIdentities.Query(
+		sm.Where(ExternalIdentities.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after ExternalIdentity is retrieved from the database
+func (o *ExternalIdentity) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ExternalIdentities.AfterSelectHooks.RunHooks(ctx, exec, ExternalIdentitySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = ExternalIdentities.AfterInsertHooks.RunHooks(ctx, exec, ExternalIdentitySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = ExternalIdentities.AfterUpdateHooks.RunHooks(ctx, exec, ExternalIdentitySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = ExternalIdentities.AfterDeleteHooks.RunHooks(ctx, exec, ExternalIdentitySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the ExternalIdentity
+func (o *ExternalIdentity) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *ExternalIdentity) pkEQ() dialect.Expression {
+	return psql.Quote("external_identities", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the ExternalIdentity
+func (o *ExternalIdentity) Update(ctx context.Context, exec bob.Executor, s *ExternalIdentitySetter) error {
+	v, err := ExternalIdentities.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single ExternalIdentity record with an executor
+func (o *ExternalIdentity) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := ExternalIdentities.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the ExternalIdentity using the executor
+func (o *ExternalIdentity) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := ExternalIdentities.Query(
+		sm.Where(ExternalIdentities.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ExternalIdentitySlice is retrieved from the database
+func (o ExternalIdentitySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ExternalIdentities.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = ExternalIdentities.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = ExternalIdentities.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = ExternalIdentities.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ExternalIdentitySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("external_identities", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ExternalIdentitySlice) copyMatchingRows(from ...*ExternalIdentity) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ExternalIdentitySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ExternalIdentities.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ExternalIdentity:
+				o.copyMatchingRows(retrieved)
+			case []*ExternalIdentity:
+				o.copyMatchingRows(retrieved...)
+			case ExternalIdentitySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ExternalIdentity or a slice of ExternalIdentity
+				// then run the AfterUpdateHooks on the slice
+				_, err = ExternalIdentities.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ExternalIdentitySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ExternalIdentities.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ExternalIdentity:
+				o.copyMatchingRows(retrieved)
+			case []*ExternalIdentity:
+				o.copyMatchingRows(retrieved...)
+			case ExternalIdentitySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ExternalIdentity or a slice of ExternalIdentity
+				// then run the AfterDeleteHooks on the slice
+				_, err = ExternalIdentities.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ExternalIdentitySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ExternalIdentitySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ExternalIdentities.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ExternalIdentitySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ExternalIdentities.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ExternalIdentitySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := ExternalIdentities.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *ExternalIdentity) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os ExternalIdentitySlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "uuid[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachExternalIdentityUser0(ctx context.Context, exec bob.Executor, count int, externalIdentity0 *ExternalIdentity, user1 *User) (*ExternalIdentity, error) {
+	setter := &ExternalIdentitySetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := externalIdentity0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachExternalIdentityUser0: %w", err)
+	}
+
+	return externalIdentity0, nil
+}
+
+func (externalIdentity0 *ExternalIdentity) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachExternalIdentityUser0(ctx, exec, 1, externalIdentity0, user1)
+	if err != nil {
+		return err
+	}
+
+	externalIdentity0.R.User = user1
+
+	user1.R.ExternalIdentities = append(user1.R.ExternalIdentities, externalIdentity0)
+
+	return nil
+}
+
+func (externalIdentity0 *ExternalIdentity) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachExternalIdentityUser0(ctx, exec, 1, externalIdentity0, user1)
+	if err != nil {
+		return err
+	}
+
+	externalIdentity0.R.User = user1
+
+	user1.R.ExternalIdentities = append(user1.R.ExternalIdentities, externalIdentity0)
+
+	return nil
+}
+
+type externalIdentityWhere[Q psql.Filterable] struct {
+	ID          psql.WhereMod[Q, uuid.UUID]
+	UserID      psql.WhereMod[Q, uuid.UUID]
+	Provider    psql.WhereMod[Q, string]
+	Subject     psql.WhereMod[Q, string]
+	Email       psql.WhereMod[Q, string]
+	LastLoginAt psql.WhereNullMod[Q, time.Time]
+	CreatedAt   psql.WhereMod[Q, time.Time]
+}
+
+func (externalIdentityWhere[Q]) AliasedAs(alias string) externalIdentityWhere[Q] {
+	return buildExternalIdentityWhere[Q](buildExternalIdentityColumns(alias))
+}
+
+func buildExternalIdentityWhere[Q psql.Filterable](cols externalIdentityColumns) externalIdentityWhere[Q] {
+	return externalIdentityWhere[Q]{
+		ID:          psql.Where[Q, uuid.UUID](cols.ID),
+		UserID:      psql.Where[Q, uuid.UUID](cols.UserID),
+		Provider:    psql.Where[Q, string](cols.Provider),
+		Subject:     psql.Where[Q, string](cols.Subject),
+		Email:       psql.Where[Q, string](cols.Email),
+		LastLoginAt: psql.WhereNull[Q, time.Time](cols.LastLoginAt),
+		CreatedAt:   psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *ExternalIdentity) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("externalIdentity cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.ExternalIdentities = ExternalIdentitySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("externalIdentity has no relationship %q", name)
+	}
+}
+
+type externalIdentityPreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildExternalIdentityPreloader() externalIdentityPreloader {
+	return externalIdentityPreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        ExternalIdentities,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type externalIdentityThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildExternalIdentityThenLoader[Q orm.Loadable]() externalIdentityThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return externalIdentityThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the externalIdentity's User into the .R struct
+func (o *ExternalIdentity) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ExternalIdentities = ExternalIdentitySlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the externalIdentity's User into the .R struct
+func (os ExternalIdentitySlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.ExternalIdentities = append(rel.R.ExternalIdentities, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type externalIdentityJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j externalIdentityJoins[Q]) aliasedAs(alias string) externalIdentityJoins[Q] {
+	return buildExternalIdentityJoins[Q](buildExternalIdentityColumns(alias), j.typ)
+}
+
+func buildExternalIdentityJoins[Q dialect.Joinable](cols externalIdentityColumns, typ string) externalIdentityJoins[Q] {
+	return externalIdentityJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,426 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// LoginThrottle is an object representing the database table.
+type LoginThrottle struct {
+	Key           string              `db:"key,pk" `
+	Failures      int32               `db:"failures" `
+	LastFailureAt time.Time           `db:"last_failure_at" `
+	LockedUntil   null.Val[time.Time] `db:"locked_until" `
+}
+
+// LoginThrottleSlice is an alias for a slice of pointers to LoginThrottle.
+// This should almost always be used instead of []*LoginThrottle.
+type LoginThrottleSlice []*LoginThrottle
+
+// LoginThrottles contains methods to work with the login_throttles table
+var LoginThrottles = psql.NewTablex[*LoginThrottle, LoginThrottleSlice, *LoginThrottleSetter]("", "login_throttles", buildLoginThrottleColumns("login_throttles"))
+
+// LoginThrottlesQuery is a query on the login_throttles table
+type LoginThrottlesQuery = *psql.ViewQuery[*LoginThrottle, LoginThrottleSlice]
+
+func buildLoginThrottleColumns(alias string) loginThrottleColumns {
+	return loginThrottleColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"key", "failures", "last_failure_at", "locked_until",
+		).WithParent("login_throttles"),
+		tableAlias:    alias,
+		Key:           psql.Quote(alias, "key"),
+		Failures:      psql.Quote(alias, "failures"),
+		LastFailureAt: psql.Quote(alias, "last_failure_at"),
+		LockedUntil:   psql.Quote(alias, "locked_until"),
+	}
+}
+
+type loginThrottleColumns struct {
+	expr.ColumnsExpr
+	tableAlias    string
+	Key           psql.Expression
+	Failures      psql.Expression
+	LastFailureAt psql.Expression
+	LockedUntil   psql.Expression
+}
+
+func (c loginThrottleColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (loginThrottleColumns) AliasedAs(alias string) loginThrottleColumns {
+	return buildLoginThrottleColumns(alias)
+}
+
+// LoginThrottleSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type LoginThrottleSetter struct {
+	Key           omit.Val[string]        `db:"key,pk" `
+	Failures      omit.Val[int32]         `db:"failures" `
+	LastFailureAt omit.Val[time.Time]     `db:"last_failure_at" `
+	LockedUntil   omitnull.Val[time.Time] `db:"locked_until" `
+}
+
+func (s LoginThrottleSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.Key.IsValue() {
+		vals = append(vals, "key")
+	}
+	if s.Failures.IsValue() {
+		vals = append(vals, "failures")
+	}
+	if s.LastFailureAt.IsValue() {
+		vals = append(vals, "last_failure_at")
+	}
+	if !s.LockedUntil.IsUnset() {
+		vals = append(vals, "locked_until")
+	}
+	return vals
+}
+
+func (s LoginThrottleSetter) Overwrite(t *LoginThrottle) {
+	if s.Key.IsValue() {
+		t.Key = s.Key.MustGet()
+	}
+	if s.Failures.IsValue() {
+		t.Failures = s.Failures.MustGet()
+	}
+	if s.LastFailureAt.IsValue() {
+		t.LastFailureAt = s.LastFailureAt.MustGet()
+	}
+	if !s.LockedUntil.IsUnset() {
+		t.LockedUntil = s.LockedUntil.MustGetNull()
+	}
+}
+
+func (s *LoginThrottleSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return LoginThrottles.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.Key.IsValue() {
+			vals[0] = psql.Arg(s.Key.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Failures.IsValue() {
+			vals[1] = psql.Arg(s.Failures.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.LastFailureAt.IsValue() {
+			vals[2] = psql.Arg(s.LastFailureAt.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.LockedUntil.IsUnset() {
+			vals[3] = psql.Arg(s.LockedUntil.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s LoginThrottleSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s LoginThrottleSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.Key.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "key")...),
+			psql.Arg(s.Key),
+		}})
+	}
+
+	if s.Failures.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "failures")...),
+			psql.Arg(s.Failures),
+		}})
+	}
+
+	if s.LastFailureAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_failure_at")...),
+			psql.Arg(s.LastFailureAt),
+		}})
+	}
+
+	if !s.LockedUntil.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "locked_until")...),
+			psql.Arg(s.LockedUntil),
+		}})
+	}
+
+	return exprs
+}
+
+// FindLoginThrottle retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindLoginThrottle(ctx context.Context, exec bob.Executor, KeyPK string, cols ...string) (*LoginThrottle, error) {
+	if len(cols) == 0 {
+		return LoginThrottles.Query(
+			sm.Where(LoginThrottles.Columns.Key.EQ(psql.Arg(KeyPK))),
+		).One(ctx, exec)
+	}
+
+	return LoginThrottles.Query(
+		sm.Where(LoginThrottles.Columns.Key.EQ(psql.Arg(KeyPK))),
+		sm.Columns(LoginThrottles.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// LoginThrottleExists checks the presence of a single record by primary key
+func LoginThrottleExists(ctx context.Context, exec bob.Executor, KeyPK string) (bool, error) {
+	return LoginThrottles.Query(
+		sm.Where(LoginThrottles.Columns.Key.EQ(psql.Arg(KeyPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after LoginThrottle is retrieved from the database
+func (o *LoginThrottle) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = LoginThrottles.AfterSelectHooks.RunHooks(ctx, exec, LoginThrottleSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = LoginThrottles.AfterInsertHooks.RunHooks(ctx, exec, LoginThrottleSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = LoginThrottles.AfterUpdateHooks.RunHooks(ctx, exec, LoginThrottleSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = LoginThrottles.AfterDeleteHooks.RunHooks(ctx, exec, LoginThrottleSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the LoginThrottle
+func (o *LoginThrottle) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.Key)
+}
+
+func (o *LoginThrottle) pkEQ() dialect.Expression {
+	return psql.Quote("login_throttles", "key").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the LoginThrottle
+func (o *LoginThrottle) Update(ctx context.Context, exec bob.Executor, s *LoginThrottleSetter) error {
+	v, err := LoginThrottles.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single LoginThrottle record with an executor
+func (o *LoginThrottle) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := LoginThrottles.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the LoginThrottle using the executor
+func (o *LoginThrottle) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := LoginThrottles.Query(
+		sm.Where(LoginThrottles.Columns.Key.EQ(psql.Arg(o.Key))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after LoginThrottleSlice is retrieved from the database
+func (o LoginThrottleSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = LoginThrottles.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = LoginThrottles.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = LoginThrottles.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = LoginThrottles.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o LoginThrottleSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("login_throttles", "key").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o LoginThrottleSlice) copyMatchingRows(from ...*LoginThrottle) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.Key != old.Key {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o LoginThrottleSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return LoginThrottles.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *LoginThrottle:
+				o.copyMatchingRows(retrieved)
+			case []*LoginThrottle:
+				o.copyMatchingRows(retrieved...)
+			case LoginThrottleSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a LoginThrottle or a slice of LoginThrottle
+				// then run the AfterUpdateHooks on the slice
+				_, err = LoginThrottles.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o LoginThrottleSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return LoginThrottles.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *LoginThrottle:
+				o.copyMatchingRows(retrieved)
+			case []*LoginThrottle:
+				o.copyMatchingRows(retrieved...)
+			case LoginThrottleSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a LoginThrottle or a slice of LoginThrottle
+				// then run the AfterDeleteHooks on the slice
+				_, err = LoginThrottles.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o LoginThrottleSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals LoginThrottleSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := LoginThrottles.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o LoginThrottleSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := LoginThrottles.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o LoginThrottleSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := LoginThrottles.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type loginThrottleWhere[Q psql.Filterable] struct {
+	Key           psql.WhereMod[Q, string]
+	Failures      psql.WhereMod[Q, int32]
+	LastFailureAt psql.WhereMod[Q, time.Time]
+	LockedUntil   psql.WhereNullMod[Q, time.Time]
+}
+
+func (loginThrottleWhere[Q]) AliasedAs(alias string) loginThrottleWhere[Q] {
+	return buildLoginThrottleWhere[Q](buildLoginThrottleColumns(alias))
+}
+
+func buildLoginThrottleWhere[Q psql.Filterable](cols loginThrottleColumns) loginThrottleWhere[Q] {
+	return loginThrottleWhere[Q]{
+		Key:           psql.Where[Q, string](cols.Key),
+		Failures:      psql.Where[Q, int32](cols.Failures),
+		LastFailureAt: psql.Where[Q, time.Time](cols.LastFailureAt),
+		LockedUntil:   psql.WhereNull[Q, time.Time](cols.LockedUntil),
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// MfaRecoveryCode is an object representing the database table.
+type MfaRecoveryCode struct {
+	ID        uuid.UUID           `db:"id,pk" `
+	UserID    uuid.UUID           `db:"user_id" `
+	CodeHash  string              `db:"code_hash" `
+	UsedAt    null.Val[time.Time] `db:"used_at" `
+	CreatedAt time.Time           `db:"created_at" `
+
+	R mfaRecoveryCodeR `db:"-" `
+}
+
+// MfaRecoveryCodeSlice is an alias for a slice of pointers to MfaRecoveryCode.
+// This should almost always be used instead of []*MfaRecoveryCode.
+type MfaRecoveryCodeSlice []*MfaRecoveryCode
+
+// MfaRecoveryCodes contains methods to work with the mfa_recovery_codes table
+var MfaRecoveryCodes = psql.NewTablex[*MfaRecoveryCode, MfaRecoveryCodeSlice, *MfaRecoveryCodeSetter]("", "mfa_recovery_codes", buildMfaRecoveryCodeColumns("mfa_recovery_codes"))
+
+// MfaRecoveryCodesQuery is a query on the mfa_recovery_codes table
+type MfaRecoveryCodesQuery = *psql.ViewQuery[*MfaRecoveryCode, MfaRecoveryCodeSlice]
+
+// mfaRecoveryCodeR is where relationships are stored.
+type mfaRecoveryCodeR struct {
+	User *User // mfa_recovery_codes_user_id_fkey
+}
+
+func buildMfaRecoveryCodeColumns(alias string) mfaRecoveryCodeColumns {
+	return mfaRecoveryCodeColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "code_hash", "used_at", "created_at",
+		).WithParent("mfa_recovery_codes"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		UserID:     psql.Quote(alias, "user_id"),
+		CodeHash:   psql.Quote(alias, "code_hash"),
+		UsedAt:     psql.Quote(alias, "used_at"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type mfaRecoveryCodeColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	UserID     psql.Expression
+	CodeHash   psql.Expression
+	UsedAt     psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c mfaRecoveryCodeColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (mfaRecoveryCodeColumns) AliasedAs(alias string) mfaRecoveryCodeColumns {
+	return buildMfaRecoveryCodeColumns(alias)
+}
+
+// MfaRecoveryCodeSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type MfaRecoveryCodeSetter struct {
+	ID        omit.Val[uuid.UUID]     `db:"id,pk" `
+	UserID    omit.Val[uuid.UUID]     `db:"user_id" `
+	CodeHash  omit.Val[string]        `db:"code_hash" `
+	UsedAt    omitnull.Val[time.Time] `db:"used_at" `
+	CreatedAt omit.Val[time.Time]     `db:"created_at" `
+}
+
+func (s MfaRecoveryCodeSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.CodeHash.IsValue() {
+		vals = append(vals, "code_hash")
+	}
+	if !s.UsedAt.IsUnset() {
+		vals = append(vals, "used_at")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s MfaRecoveryCodeSetter) Overwrite(t *MfaRecoveryCode) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.CodeHash.IsValue() {
+		t.CodeHash = s.CodeHash.MustGet()
+	}
+	if !s.UsedAt.IsUnset() {
+		t.UsedAt = s.UsedAt.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *MfaRecoveryCodeSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return MfaRecoveryCodes.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 5)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.CodeHash.IsValue() {
+			vals[2] = psql.Arg(s.CodeHash.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UsedAt.IsUnset() {
+			vals[3] = psql.Arg(s.UsedAt.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[4] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s MfaRecoveryCodeSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s MfaRecoveryCodeSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.CodeHash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "code_hash")...),
+			psql.Arg(s.CodeHash),
+		}})
+	}
+
+	if !s.UsedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "used_at")...),
+			psql.Arg(s.UsedAt),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindMfaRecoveryCode retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindMfaRecoveryCode(ctx context.Context, exec bob.Executor, IDPK uuid.UUID, cols ...string) (*MfaRecoveryCode, error) {
+	if len(cols) == 0 {
+		return MfaRecoveryCodes.Query(
+			sm.Where(MfaRecoveryCodes.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return MfaRecoveryCodes.Query(
+		sm.Where(MfaRecoveryCodes.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(MfaRecoveryCodes.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// MfaRecoveryCodeExists checks the presence of a single record by primary key
+func MfaRecoveryCodeExists(ctx context.Context, exec bob.Executor, IDPK uuid.UUID) (bool, error) {
+	return MfaRecoveryCodes.Query(
+		sm.Where(MfaRecoveryCodes.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after MfaRecoveryCode is retrieved from the database
+func (o *MfaRecoveryCode) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = MfaRecoveryCodes.AfterSelectHooks.RunHooks(ctx, exec, MfaRecoveryCodeSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = MfaRecoveryCodes.AfterInsertHooks.RunHooks(ctx, exec, MfaRecoveryCodeSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = MfaRecoveryCodes.AfterUpdateHooks.RunHooks(ctx, exec, MfaRecoveryCodeSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = MfaRecoveryCodes.AfterDeleteHooks.RunHooks(ctx, exec, MfaRecoveryCodeSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the MfaRecoveryCode
+func (o *MfaRecoveryCode) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *MfaRecoveryCode) pkEQ() dialect.Expression {
+	return psql.Quote("mfa_recovery_codes", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the MfaRecoveryCode
+func (o *MfaRecoveryCode) Update(ctx context.Context, exec bob.Executor, s *MfaRecoveryCodeSetter) error {
+	v, err := MfaRecoveryCodes.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single MfaRecoveryCode record with an executor
+func (o *MfaRecoveryCode) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := MfaRecoveryCodes.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the MfaRecoveryCode using the executor
+func (o *MfaRecoveryCode) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := MfaRecoveryCodes.Query(
+		sm.Where(MfaRecoveryCodes.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after MfaRecoveryCodeSlice is retrieved from the database
+func (o MfaRecoveryCodeSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = MfaRecoveryCodes.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = MfaRecoveryCodes.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = MfaRecoveryCodes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = MfaRecoveryCodes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o MfaRecoveryCodeSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("mfa_recovery_codes", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o MfaRecoveryCodeSlice) copyMatchingRows(from ...*MfaRecoveryCode) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o MfaRecoveryCodeSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return MfaRecoveryCodes.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *MfaRecoveryCode:
+				o.copyMatchingRows(retrieved)
+			case []*MfaRecoveryCode:
+				o.copyMatchingRows(retrieved...)
+			case MfaRecoveryCodeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a MfaRecoveryCode or a slice of MfaRecoveryCode
+				// then run the AfterUpdateHooks on the slice
+				_, err = MfaRecoveryCodes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o MfaRecoveryCodeSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return MfaRecoveryCodes.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *MfaRecoveryCode:
+				o.copyMatchingRows(retrieved)
+			case []*MfaRecoveryCode:
+				o.copyMatchingRows(retrieved...)
+			case MfaRecoveryCodeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a MfaRecoveryCode or a slice of MfaRecoveryCode
+				// then run the AfterDeleteHooks on the slice
+				_, err = MfaRecoveryCodes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o MfaRecoveryCodeSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals MfaRecoveryCodeSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := MfaRecoveryCodes.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o MfaRecoveryCodeSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := MfaRecoveryCodes.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o MfaRecoveryCodeSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := MfaRecoveryCodes.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *MfaRecoveryCode) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os MfaRecoveryCodeSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "uuid[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachMfaRecoveryCodeUser0(ctx context.Context, exec bob.Executor, count int, mfaRecoveryCode0 *MfaRecoveryCode, user1 *User) (*MfaRecoveryCode, error) {
+	setter := &MfaRecoveryCodeSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := mfaRecoveryCode0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMfaRecoveryCodeUser0: %w", err)
+	}
+
+	return mfaRecoveryCode0, nil
+}
+
+func (mfaRecoveryCode0 *MfaRecoveryCode) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachMfaRecoveryCodeUser0(ctx, exec, 1, mfaRecoveryCode0, user1)
+	if err != nil {
+		return err
+	}
+
+	mfaRecoveryCode0.R.User = user1
+
+	user1.R.MfaRecoveryCodes = append(user1.R.MfaRecoveryCodes, mfaRecoveryCode0)
+
+	return nil
+}
+
+func (mfaRecoveryCode0 *MfaRecoveryCode) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachMfaRecoveryCodeUser0(ctx, exec, 1, mfaRecoveryCode0, user1)
+	if err != nil {
+		return err
+	}
+
+	mfaRecoveryCode0.R.User = user1
+
+	user1.R.MfaRecoveryCodes = append(user1.R.MfaRecoveryCodes, mfaRecoveryCode0)
+
+	return nil
+}
+
+type mfaRecoveryCodeWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, uuid.UUID]
+	UserID    psql.WhereMod[Q, uuid.UUID]
+	CodeHash  psql.WhereMod[Q, string]
+	UsedAt    psql.WhereNullMod[Q, time.Time]
+	CreatedAt psql.WhereMod[Q, time.Time]
+}
+
+func (mfaRecoveryCodeWhere[Q]) AliasedAs(alias string) mfaRecoveryCodeWhere[Q] {
+	return buildMfaRecoveryCodeWhere[Q](buildMfaRecoveryCodeColumns(alias))
+}
+
+func buildMfaRecoveryCodeWhere[Q psql.Filterable](cols mfaRecoveryCodeColumns) mfaRecoveryCodeWhere[Q] {
+	return mfaRecoveryCodeWhere[Q]{
+		ID:        psql.Where[Q, uuid.UUID](cols.ID),
+		UserID:    psql.Where[Q, uuid.UUID](cols.UserID),
+		CodeHash:  psql.Where[Q, string](cols.CodeHash),
+		UsedAt:    psql.WhereNull[Q, time.Time](cols.UsedAt),
+		CreatedAt: psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *MfaRecoveryCode) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("mfaRecoveryCode cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.MfaRecoveryCodes = MfaRecoveryCodeSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("mfaRecoveryCode has no relationship %q", name)
+	}
+}
+
+type mfaRecoveryCodePreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildMfaRecoveryCodePreloader() mfaRecoveryCodePreloader {
+	return mfaRecoveryCodePreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        MfaRecoveryCodes,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type mfaRecoveryCodeThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildMfaRecoveryCodeThenLoader[Q orm.Loadable]() mfaRecoveryCodeThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return mfaRecoveryCodeThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the mfaRecoveryCode's User into the .R struct
+func (o *MfaRecoveryCode) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.MfaRecoveryCodes = MfaRecoveryCodeSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the mfaRecoveryCode's User into the .R struct
+func (os MfaRecoveryCodeSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.MfaRecoveryCodes = append(rel.R.MfaRecoveryCodes, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type mfaRecoveryCodeJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j mfaRecoveryCodeJoins[Q]) aliasedAs(alias string) mfaRecoveryCodeJoins[Q] {
+	return buildMfaRecoveryCodeJoins[Q](buildMfaRecoveryCodeColumns(alias), j.typ)
+}
+
+func buildMfaRecoveryCodeJoins[Q dialect.Joinable](cols mfaRecoveryCodeColumns, typ string) mfaRecoveryCodeJoins[Q] {
+	return mfaRecoveryCodeJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
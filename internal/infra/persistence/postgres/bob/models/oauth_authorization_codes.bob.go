@@ -0,0 +1,1211 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// OauthAuthorizationCode is an object representing the database table.
+type OauthAuthorizationCode struct {
+	CodeHash      string              `db:"code_hash,pk" `
+	ClientID      string              `db:"client_id" `
+	UserID        uuid.UUID           `db:"user_id" `
+	RedirectURI   string              `db:"redirect_uri" `
+	Scopes        string              `db:"scopes" `
+	CodeChallenge string              `db:"code_challenge" `
+	Nonce         string              `db:"nonce" `
+	AuthTime      time.Time           `db:"auth_time" `
+	SessionID     null.Val[uuid.UUID] `db:"session_id" `
+	ExpiresAt     time.Time           `db:"expires_at" `
+	UsedAt        null.Val[time.Time] `db:"used_at" `
+	CreatedAt     time.Time           `db:"created_at" `
+
+	R oauthAuthorizationCodeR `db:"-" `
+}
+
+// OauthAuthorizationCodeSlice is an alias for a slice of pointers to OauthAuthorizationCode.
+// This should almost always be used instead of []*OauthAuthorizationCode.
+type OauthAuthorizationCodeSlice []*OauthAuthorizationCode
+
+// OauthAuthorizationCodes contains methods to work with the oauth_authorization_codes table
+var OauthAuthorizationCodes = psql.NewTablex[*OauthAuthorizationCode, OauthAuthorizationCodeSlice, *OauthAuthorizationCodeSetter]("", "oauth_authorization_codes", buildOauthAuthorizationCodeColumns("oauth_authorization_codes"))
+
+// OauthAuthorizationCodesQuery is a query on the oauth_authorization_codes table
+type OauthAuthorizationCodesQuery = *psql.ViewQuery[*OauthAuthorizationCode, OauthAuthorizationCodeSlice]
+
+// oauthAuthorizationCodeR is where relationships are stored.
+type oauthAuthorizationCodeR struct {
+	ClientOauthClient *OauthClient // oauth_authorization_codes_client_id_fkey
+	Session           *Session     // oauth_authorization_codes_session_id_fkey
+	User              *User        // oauth_authorization_codes_user_id_fkey
+}
+
+func buildOauthAuthorizationCodeColumns(alias string) oauthAuthorizationCodeColumns {
+	return oauthAuthorizationCodeColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"code_hash", "client_id", "user_id", "redirect_uri", "scopes", "code_challenge", "nonce", "auth_time", "session_id", "expires_at", "used_at", "created_at",
+		).WithParent("oauth_authorization_codes"),
+		tableAlias:    alias,
+		CodeHash:      psql.Quote(alias, "code_hash"),
+		ClientID:      psql.Quote(alias, "client_id"),
+		UserID:        psql.Quote(alias, "user_id"),
+		RedirectURI:   psql.Quote(alias, "redirect_uri"),
+		Scopes:        psql.Quote(alias, "scopes"),
+		CodeChallenge: psql.Quote(alias, "code_challenge"),
+		Nonce:         psql.Quote(alias, "nonce"),
+		AuthTime:      psql.Quote(alias, "auth_time"),
+		SessionID:     psql.Quote(alias, "session_id"),
+		ExpiresAt:     psql.Quote(alias, "expires_at"),
+		UsedAt:        psql.Quote(alias, "used_at"),
+		CreatedAt:     psql.Quote(alias, "created_at"),
+	}
+}
+
+type oauthAuthorizationCodeColumns struct {
+	expr.ColumnsExpr
+	tableAlias    string
+	CodeHash      psql.Expression
+	ClientID      psql.Expression
+	UserID        psql.Expression
+	RedirectURI   psql.Expression
+	Scopes        psql.Expression
+	CodeChallenge psql.Expression
+	Nonce         psql.Expression
+	AuthTime      psql.Expression
+	SessionID     psql.Expression
+	ExpiresAt     psql.Expression
+	UsedAt        psql.Expression
+	CreatedAt     psql.Expression
+}
+
+func (c oauthAuthorizationCodeColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oauthAuthorizationCodeColumns) AliasedAs(alias string) oauthAuthorizationCodeColumns {
+	return buildOauthAuthorizationCodeColumns(alias)
+}
+
+// OauthAuthorizationCodeSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OauthAuthorizationCodeSetter struct {
+	CodeHash      omit.Val[string]        `db:"code_hash,pk" `
+	ClientID      omit.Val[string]        `db:"client_id" `
+	UserID        omit.Val[uuid.UUID]     `db:"user_id" `
+	RedirectURI   omit.Val[string]        `db:"redirect_uri" `
+	Scopes        omit.Val[string]        `db:"scopes" `
+	CodeChallenge omit.Val[string]        `db:"code_challenge" `
+	Nonce         omit.Val[string]        `db:"nonce" `
+	AuthTime      omit.Val[time.Time]     `db:"auth_time" `
+	SessionID     omitnull.Val[uuid.UUID] `db:"session_id" `
+	ExpiresAt     omit.Val[time.Time]     `db:"expires_at" `
+	UsedAt        omitnull.Val[time.Time] `db:"used_at" `
+	CreatedAt     omit.Val[time.Time]     `db:"created_at" `
+}
+
+func (s OauthAuthorizationCodeSetter) SetColumns() []string {
+	vals := make([]string, 0, 12)
+	if s.CodeHash.IsValue() {
+		vals = append(vals, "code_hash")
+	}
+	if s.ClientID.IsValue() {
+		vals = append(vals, "client_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.RedirectURI.IsValue() {
+		vals = append(vals, "redirect_uri")
+	}
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if s.CodeChallenge.IsValue() {
+		vals = append(vals, "code_challenge")
+	}
+	if s.Nonce.IsValue() {
+		vals = append(vals, "nonce")
+	}
+	if s.AuthTime.IsValue() {
+		vals = append(vals, "auth_time")
+	}
+	if !s.SessionID.IsUnset() {
+		vals = append(vals, "session_id")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if !s.UsedAt.IsUnset() {
+		vals = append(vals, "used_at")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s OauthAuthorizationCodeSetter) Overwrite(t *OauthAuthorizationCode) {
+	if s.CodeHash.IsValue() {
+		t.CodeHash = s.CodeHash.MustGet()
+	}
+	if s.ClientID.IsValue() {
+		t.ClientID = s.ClientID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.RedirectURI.IsValue() {
+		t.RedirectURI = s.RedirectURI.MustGet()
+	}
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if s.CodeChallenge.IsValue() {
+		t.CodeChallenge = s.CodeChallenge.MustGet()
+	}
+	if s.Nonce.IsValue() {
+		t.Nonce = s.Nonce.MustGet()
+	}
+	if s.AuthTime.IsValue() {
+		t.AuthTime = s.AuthTime.MustGet()
+	}
+	if !s.SessionID.IsUnset() {
+		t.SessionID = s.SessionID.MustGetNull()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if !s.UsedAt.IsUnset() {
+		t.UsedAt = s.UsedAt.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *OauthAuthorizationCodeSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OauthAuthorizationCodes.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 12)
+		if s.CodeHash.IsValue() {
+			vals[0] = psql.Arg(s.CodeHash.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.ClientID.IsValue() {
+			vals[1] = psql.Arg(s.ClientID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[2] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.RedirectURI.IsValue() {
+			vals[3] = psql.Arg(s.RedirectURI.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Scopes.IsValue() {
+			vals[4] = psql.Arg(s.Scopes.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.CodeChallenge.IsValue() {
+			vals[5] = psql.Arg(s.CodeChallenge.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.Nonce.IsValue() {
+			vals[6] = psql.Arg(s.Nonce.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.AuthTime.IsValue() {
+			vals[7] = psql.Arg(s.AuthTime.MustGet())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.SessionID.IsUnset() {
+			vals[8] = psql.Arg(s.SessionID.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals[9] = psql.Arg(s.ExpiresAt.MustGet())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UsedAt.IsUnset() {
+			vals[10] = psql.Arg(s.UsedAt.MustGetNull())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[11] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OauthAuthorizationCodeSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OauthAuthorizationCodeSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 12)
+
+	if s.CodeHash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "code_hash")...),
+			psql.Arg(s.CodeHash),
+		}})
+	}
+
+	if s.ClientID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "client_id")...),
+			psql.Arg(s.ClientID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.RedirectURI.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "redirect_uri")...),
+			psql.Arg(s.RedirectURI),
+		}})
+	}
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "scopes")...),
+			psql.Arg(s.Scopes),
+		}})
+	}
+
+	if s.CodeChallenge.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "code_challenge")...),
+			psql.Arg(s.CodeChallenge),
+		}})
+	}
+
+	if s.Nonce.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "nonce")...),
+			psql.Arg(s.Nonce),
+		}})
+	}
+
+	if s.AuthTime.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "auth_time")...),
+			psql.Arg(s.AuthTime),
+		}})
+	}
+
+	if !s.SessionID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "session_id")...),
+			psql.Arg(s.SessionID),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "expires_at")...),
+			psql.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if !s.UsedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "used_at")...),
+			psql.Arg(s.UsedAt),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOauthAuthorizationCode retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOauthAuthorizationCode(ctx context.Context, exec bob.Executor, CodeHashPK string, cols ...string) (*OauthAuthorizationCode, error) {
+	if len(cols) == 0 {
+		return OauthAuthorizationCodes.Query(
+			sm.Where(OauthAuthorizationCodes.Columns.CodeHash.EQ(psql.Arg(CodeHashPK))),
+		).One(ctx, exec)
+	}
+
+	return OauthAuthorizationCodes.Query(
+		sm.Where(OauthAuthorizationCodes.Columns.CodeHash.EQ(psql.Arg(CodeHashPK))),
+		sm.Columns(OauthAuthorizationCodes.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OauthAuthorizationCodeExists checks the presence of a single record by primary key
+func OauthAuthorizationCodeExists(ctx context.Context, exec bob.Executor, CodeHashPK string) (bool, error) {
+	return OauthAuthorizationCodes.Query(
+		sm.Where(OauthAuthorizationCodes.Columns.CodeHash.EQ(psql.Arg(CodeHashPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OauthAuthorizationCode is retrieved from the database
+func (o *OauthAuthorizationCode) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthAuthorizationCodes.AfterSelectHooks.RunHooks(ctx, exec, OauthAuthorizationCodeSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OauthAuthorizationCodes.AfterInsertHooks.RunHooks(ctx, exec, OauthAuthorizationCodeSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthAuthorizationCodes.AfterUpdateHooks.RunHooks(ctx, exec, OauthAuthorizationCodeSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OauthAuthorizationCodes.AfterDeleteHooks.RunHooks(ctx, exec, OauthAuthorizationCodeSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OauthAuthorizationCode
+func (o *OauthAuthorizationCode) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.CodeHash)
+}
+
+func (o *OauthAuthorizationCode) pkEQ() dialect.Expression {
+	return psql.Quote("oauth_authorization_codes", "code_hash").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OauthAuthorizationCode
+func (o *OauthAuthorizationCode) Update(ctx context.Context, exec bob.Executor, s *OauthAuthorizationCodeSetter) error {
+	v, err := OauthAuthorizationCodes.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OauthAuthorizationCode record with an executor
+func (o *OauthAuthorizationCode) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OauthAuthorizationCodes.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OauthAuthorizationCode using the executor
+func (o *OauthAuthorizationCode) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OauthAuthorizationCodes.Query(
+		sm.Where(OauthAuthorizationCodes.Columns.CodeHash.EQ(psql.Arg(o.CodeHash))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OauthAuthorizationCodeSlice is retrieved from the database
+func (o OauthAuthorizationCodeSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthAuthorizationCodes.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OauthAuthorizationCodes.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthAuthorizationCodes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OauthAuthorizationCodes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OauthAuthorizationCodeSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("oauth_authorization_codes", "code_hash").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OauthAuthorizationCodeSlice) copyMatchingRows(from ...*OauthAuthorizationCode) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.CodeHash != old.CodeHash {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OauthAuthorizationCodeSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthAuthorizationCodes.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthAuthorizationCode:
+				o.copyMatchingRows(retrieved)
+			case []*OauthAuthorizationCode:
+				o.copyMatchingRows(retrieved...)
+			case OauthAuthorizationCodeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthAuthorizationCode or a slice of OauthAuthorizationCode
+				// then run the AfterUpdateHooks on the slice
+				_, err = OauthAuthorizationCodes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OauthAuthorizationCodeSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthAuthorizationCodes.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthAuthorizationCode:
+				o.copyMatchingRows(retrieved)
+			case []*OauthAuthorizationCode:
+				o.copyMatchingRows(retrieved...)
+			case OauthAuthorizationCodeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthAuthorizationCode or a slice of OauthAuthorizationCode
+				// then run the AfterDeleteHooks on the slice
+				_, err = OauthAuthorizationCodes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OauthAuthorizationCodeSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OauthAuthorizationCodeSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthAuthorizationCodes.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OauthAuthorizationCodeSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthAuthorizationCodes.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OauthAuthorizationCodeSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OauthAuthorizationCodes.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// ClientOauthClient starts a query for related objects on oauth_clients
+func (o *OauthAuthorizationCode) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	return OauthClients.Query(append(mods,
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(o.ClientID))),
+	)...)
+}
+
+func (os OauthAuthorizationCodeSlice) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	pkClientID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkClientID = append(pkClientID, o.ClientID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkClientID), "text[]")),
+	))
+
+	return OauthClients.Query(append(mods,
+		sm.Where(psql.Group(OauthClients.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Session starts a query for related objects on sessions
+func (o *OauthAuthorizationCode) Session(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	return Sessions.Query(append(mods,
+		sm.Where(Sessions.Columns.ID.EQ(psql.Arg(o.SessionID))),
+	)...)
+}
+
+func (os OauthAuthorizationCodeSlice) Session(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	pkSessionID := make(pgtypes.Array[null.Val[uuid.UUID]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkSessionID = append(pkSessionID, o.SessionID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkSessionID), "uuid[]")),
+	))
+
+	return Sessions.Query(append(mods,
+		sm.Where(psql.Group(Sessions.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *OauthAuthorizationCode) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os OauthAuthorizationCodeSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "uuid[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachOauthAuthorizationCodeClientOauthClient0(ctx context.Context, exec bob.Executor, count int, oauthAuthorizationCode0 *OauthAuthorizationCode, oauthClient1 *OauthClient) (*OauthAuthorizationCode, error) {
+	setter := &OauthAuthorizationCodeSetter{
+		ClientID: omit.From(oauthClient1.ID),
+	}
+
+	err := oauthAuthorizationCode0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthAuthorizationCodeClientOauthClient0: %w", err)
+	}
+
+	return oauthAuthorizationCode0, nil
+}
+
+func (oauthAuthorizationCode0 *OauthAuthorizationCode) InsertClientOauthClient(ctx context.Context, exec bob.Executor, related *OauthClientSetter) error {
+	var err error
+
+	oauthClient1, err := OauthClients.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthAuthorizationCodeClientOauthClient0(ctx, exec, 1, oauthAuthorizationCode0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	oauthAuthorizationCode0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientOauthAuthorizationCodes = append(oauthClient1.R.ClientOauthAuthorizationCodes, oauthAuthorizationCode0)
+
+	return nil
+}
+
+func (oauthAuthorizationCode0 *OauthAuthorizationCode) AttachClientOauthClient(ctx context.Context, exec bob.Executor, oauthClient1 *OauthClient) error {
+	var err error
+
+	_, err = attachOauthAuthorizationCodeClientOauthClient0(ctx, exec, 1, oauthAuthorizationCode0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	oauthAuthorizationCode0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientOauthAuthorizationCodes = append(oauthClient1.R.ClientOauthAuthorizationCodes, oauthAuthorizationCode0)
+
+	return nil
+}
+
+func attachOauthAuthorizationCodeSession0(ctx context.Context, exec bob.Executor, count int, oauthAuthorizationCode0 *OauthAuthorizationCode, session1 *Session) (*OauthAuthorizationCode, error) {
+	setter := &OauthAuthorizationCodeSetter{
+		SessionID: omitnull.From(session1.ID),
+	}
+
+	err := oauthAuthorizationCode0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthAuthorizationCodeSession0: %w", err)
+	}
+
+	return oauthAuthorizationCode0, nil
+}
+
+func (oauthAuthorizationCode0 *OauthAuthorizationCode) InsertSession(ctx context.Context, exec bob.Executor, related *SessionSetter) error {
+	var err error
+
+	session1, err := Sessions.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthAuthorizationCodeSession0(ctx, exec, 1, oauthAuthorizationCode0, session1)
+	if err != nil {
+		return err
+	}
+
+	oauthAuthorizationCode0.R.Session = session1
+
+	session1.R.OauthAuthorizationCodes = append(session1.R.OauthAuthorizationCodes, oauthAuthorizationCode0)
+
+	return nil
+}
+
+func (oauthAuthorizationCode0 *OauthAuthorizationCode) AttachSession(ctx context.Context, exec bob.Executor, session1 *Session) error {
+	var err error
+
+	_, err = attachOauthAuthorizationCodeSession0(ctx, exec, 1, oauthAuthorizationCode0, session1)
+	if err != nil {
+		return err
+	}
+
+	oauthAuthorizationCode0.R.Session = session1
+
+	session1.R.OauthAuthorizationCodes = append(session1.R.OauthAuthorizationCodes, oauthAuthorizationCode0)
+
+	return nil
+}
+
+func attachOauthAuthorizationCodeUser0(ctx context.Context, exec bob.Executor, count int, oauthAuthorizationCode0 *OauthAuthorizationCode, user1 *User) (*OauthAuthorizationCode, error) {
+	setter := &OauthAuthorizationCodeSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := oauthAuthorizationCode0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthAuthorizationCodeUser0: %w", err)
+	}
+
+	return oauthAuthorizationCode0, nil
+}
+
+func (oauthAuthorizationCode0 *OauthAuthorizationCode) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthAuthorizationCodeUser0(ctx, exec, 1, oauthAuthorizationCode0, user1)
+	if err != nil {
+		return err
+	}
+
+	oauthAuthorizationCode0.R.User = user1
+
+	user1.R.OauthAuthorizationCodes = append(user1.R.OauthAuthorizationCodes, oauthAuthorizationCode0)
+
+	return nil
+}
+
+func (oauthAuthorizationCode0 *OauthAuthorizationCode) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachOauthAuthorizationCodeUser0(ctx, exec, 1, oauthAuthorizationCode0, user1)
+	if err != nil {
+		return err
+	}
+
+	oauthAuthorizationCode0.R.User = user1
+
+	user1.R.OauthAuthorizationCodes = append(user1.R.OauthAuthorizationCodes, oauthAuthorizationCode0)
+
+	return nil
+}
+
+type oauthAuthorizationCodeWhere[Q psql.Filterable] struct {
+	CodeHash      psql.WhereMod[Q, string]
+	ClientID      psql.WhereMod[Q, string]
+	UserID        psql.WhereMod[Q, uuid.UUID]
+	RedirectURI   psql.WhereMod[Q, string]
+	Scopes        psql.WhereMod[Q, string]
+	CodeChallenge psql.WhereMod[Q, string]
+	Nonce         psql.WhereMod[Q, string]
+	AuthTime      psql.WhereMod[Q, time.Time]
+	SessionID     psql.WhereNullMod[Q, uuid.UUID]
+	ExpiresAt     psql.WhereMod[Q, time.Time]
+	UsedAt        psql.WhereNullMod[Q, time.Time]
+	CreatedAt     psql.WhereMod[Q, time.Time]
+}
+
+func (oauthAuthorizationCodeWhere[Q]) AliasedAs(alias string) oauthAuthorizationCodeWhere[Q] {
+	return buildOauthAuthorizationCodeWhere[Q](buildOauthAuthorizationCodeColumns(alias))
+}
+
+func buildOauthAuthorizationCodeWhere[Q psql.Filterable](cols oauthAuthorizationCodeColumns) oauthAuthorizationCodeWhere[Q] {
+	return oauthAuthorizationCodeWhere[Q]{
+		CodeHash:      psql.Where[Q, string](cols.CodeHash),
+		ClientID:      psql.Where[Q, string](cols.ClientID),
+		UserID:        psql.Where[Q, uuid.UUID](cols.UserID),
+		RedirectURI:   psql.Where[Q, string](cols.RedirectURI),
+		Scopes:        psql.Where[Q, string](cols.Scopes),
+		CodeChallenge: psql.Where[Q, string](cols.CodeChallenge),
+		Nonce:         psql.Where[Q, string](cols.Nonce),
+		AuthTime:      psql.Where[Q, time.Time](cols.AuthTime),
+		SessionID:     psql.WhereNull[Q, uuid.UUID](cols.SessionID),
+		ExpiresAt:     psql.Where[Q, time.Time](cols.ExpiresAt),
+		UsedAt:        psql.WhereNull[Q, time.Time](cols.UsedAt),
+		CreatedAt:     psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *OauthAuthorizationCode) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ClientOauthClient":
+		rel, ok := retrieved.(*OauthClient)
+		if !ok {
+			return fmt.Errorf("oauthAuthorizationCode cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthClient = rel
+
+		if rel != nil {
+			rel.R.ClientOauthAuthorizationCodes = OauthAuthorizationCodeSlice{o}
+		}
+		return nil
+	case "Session":
+		rel, ok := retrieved.(*Session)
+		if !ok {
+			return fmt.Errorf("oauthAuthorizationCode cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Session = rel
+
+		if rel != nil {
+			rel.R.OauthAuthorizationCodes = OauthAuthorizationCodeSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("oauthAuthorizationCode cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.OauthAuthorizationCodes = OauthAuthorizationCodeSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("oauthAuthorizationCode has no relationship %q", name)
+	}
+}
+
+type oauthAuthorizationCodePreloader struct {
+	ClientOauthClient func(...psql.PreloadOption) psql.Preloader
+	Session           func(...psql.PreloadOption) psql.Preloader
+	User              func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildOauthAuthorizationCodePreloader() oauthAuthorizationCodePreloader {
+	return oauthAuthorizationCodePreloader{
+		ClientOauthClient: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*OauthClient, OauthClientSlice](psql.PreloadRel{
+				Name: "ClientOauthClient",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OauthAuthorizationCodes,
+						To:          OauthClients,
+						FromColumns: []string{"client_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, OauthClients.Columns.Names(), opts...)
+		},
+		Session: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Session, SessionSlice](psql.PreloadRel{
+				Name: "Session",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OauthAuthorizationCodes,
+						To:          Sessions,
+						FromColumns: []string{"session_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Sessions.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OauthAuthorizationCodes,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type oauthAuthorizationCodeThenLoader[Q orm.Loadable] struct {
+	ClientOauthClient func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Session           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOauthAuthorizationCodeThenLoader[Q orm.Loadable]() oauthAuthorizationCodeThenLoader[Q] {
+	type ClientOauthClientLoadInterface interface {
+		LoadClientOauthClient(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type SessionLoadInterface interface {
+		LoadSession(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oauthAuthorizationCodeThenLoader[Q]{
+		ClientOauthClient: thenLoadBuilder[Q](
+			"ClientOauthClient",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthClientLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthClient(ctx, exec, mods...)
+			},
+		),
+		Session: thenLoadBuilder[Q](
+			"Session",
+			func(ctx context.Context, exec bob.Executor, retrieved SessionLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadSession(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadClientOauthClient loads the oauthAuthorizationCode's ClientOauthClient into the .R struct
+func (o *OauthAuthorizationCode) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthClient = nil
+
+	related, err := o.ClientOauthClient(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ClientOauthAuthorizationCodes = OauthAuthorizationCodeSlice{o}
+
+	o.R.ClientOauthClient = related
+	return nil
+}
+
+// LoadClientOauthClient loads the oauthAuthorizationCode's ClientOauthClient into the .R struct
+func (os OauthAuthorizationCodeSlice) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthClients, err := os.ClientOauthClient(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthClients {
+
+			if !(o.ClientID == rel.ID) {
+				continue
+			}
+
+			rel.R.ClientOauthAuthorizationCodes = append(rel.R.ClientOauthAuthorizationCodes, o)
+
+			o.R.ClientOauthClient = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadSession loads the oauthAuthorizationCode's Session into the .R struct
+func (o *OauthAuthorizationCode) LoadSession(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Session = nil
+
+	related, err := o.Session(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OauthAuthorizationCodes = OauthAuthorizationCodeSlice{o}
+
+	o.R.Session = related
+	return nil
+}
+
+// LoadSession loads the oauthAuthorizationCode's Session into the .R struct
+func (os OauthAuthorizationCodeSlice) LoadSession(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	sessions, err := os.Session(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range sessions {
+			if !o.SessionID.IsValue() {
+				continue
+			}
+
+			if !(o.SessionID.IsValue() && o.SessionID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.OauthAuthorizationCodes = append(rel.R.OauthAuthorizationCodes, o)
+
+			o.R.Session = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the oauthAuthorizationCode's User into the .R struct
+func (o *OauthAuthorizationCode) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OauthAuthorizationCodes = OauthAuthorizationCodeSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the oauthAuthorizationCode's User into the .R struct
+func (os OauthAuthorizationCodeSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.OauthAuthorizationCodes = append(rel.R.OauthAuthorizationCodes, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type oauthAuthorizationCodeJoins[Q dialect.Joinable] struct {
+	typ               string
+	ClientOauthClient modAs[Q, oauthClientColumns]
+	Session           modAs[Q, sessionColumns]
+	User              modAs[Q, userColumns]
+}
+
+func (j oauthAuthorizationCodeJoins[Q]) aliasedAs(alias string) oauthAuthorizationCodeJoins[Q] {
+	return buildOauthAuthorizationCodeJoins[Q](buildOauthAuthorizationCodeColumns(alias), j.typ)
+}
+
+func buildOauthAuthorizationCodeJoins[Q dialect.Joinable](cols oauthAuthorizationCodeColumns, typ string) oauthAuthorizationCodeJoins[Q] {
+	return oauthAuthorizationCodeJoins[Q]{
+		typ: typ,
+		ClientOauthClient: modAs[Q, oauthClientColumns]{
+			c: OauthClients.Columns,
+			f: func(to oauthClientColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthClients.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ClientID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Session: modAs[Q, sessionColumns]{
+			c: Sessions.Columns,
+			f: func(to sessionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Sessions.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.SessionID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
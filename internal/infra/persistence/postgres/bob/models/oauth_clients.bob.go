@@ -0,0 +1,938 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// OauthClient is an object representing the database table.
+type OauthClient struct {
+	ID           string           `db:"id,pk" `
+	Name         string           `db:"name" `
+	SecretHash   null.Val[string] `db:"secret_hash" `
+	RedirectUris string           `db:"redirect_uris" `
+	Scopes       string           `db:"scopes" `
+	CreatedAt    time.Time        `db:"created_at" `
+	UpdatedAt    time.Time        `db:"updated_at" `
+
+	R oauthClientR `db:"-" `
+}
+
+// OauthClientSlice is an alias for a slice of pointers to OauthClient.
+// This should almost always be used instead of []*OauthClient.
+type OauthClientSlice []*OauthClient
+
+// OauthClients contains methods to work with the oauth_clients table
+var OauthClients = psql.NewTablex[*OauthClient, OauthClientSlice, *OauthClientSetter]("", "oauth_clients", buildOauthClientColumns("oauth_clients"))
+
+// OauthClientsQuery is a query on the oauth_clients table
+type OauthClientsQuery = *psql.ViewQuery[*OauthClient, OauthClientSlice]
+
+// oauthClientR is where relationships are stored.
+type oauthClientR struct {
+	ClientOauthAuthorizationCodes OauthAuthorizationCodeSlice // oauth_authorization_codes_client_id_fkey
+	ClientOauthGrants             OauthGrantSlice             // oauth_grants_client_id_fkey
+}
+
+func buildOauthClientColumns(alias string) oauthClientColumns {
+	return oauthClientColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "name", "secret_hash", "redirect_uris", "scopes", "created_at", "updated_at",
+		).WithParent("oauth_clients"),
+		tableAlias:   alias,
+		ID:           psql.Quote(alias, "id"),
+		Name:         psql.Quote(alias, "name"),
+		SecretHash:   psql.Quote(alias, "secret_hash"),
+		RedirectUris: psql.Quote(alias, "redirect_uris"),
+		Scopes:       psql.Quote(alias, "scopes"),
+		CreatedAt:    psql.Quote(alias, "created_at"),
+		UpdatedAt:    psql.Quote(alias, "updated_at"),
+	}
+}
+
+type oauthClientColumns struct {
+	expr.ColumnsExpr
+	tableAlias   string
+	ID           psql.Expression
+	Name         psql.Expression
+	SecretHash   psql.Expression
+	RedirectUris psql.Expression
+	Scopes       psql.Expression
+	CreatedAt    psql.Expression
+	UpdatedAt    psql.Expression
+}
+
+func (c oauthClientColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oauthClientColumns) AliasedAs(alias string) oauthClientColumns {
+	return buildOauthClientColumns(alias)
+}
+
+// OauthClientSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OauthClientSetter struct {
+	ID           omit.Val[string]     `db:"id,pk" `
+	Name         omit.Val[string]     `db:"name" `
+	SecretHash   omitnull.Val[string] `db:"secret_hash" `
+	RedirectUris omit.Val[string]     `db:"redirect_uris" `
+	Scopes       omit.Val[string]     `db:"scopes" `
+	CreatedAt    omit.Val[time.Time]  `db:"created_at" `
+	UpdatedAt    omit.Val[time.Time]  `db:"updated_at" `
+}
+
+func (s OauthClientSetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if !s.SecretHash.IsUnset() {
+		vals = append(vals, "secret_hash")
+	}
+	if s.RedirectUris.IsValue() {
+		vals = append(vals, "redirect_uris")
+	}
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.UpdatedAt.IsValue() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s OauthClientSetter) Overwrite(t *OauthClient) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if !s.SecretHash.IsUnset() {
+		t.SecretHash = s.SecretHash.MustGetNull()
+	}
+	if s.RedirectUris.IsValue() {
+		t.RedirectUris = s.RedirectUris.MustGet()
+	}
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.UpdatedAt.IsValue() {
+		t.UpdatedAt = s.UpdatedAt.MustGet()
+	}
+}
+
+func (s *OauthClientSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OauthClients.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 7)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[1] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.SecretHash.IsUnset() {
+			vals[2] = psql.Arg(s.SecretHash.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.RedirectUris.IsValue() {
+			vals[3] = psql.Arg(s.RedirectUris.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Scopes.IsValue() {
+			vals[4] = psql.Arg(s.Scopes.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[5] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.UpdatedAt.IsValue() {
+			vals[6] = psql.Arg(s.UpdatedAt.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OauthClientSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OauthClientSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if !s.SecretHash.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "secret_hash")...),
+			psql.Arg(s.SecretHash),
+		}})
+	}
+
+	if s.RedirectUris.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "redirect_uris")...),
+			psql.Arg(s.RedirectUris),
+		}})
+	}
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "scopes")...),
+			psql.Arg(s.Scopes),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.UpdatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOauthClient retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOauthClient(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*OauthClient, error) {
+	if len(cols) == 0 {
+		return OauthClients.Query(
+			sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(OauthClients.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OauthClientExists checks the presence of a single record by primary key
+func OauthClientExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OauthClient is retrieved from the database
+func (o *OauthClient) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthClients.AfterSelectHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OauthClients.AfterInsertHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OauthClient
+func (o *OauthClient) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *OauthClient) pkEQ() dialect.Expression {
+	return psql.Quote("oauth_clients", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OauthClient
+func (o *OauthClient) Update(ctx context.Context, exec bob.Executor, s *OauthClientSetter) error {
+	v, err := OauthClients.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OauthClient record with an executor
+func (o *OauthClient) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OauthClients.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OauthClient using the executor
+func (o *OauthClient) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OauthClientSlice is retrieved from the database
+func (o OauthClientSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthClients.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OauthClients.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OauthClientSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("oauth_clients", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OauthClientSlice) copyMatchingRows(from ...*OauthClient) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OauthClientSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthClients.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthClient:
+				o.copyMatchingRows(retrieved)
+			case []*OauthClient:
+				o.copyMatchingRows(retrieved...)
+			case OauthClientSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthClient or a slice of OauthClient
+				// then run the AfterUpdateHooks on the slice
+				_, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OauthClientSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthClients.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthClient:
+				o.copyMatchingRows(retrieved)
+			case []*OauthClient:
+				o.copyMatchingRows(retrieved...)
+			case OauthClientSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthClient or a slice of OauthClient
+				// then run the AfterDeleteHooks on the slice
+				_, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OauthClientSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OauthClientSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthClients.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OauthClientSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthClients.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OauthClientSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OauthClients.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// ClientOauthAuthorizationCodes starts a query for related objects on oauth_authorization_codes
+func (o *OauthClient) ClientOauthAuthorizationCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthAuthorizationCodesQuery {
+	return OauthAuthorizationCodes.Query(append(mods,
+		sm.Where(OauthAuthorizationCodes.Columns.ClientID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os OauthClientSlice) ClientOauthAuthorizationCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthAuthorizationCodesQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "text[]")),
+	))
+
+	return OauthAuthorizationCodes.Query(append(mods,
+		sm.Where(psql.Group(OauthAuthorizationCodes.Columns.ClientID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ClientOauthGrants starts a query for related objects on oauth_grants
+func (o *OauthClient) ClientOauthGrants(mods ...bob.Mod[*dialect.SelectQuery]) OauthGrantsQuery {
+	return OauthGrants.Query(append(mods,
+		sm.Where(OauthGrants.Columns.ClientID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os OauthClientSlice) ClientOauthGrants(mods ...bob.Mod[*dialect.SelectQuery]) OauthGrantsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "text[]")),
+	))
+
+	return OauthGrants.Query(append(mods,
+		sm.Where(psql.Group(OauthGrants.Columns.ClientID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertOauthClientClientOauthAuthorizationCodes0(ctx context.Context, exec bob.Executor, oauthAuthorizationCodes1 []*OauthAuthorizationCodeSetter, oauthClient0 *OauthClient) (OauthAuthorizationCodeSlice, error) {
+	for i := range oauthAuthorizationCodes1 {
+		oauthAuthorizationCodes1[i].ClientID = omit.From(oauthClient0.ID)
+	}
+
+	ret, err := OauthAuthorizationCodes.Insert(bob.ToMods(oauthAuthorizationCodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOauthClientClientOauthAuthorizationCodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOauthClientClientOauthAuthorizationCodes0(ctx context.Context, exec bob.Executor, count int, oauthAuthorizationCodes1 OauthAuthorizationCodeSlice, oauthClient0 *OauthClient) (OauthAuthorizationCodeSlice, error) {
+	setter := &OauthAuthorizationCodeSetter{
+		ClientID: omit.From(oauthClient0.ID),
+	}
+
+	err := oauthAuthorizationCodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthClientClientOauthAuthorizationCodes0: %w", err)
+	}
+
+	return oauthAuthorizationCodes1, nil
+}
+
+func (oauthClient0 *OauthClient) InsertClientOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, related ...*OauthAuthorizationCodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthAuthorizationCodes1, err := insertOauthClientClientOauthAuthorizationCodes0(ctx, exec, related, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientOauthAuthorizationCodes = append(oauthClient0.R.ClientOauthAuthorizationCodes, oauthAuthorizationCodes1...)
+
+	for _, rel := range oauthAuthorizationCodes1 {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+	return nil
+}
+
+func (oauthClient0 *OauthClient) AttachClientOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, related ...*OauthAuthorizationCode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthAuthorizationCodes1 := OauthAuthorizationCodeSlice(related)
+
+	_, err = attachOauthClientClientOauthAuthorizationCodes0(ctx, exec, len(related), oauthAuthorizationCodes1, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientOauthAuthorizationCodes = append(oauthClient0.R.ClientOauthAuthorizationCodes, oauthAuthorizationCodes1...)
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+
+	return nil
+}
+
+func insertOauthClientClientOauthGrants0(ctx context.Context, exec bob.Executor, oauthGrants1 []*OauthGrantSetter, oauthClient0 *OauthClient) (OauthGrantSlice, error) {
+	for i := range oauthGrants1 {
+		oauthGrants1[i].ClientID = omit.From(oauthClient0.ID)
+	}
+
+	ret, err := OauthGrants.Insert(bob.ToMods(oauthGrants1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOauthClientClientOauthGrants0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOauthClientClientOauthGrants0(ctx context.Context, exec bob.Executor, count int, oauthGrants1 OauthGrantSlice, oauthClient0 *OauthClient) (OauthGrantSlice, error) {
+	setter := &OauthGrantSetter{
+		ClientID: omit.From(oauthClient0.ID),
+	}
+
+	err := oauthGrants1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthClientClientOauthGrants0: %w", err)
+	}
+
+	return oauthGrants1, nil
+}
+
+func (oauthClient0 *OauthClient) InsertClientOauthGrants(ctx context.Context, exec bob.Executor, related ...*OauthGrantSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthGrants1, err := insertOauthClientClientOauthGrants0(ctx, exec, related, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientOauthGrants = append(oauthClient0.R.ClientOauthGrants, oauthGrants1...)
+
+	for _, rel := range oauthGrants1 {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+	return nil
+}
+
+func (oauthClient0 *OauthClient) AttachClientOauthGrants(ctx context.Context, exec bob.Executor, related ...*OauthGrant) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthGrants1 := OauthGrantSlice(related)
+
+	_, err = attachOauthClientClientOauthGrants0(ctx, exec, len(related), oauthGrants1, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientOauthGrants = append(oauthClient0.R.ClientOauthGrants, oauthGrants1...)
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+
+	return nil
+}
+
+type oauthClientWhere[Q psql.Filterable] struct {
+	ID           psql.WhereMod[Q, string]
+	Name         psql.WhereMod[Q, string]
+	SecretHash   psql.WhereNullMod[Q, string]
+	RedirectUris psql.WhereMod[Q, string]
+	Scopes       psql.WhereMod[Q, string]
+	CreatedAt    psql.WhereMod[Q, time.Time]
+	UpdatedAt    psql.WhereMod[Q, time.Time]
+}
+
+func (oauthClientWhere[Q]) AliasedAs(alias string) oauthClientWhere[Q] {
+	return buildOauthClientWhere[Q](buildOauthClientColumns(alias))
+}
+
+func buildOauthClientWhere[Q psql.Filterable](cols oauthClientColumns) oauthClientWhere[Q] {
+	return oauthClientWhere[Q]{
+		ID:           psql.Where[Q, string](cols.ID),
+		Name:         psql.Where[Q, string](cols.Name),
+		SecretHash:   psql.WhereNull[Q, string](cols.SecretHash),
+		RedirectUris: psql.Where[Q, string](cols.RedirectUris),
+		Scopes:       psql.Where[Q, string](cols.Scopes),
+		CreatedAt:    psql.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:    psql.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *OauthClient) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ClientOauthAuthorizationCodes":
+		rels, ok := retrieved.(OauthAuthorizationCodeSlice)
+		if !ok {
+			return fmt.Errorf("oauthClient cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthAuthorizationCodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ClientOauthClient = o
+			}
+		}
+		return nil
+	case "ClientOauthGrants":
+		rels, ok := retrieved.(OauthGrantSlice)
+		if !ok {
+			return fmt.Errorf("oauthClient cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthGrants = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ClientOauthClient = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("oauthClient has no relationship %q", name)
+	}
+}
+
+type oauthClientPreloader struct{}
+
+func buildOauthClientPreloader() oauthClientPreloader {
+	return oauthClientPreloader{}
+}
+
+type oauthClientThenLoader[Q orm.Loadable] struct {
+	ClientOauthAuthorizationCodes func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ClientOauthGrants             func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOauthClientThenLoader[Q orm.Loadable]() oauthClientThenLoader[Q] {
+	type ClientOauthAuthorizationCodesLoadInterface interface {
+		LoadClientOauthAuthorizationCodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ClientOauthGrantsLoadInterface interface {
+		LoadClientOauthGrants(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oauthClientThenLoader[Q]{
+		ClientOauthAuthorizationCodes: thenLoadBuilder[Q](
+			"ClientOauthAuthorizationCodes",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthAuthorizationCodesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthAuthorizationCodes(ctx, exec, mods...)
+			},
+		),
+		ClientOauthGrants: thenLoadBuilder[Q](
+			"ClientOauthGrants",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthGrantsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthGrants(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadClientOauthAuthorizationCodes loads the oauthClient's ClientOauthAuthorizationCodes into the .R struct
+func (o *OauthClient) LoadClientOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthAuthorizationCodes = nil
+
+	related, err := o.ClientOauthAuthorizationCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = o
+	}
+
+	o.R.ClientOauthAuthorizationCodes = related
+	return nil
+}
+
+// LoadClientOauthAuthorizationCodes loads the oauthClient's ClientOauthAuthorizationCodes into the .R struct
+func (os OauthClientSlice) LoadClientOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthAuthorizationCodes, err := os.ClientOauthAuthorizationCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ClientOauthAuthorizationCodes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthAuthorizationCodes {
+
+			if !(o.ID == rel.ClientID) {
+				continue
+			}
+
+			rel.R.ClientOauthClient = o
+
+			o.R.ClientOauthAuthorizationCodes = append(o.R.ClientOauthAuthorizationCodes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadClientOauthGrants loads the oauthClient's ClientOauthGrants into the .R struct
+func (o *OauthClient) LoadClientOauthGrants(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthGrants = nil
+
+	related, err := o.ClientOauthGrants(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = o
+	}
+
+	o.R.ClientOauthGrants = related
+	return nil
+}
+
+// LoadClientOauthGrants loads the oauthClient's ClientOauthGrants into the .R struct
+func (os OauthClientSlice) LoadClientOauthGrants(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthGrants, err := os.ClientOauthGrants(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ClientOauthGrants = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthGrants {
+
+			if !(o.ID == rel.ClientID) {
+				continue
+			}
+
+			rel.R.ClientOauthClient = o
+
+			o.R.ClientOauthGrants = append(o.R.ClientOauthGrants, rel)
+		}
+	}
+
+	return nil
+}
+
+type oauthClientJoins[Q dialect.Joinable] struct {
+	typ                           string
+	ClientOauthAuthorizationCodes modAs[Q, oauthAuthorizationCodeColumns]
+	ClientOauthGrants             modAs[Q, oauthGrantColumns]
+}
+
+func (j oauthClientJoins[Q]) aliasedAs(alias string) oauthClientJoins[Q] {
+	return buildOauthClientJoins[Q](buildOauthClientColumns(alias), j.typ)
+}
+
+func buildOauthClientJoins[Q dialect.Joinable](cols oauthClientColumns, typ string) oauthClientJoins[Q] {
+	return oauthClientJoins[Q]{
+		typ: typ,
+		ClientOauthAuthorizationCodes: modAs[Q, oauthAuthorizationCodeColumns]{
+			c: OauthAuthorizationCodes.Columns,
+			f: func(to oauthAuthorizationCodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthAuthorizationCodes.Name().As(to.Alias())).On(
+						to.ClientID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ClientOauthGrants: modAs[Q, oauthGrantColumns]{
+			c: OauthGrants.Columns,
+			f: func(to oauthGrantColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthGrants.Name().As(to.Alias())).On(
+						to.ClientID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
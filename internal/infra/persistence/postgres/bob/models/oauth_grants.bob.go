@@ -0,0 +1,855 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// OauthGrant is an object representing the database table.
+type OauthGrant struct {
+	SessionID uuid.UUID `db:"session_id,pk" `
+	ClientID  string    `db:"client_id" `
+	Scopes    string    `db:"scopes" `
+	AuthTime  time.Time `db:"auth_time" `
+	CreatedAt time.Time `db:"created_at" `
+
+	R oauthGrantR `db:"-" `
+}
+
+// OauthGrantSlice is an alias for a slice of pointers to OauthGrant.
+// This should almost always be used instead of []*OauthGrant.
+type OauthGrantSlice []*OauthGrant
+
+// OauthGrants contains methods to work with the oauth_grants table
+var OauthGrants = psql.NewTablex[*OauthGrant, OauthGrantSlice, *OauthGrantSetter]("", "oauth_grants", buildOauthGrantColumns("oauth_grants"))
+
+// OauthGrantsQuery is a query on the oauth_grants table
+type OauthGrantsQuery = *psql.ViewQuery[*OauthGrant, OauthGrantSlice]
+
+// oauthGrantR is where relationships are stored.
+type oauthGrantR struct {
+	ClientOauthClient *OauthClient // oauth_grants_client_id_fkey
+	Session           *Session     // oauth_grants_session_id_fkey
+}
+
+func buildOauthGrantColumns(alias string) oauthGrantColumns {
+	return oauthGrantColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"session_id", "client_id", "scopes", "auth_time", "created_at",
+		).WithParent("oauth_grants"),
+		tableAlias: alias,
+		SessionID:  psql.Quote(alias, "session_id"),
+		ClientID:   psql.Quote(alias, "client_id"),
+		Scopes:     psql.Quote(alias, "scopes"),
+		AuthTime:   psql.Quote(alias, "auth_time"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type oauthGrantColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	SessionID  psql.Expression
+	ClientID   psql.Expression
+	Scopes     psql.Expression
+	AuthTime   psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c oauthGrantColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oauthGrantColumns) AliasedAs(alias string) oauthGrantColumns {
+	return buildOauthGrantColumns(alias)
+}
+
+// OauthGrantSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OauthGrantSetter struct {
+	SessionID omit.Val[uuid.UUID] `db:"session_id,pk" `
+	ClientID  omit.Val[string]    `db:"client_id" `
+	Scopes    omit.Val[string]    `db:"scopes" `
+	AuthTime  omit.Val[time.Time] `db:"auth_time" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+}
+
+func (s OauthGrantSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.SessionID.IsValue() {
+		vals = append(vals, "session_id")
+	}
+	if s.ClientID.IsValue() {
+		vals = append(vals, "client_id")
+	}
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if s.AuthTime.IsValue() {
+		vals = append(vals, "auth_time")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s OauthGrantSetter) Overwrite(t *OauthGrant) {
+	if s.SessionID.IsValue() {
+		t.SessionID = s.SessionID.MustGet()
+	}
+	if s.ClientID.IsValue() {
+		t.ClientID = s.ClientID.MustGet()
+	}
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if s.AuthTime.IsValue() {
+		t.AuthTime = s.AuthTime.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *OauthGrantSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OauthGrants.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 5)
+		if s.SessionID.IsValue() {
+			vals[0] = psql.Arg(s.SessionID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.ClientID.IsValue() {
+			vals[1] = psql.Arg(s.ClientID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Scopes.IsValue() {
+			vals[2] = psql.Arg(s.Scopes.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.AuthTime.IsValue() {
+			vals[3] = psql.Arg(s.AuthTime.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[4] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OauthGrantSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OauthGrantSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.SessionID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "session_id")...),
+			psql.Arg(s.SessionID),
+		}})
+	}
+
+	if s.ClientID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "client_id")...),
+			psql.Arg(s.ClientID),
+		}})
+	}
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "scopes")...),
+			psql.Arg(s.Scopes),
+		}})
+	}
+
+	if s.AuthTime.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "auth_time")...),
+			psql.Arg(s.AuthTime),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOauthGrant retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOauthGrant(ctx context.Context, exec bob.Executor, SessionIDPK uuid.UUID, cols ...string) (*OauthGrant, error) {
+	if len(cols) == 0 {
+		return OauthGrants.Query(
+			sm.Where(OauthGrants.Columns.SessionID.EQ(psql.Arg(SessionIDPK))),
+		).One(ctx, exec)
+	}
+
+	return OauthGrants.Query(
+		sm.Where(OauthGrants.Columns.SessionID.EQ(psql.Arg(SessionIDPK))),
+		sm.Columns(OauthGrants.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OauthGrantExists checks the presence of a single record by primary key
+func OauthGrantExists(ctx context.Context, exec bob.Executor, SessionIDPK uuid.UUID) (bool, error) {
+	return OauthGrants.Query(
+		sm.Where(OauthGrants.Columns.SessionID.EQ(psql.Arg(SessionIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OauthGrant is retrieved from the database
+func (o *OauthGrant) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthGrants.AfterSelectHooks.RunHooks(ctx, exec, OauthGrantSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OauthGrants.AfterInsertHooks.RunHooks(ctx, exec, OauthGrantSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthGrants.AfterUpdateHooks.RunHooks(ctx, exec, OauthGrantSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OauthGrants.AfterDeleteHooks.RunHooks(ctx, exec, OauthGrantSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OauthGrant
+func (o *OauthGrant) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.SessionID)
+}
+
+func (o *OauthGrant) pkEQ() dialect.Expression {
+	return psql.Quote("oauth_grants", "session_id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OauthGrant
+func (o *OauthGrant) Update(ctx context.Context, exec bob.Executor, s *OauthGrantSetter) error {
+	v, err := OauthGrants.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OauthGrant record with an executor
+func (o *OauthGrant) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OauthGrants.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OauthGrant using the executor
+func (o *OauthGrant) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OauthGrants.Query(
+		sm.Where(OauthGrants.Columns.SessionID.EQ(psql.Arg(o.SessionID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OauthGrantSlice is retrieved from the database
+func (o OauthGrantSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthGrants.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OauthGrants.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthGrants.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OauthGrants.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OauthGrantSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("oauth_grants", "session_id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OauthGrantSlice) copyMatchingRows(from ...*OauthGrant) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.SessionID != old.SessionID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OauthGrantSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthGrants.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthGrant:
+				o.copyMatchingRows(retrieved)
+			case []*OauthGrant:
+				o.copyMatchingRows(retrieved...)
+			case OauthGrantSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthGrant or a slice of OauthGrant
+				// then run the AfterUpdateHooks on the slice
+				_, err = OauthGrants.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OauthGrantSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthGrants.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthGrant:
+				o.copyMatchingRows(retrieved)
+			case []*OauthGrant:
+				o.copyMatchingRows(retrieved...)
+			case OauthGrantSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthGrant or a slice of OauthGrant
+				// then run the AfterDeleteHooks on the slice
+				_, err = OauthGrants.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OauthGrantSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OauthGrantSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthGrants.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OauthGrantSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthGrants.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OauthGrantSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OauthGrants.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// ClientOauthClient starts a query for related objects on oauth_clients
+func (o *OauthGrant) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	return OauthClients.Query(append(mods,
+		sm.Where(OauthClients.Columns.ID.EQ(psql.Arg(o.ClientID))),
+	)...)
+}
+
+func (os OauthGrantSlice) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	pkClientID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkClientID = append(pkClientID, o.ClientID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkClientID), "text[]")),
+	))
+
+	return OauthClients.Query(append(mods,
+		sm.Where(psql.Group(OauthClients.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Session starts a query for related objects on sessions
+func (o *OauthGrant) Session(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	return Sessions.Query(append(mods,
+		sm.Where(Sessions.Columns.ID.EQ(psql.Arg(o.SessionID))),
+	)...)
+}
+
+func (os OauthGrantSlice) Session(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	pkSessionID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkSessionID = append(pkSessionID, o.SessionID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkSessionID), "uuid[]")),
+	))
+
+	return Sessions.Query(append(mods,
+		sm.Where(psql.Group(Sessions.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachOauthGrantClientOauthClient0(ctx context.Context, exec bob.Executor, count int, oauthGrant0 *OauthGrant, oauthClient1 *OauthClient) (*OauthGrant, error) {
+	setter := &OauthGrantSetter{
+		ClientID: omit.From(oauthClient1.ID),
+	}
+
+	err := oauthGrant0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthGrantClientOauthClient0: %w", err)
+	}
+
+	return oauthGrant0, nil
+}
+
+func (oauthGrant0 *OauthGrant) InsertClientOauthClient(ctx context.Context, exec bob.Executor, related *OauthClientSetter) error {
+	var err error
+
+	oauthClient1, err := OauthClients.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthGrantClientOauthClient0(ctx, exec, 1, oauthGrant0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	oauthGrant0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientOauthGrants = append(oauthClient1.R.ClientOauthGrants, oauthGrant0)
+
+	return nil
+}
+
+func (oauthGrant0 *OauthGrant) AttachClientOauthClient(ctx context.Context, exec bob.Executor, oauthClient1 *OauthClient) error {
+	var err error
+
+	_, err = attachOauthGrantClientOauthClient0(ctx, exec, 1, oauthGrant0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	oauthGrant0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientOauthGrants = append(oauthClient1.R.ClientOauthGrants, oauthGrant0)
+
+	return nil
+}
+
+func attachOauthGrantSession0(ctx context.Context, exec bob.Executor, count int, oauthGrant0 *OauthGrant, session1 *Session) (*OauthGrant, error) {
+	setter := &OauthGrantSetter{
+		SessionID: omit.From(session1.ID),
+	}
+
+	err := oauthGrant0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthGrantSession0: %w", err)
+	}
+
+	return oauthGrant0, nil
+}
+
+func (oauthGrant0 *OauthGrant) InsertSession(ctx context.Context, exec bob.Executor, related *SessionSetter) error {
+	var err error
+
+	session1, err := Sessions.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthGrantSession0(ctx, exec, 1, oauthGrant0, session1)
+	if err != nil {
+		return err
+	}
+
+	oauthGrant0.R.Session = session1
+
+	session1.R.OauthGrant = oauthGrant0
+
+	return nil
+}
+
+func (oauthGrant0 *OauthGrant) AttachSession(ctx context.Context, exec bob.Executor, session1 *Session) error {
+	var err error
+
+	_, err = attachOauthGrantSession0(ctx, exec, 1, oauthGrant0, session1)
+	if err != nil {
+		return err
+	}
+
+	oauthGrant0.R.Session = session1
+
+	session1.R.OauthGrant = oauthGrant0
+
+	return nil
+}
+
+type oauthGrantWhere[Q psql.Filterable] struct {
+	SessionID psql.WhereMod[Q, uuid.UUID]
+	ClientID  psql.WhereMod[Q, string]
+	Scopes    psql.WhereMod[Q, string]
+	AuthTime  psql.WhereMod[Q, time.Time]
+	CreatedAt psql.WhereMod[Q, time.Time]
+}
+
+func (oauthGrantWhere[Q]) AliasedAs(alias string) oauthGrantWhere[Q] {
+	return buildOauthGrantWhere[Q](buildOauthGrantColumns(alias))
+}
+
+func buildOauthGrantWhere[Q psql.Filterable](cols oauthGrantColumns) oauthGrantWhere[Q] {
+	return oauthGrantWhere[Q]{
+		SessionID: psql.Where[Q, uuid.UUID](cols.SessionID),
+		ClientID:  psql.Where[Q, string](cols.ClientID),
+		Scopes:    psql.Where[Q, string](cols.Scopes),
+		AuthTime:  psql.Where[Q, time.Time](cols.AuthTime),
+		CreatedAt: psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *OauthGrant) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ClientOauthClient":
+		rel, ok := retrieved.(*OauthClient)
+		if !ok {
+			return fmt.Errorf("oauthGrant cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthClient = rel
+
+		if rel != nil {
+			rel.R.ClientOauthGrants = OauthGrantSlice{o}
+		}
+		return nil
+	case "Session":
+		rel, ok := retrieved.(*Session)
+		if !ok {
+			return fmt.Errorf("oauthGrant cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Session = rel
+
+		if rel != nil {
+			rel.R.OauthGrant = o
+		}
+		return nil
+	default:
+		return fmt.Errorf("oauthGrant has no relationship %q", name)
+	}
+}
+
+type oauthGrantPreloader struct {
+	ClientOauthClient func(...psql.PreloadOption) psql.Preloader
+	Session           func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildOauthGrantPreloader() oauthGrantPreloader {
+	return oauthGrantPreloader{
+		ClientOauthClient: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*OauthClient, OauthClientSlice](psql.PreloadRel{
+				Name: "ClientOauthClient",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OauthGrants,
+						To:          OauthClients,
+						FromColumns: []string{"client_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, OauthClients.Columns.Names(), opts...)
+		},
+		Session: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Session, SessionSlice](psql.PreloadRel{
+				Name: "Session",
+				Sides: []psql.PreloadSide{
+					{
+						From:        OauthGrants,
+						To:          Sessions,
+						FromColumns: []string{"session_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Sessions.Columns.Names(), opts...)
+		},
+	}
+}
+
+type oauthGrantThenLoader[Q orm.Loadable] struct {
+	ClientOauthClient func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Session           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOauthGrantThenLoader[Q orm.Loadable]() oauthGrantThenLoader[Q] {
+	type ClientOauthClientLoadInterface interface {
+		LoadClientOauthClient(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type SessionLoadInterface interface {
+		LoadSession(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oauthGrantThenLoader[Q]{
+		ClientOauthClient: thenLoadBuilder[Q](
+			"ClientOauthClient",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthClientLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthClient(ctx, exec, mods...)
+			},
+		),
+		Session: thenLoadBuilder[Q](
+			"Session",
+			func(ctx context.Context, exec bob.Executor, retrieved SessionLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadSession(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadClientOauthClient loads the oauthGrant's ClientOauthClient into the .R struct
+func (o *OauthGrant) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthClient = nil
+
+	related, err := o.ClientOauthClient(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ClientOauthGrants = OauthGrantSlice{o}
+
+	o.R.ClientOauthClient = related
+	return nil
+}
+
+// LoadClientOauthClient loads the oauthGrant's ClientOauthClient into the .R struct
+func (os OauthGrantSlice) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthClients, err := os.ClientOauthClient(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthClients {
+
+			if !(o.ClientID == rel.ID) {
+				continue
+			}
+
+			rel.R.ClientOauthGrants = append(rel.R.ClientOauthGrants, o)
+
+			o.R.ClientOauthClient = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadSession loads the oauthGrant's Session into the .R struct
+func (o *OauthGrant) LoadSession(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Session = nil
+
+	related, err := o.Session(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OauthGrant = o
+
+	o.R.Session = related
+	return nil
+}
+
+// LoadSession loads the oauthGrant's Session into the .R struct
+func (os OauthGrantSlice) LoadSession(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	sessions, err := os.Session(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range sessions {
+
+			if !(o.SessionID == rel.ID) {
+				continue
+			}
+
+			rel.R.OauthGrant = o
+
+			o.R.Session = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type oauthGrantJoins[Q dialect.Joinable] struct {
+	typ               string
+	ClientOauthClient modAs[Q, oauthClientColumns]
+	Session           modAs[Q, sessionColumns]
+}
+
+func (j oauthGrantJoins[Q]) aliasedAs(alias string) oauthGrantJoins[Q] {
+	return buildOauthGrantJoins[Q](buildOauthGrantColumns(alias), j.typ)
+}
+
+func buildOauthGrantJoins[Q dialect.Joinable](cols oauthGrantColumns, typ string) oauthGrantJoins[Q] {
+	return oauthGrantJoins[Q]{
+		typ: typ,
+		ClientOauthClient: modAs[Q, oauthClientColumns]{
+			c: OauthClients.Columns,
+			f: func(to oauthClientColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthClients.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ClientID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Session: modAs[Q, sessionColumns]{
+			c: Sessions.Columns,
+			f: func(to sessionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Sessions.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.SessionID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
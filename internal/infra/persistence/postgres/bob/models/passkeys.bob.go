@@ -0,0 +1,881 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Passkey is an object representing the database table.
+type Passkey struct {
+	ID              uuid.UUID           `db:"id,pk" `
+	UserID          uuid.UUID           `db:"user_id" `
+	Name            string              `db:"name" `
+	CredentialID    []byte              `db:"credential_id" `
+	PublicKey       []byte              `db:"public_key" `
+	AttestationType string              `db:"attestation_type" `
+	Aaguid          []byte              `db:"aaguid" `
+	SignCount       int64               `db:"sign_count" `
+	Transports      string              `db:"transports" `
+	BackupEligible  bool                `db:"backup_eligible" `
+	BackupState     bool                `db:"backup_state" `
+	LastUsedAt      null.Val[time.Time] `db:"last_used_at" `
+	CreatedAt       time.Time           `db:"created_at" `
+
+	R passkeyR `db:"-" `
+}
+
+// PasskeySlice is an alias for a slice of pointers to Passkey.
+// This should almost always be used instead of []*Passkey.
+type PasskeySlice []*Passkey
+
+// Passkeys contains methods to work with the passkeys table
+var Passkeys = psql.NewTablex[*Passkey, PasskeySlice, *PasskeySetter]("", "passkeys", buildPasskeyColumns("passkeys"))
+
+// PasskeysQuery is a query on the passkeys table
+type PasskeysQuery = *psql.ViewQuery[*Passkey, PasskeySlice]
+
+// passkeyR is where relationships are stored.
+type passkeyR struct {
+	User *User // passkeys_user_id_fkey
+}
+
+func buildPasskeyColumns(alias string) passkeyColumns {
+	return passkeyColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "name", "credential_id", "public_key", "attestation_type", "aaguid", "sign_count", "transports", "backup_eligible", "backup_state", "last_used_at", "created_at",
+		).WithParent("passkeys"),
+		tableAlias:      alias,
+		ID:              psql.Quote(alias, "id"),
+		UserID:          psql.Quote(alias, "user_id"),
+		Name:            psql.Quote(alias, "name"),
+		CredentialID:    psql.Quote(alias, "credential_id"),
+		PublicKey:       psql.Quote(alias, "public_key"),
+		AttestationType: psql.Quote(alias, "attestation_type"),
+		Aaguid:          psql.Quote(alias, "aaguid"),
+		SignCount:       psql.Quote(alias, "sign_count"),
+		Transports:      psql.Quote(alias, "transports"),
+		BackupEligible:  psql.Quote(alias, "backup_eligible"),
+		BackupState:     psql.Quote(alias, "backup_state"),
+		LastUsedAt:      psql.Quote(alias, "last_used_at"),
+		CreatedAt:       psql.Quote(alias, "created_at"),
+	}
+}
+
+type passkeyColumns struct {
+	expr.ColumnsExpr
+	tableAlias      string
+	ID              psql.Expression
+	UserID          psql.Expression
+	Name            psql.Expression
+	CredentialID    psql.Expression
+	PublicKey       psql.Expression
+	AttestationType psql.Expression
+	Aaguid          psql.Expression
+	SignCount       psql.Expression
+	Transports      psql.Expression
+	BackupEligible  psql.Expression
+	BackupState     psql.Expression
+	LastUsedAt      psql.Expression
+	CreatedAt       psql.Expression
+}
+
+func (c passkeyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (passkeyColumns) AliasedAs(alias string) passkeyColumns {
+	return buildPasskeyColumns(alias)
+}
+
+// PasskeySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type PasskeySetter struct {
+	ID              omit.Val[uuid.UUID]     `db:"id,pk" `
+	UserID          omit.Val[uuid.UUID]     `db:"user_id" `
+	Name            omit.Val[string]        `db:"name" `
+	CredentialID    omit.Val[[]byte]        `db:"credential_id" `
+	PublicKey       omit.Val[[]byte]        `db:"public_key" `
+	AttestationType omit.Val[string]        `db:"attestation_type" `
+	Aaguid          omit.Val[[]byte]        `db:"aaguid" `
+	SignCount       omit.Val[int64]         `db:"sign_count" `
+	Transports      omit.Val[string]        `db:"transports" `
+	BackupEligible  omit.Val[bool]          `db:"backup_eligible" `
+	BackupState     omit.Val[bool]          `db:"backup_state" `
+	LastUsedAt      omitnull.Val[time.Time] `db:"last_used_at" `
+	CreatedAt       omit.Val[time.Time]     `db:"created_at" `
+}
+
+func (s PasskeySetter) SetColumns() []string {
+	vals := make([]string, 0, 13)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.CredentialID.IsValue() {
+		vals = append(vals, "credential_id")
+	}
+	if s.PublicKey.IsValue() {
+		vals = append(vals, "public_key")
+	}
+	if s.AttestationType.IsValue() {
+		vals = append(vals, "attestation_type")
+	}
+	if s.Aaguid.IsValue() {
+		vals = append(vals, "aaguid")
+	}
+	if s.SignCount.IsValue() {
+		vals = append(vals, "sign_count")
+	}
+	if s.Transports.IsValue() {
+		vals = append(vals, "transports")
+	}
+	if s.BackupEligible.IsValue() {
+		vals = append(vals, "backup_eligible")
+	}
+	if s.BackupState.IsValue() {
+		vals = append(vals, "backup_state")
+	}
+	if !s.LastUsedAt.IsUnset() {
+		vals = append(vals, "last_used_at")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s PasskeySetter) Overwrite(t *Passkey) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.CredentialID.IsValue() {
+		t.CredentialID = s.CredentialID.MustGet()
+	}
+	if s.PublicKey.IsValue() {
+		t.PublicKey = s.PublicKey.MustGet()
+	}
+	if s.AttestationType.IsValue() {
+		t.AttestationType = s.AttestationType.MustGet()
+	}
+	if s.Aaguid.IsValue() {
+		t.Aaguid = s.Aaguid.MustGet()
+	}
+	if s.SignCount.IsValue() {
+		t.SignCount = s.SignCount.MustGet()
+	}
+	if s.Transports.IsValue() {
+		t.Transports = s.Transports.MustGet()
+	}
+	if s.BackupEligible.IsValue() {
+		t.BackupEligible = s.BackupEligible.MustGet()
+	}
+	if s.BackupState.IsValue() {
+		t.BackupState = s.BackupState.MustGet()
+	}
+	if !s.LastUsedAt.IsUnset() {
+		t.LastUsedAt = s.LastUsedAt.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *PasskeySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Passkeys.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 13)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[2] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.CredentialID.IsValue() {
+			vals[3] = psql.Arg(s.CredentialID.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.PublicKey.IsValue() {
+			vals[4] = psql.Arg(s.PublicKey.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.AttestationType.IsValue() {
+			vals[5] = psql.Arg(s.AttestationType.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.Aaguid.IsValue() {
+			vals[6] = psql.Arg(s.Aaguid.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.SignCount.IsValue() {
+			vals[7] = psql.Arg(s.SignCount.MustGet())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if s.Transports.IsValue() {
+			vals[8] = psql.Arg(s.Transports.MustGet())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if s.BackupEligible.IsValue() {
+			vals[9] = psql.Arg(s.BackupEligible.MustGet())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if s.BackupState.IsValue() {
+			vals[10] = psql.Arg(s.BackupState.MustGet())
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		if !s.LastUsedAt.IsUnset() {
+			vals[11] = psql.Arg(s.LastUsedAt.MustGetNull())
+		} else {
+			vals[11] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[12] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s PasskeySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s PasskeySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 13)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if s.CredentialID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "credential_id")...),
+			psql.Arg(s.CredentialID),
+		}})
+	}
+
+	if s.PublicKey.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "public_key")...),
+			psql.Arg(s.PublicKey),
+		}})
+	}
+
+	if s.AttestationType.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "attestation_type")...),
+			psql.Arg(s.AttestationType),
+		}})
+	}
+
+	if s.Aaguid.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "aaguid")...),
+			psql.Arg(s.Aaguid),
+		}})
+	}
+
+	if s.SignCount.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "sign_count")...),
+			psql.Arg(s.SignCount),
+		}})
+	}
+
+	if s.Transports.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "transports")...),
+			psql.Arg(s.Transports),
+		}})
+	}
+
+	if s.BackupEligible.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "backup_eligible")...),
+			psql.Arg(s.BackupEligible),
+		}})
+	}
+
+	if s.BackupState.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "backup_state")...),
+			psql.Arg(s.BackupState),
+		}})
+	}
+
+	if !s.LastUsedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_used_at")...),
+			psql.Arg(s.LastUsedAt),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindPasskey retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindPasskey(ctx context.Context, exec bob.Executor, IDPK uuid.UUID, cols ...string) (*Passkey, error) {
+	if len(cols) == 0 {
+		return Passkeys.Query(
+			sm.Where(Passkeys.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Passkeys.Query(
+		sm.Where(Passkeys.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Passkeys.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// PasskeyExists checks the presence of a single record by primary key
+func PasskeyExists(ctx context.Context, exec bob.Executor, IDPK uuid.UUID) (bool, error) {
+	return Passkeys.Query(
+		sm.Where(Passkeys.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Passkey is retrieved from the database
+func (o *Passkey) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Passkeys.AfterSelectHooks.RunHooks(ctx, exec, PasskeySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Passkeys.AfterInsertHooks.RunHooks(ctx, exec, PasskeySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Passkeys.AfterUpdateHooks.RunHooks(ctx, exec, PasskeySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Passkeys.AfterDeleteHooks.RunHooks(ctx, exec, PasskeySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Passkey
+func (o *Passkey) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Passkey) pkEQ() dialect.Expression {
+	return psql.Quote("passkeys", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Passkey
+func (o *Passkey) Update(ctx context.Context, exec bob.Executor, s *PasskeySetter) error {
+	v, err := Passkeys.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Passkey record with an executor
+func (o *Passkey) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Passkeys.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Passkey using the executor
+func (o *Passkey) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Passkeys.Query(
+		sm.Where(Passkeys.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after PasskeySlice is retrieved from the database
+func (o PasskeySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Passkeys.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Passkeys.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Passkeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Passkeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o PasskeySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("passkeys", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o PasskeySlice) copyMatchingRows(from ...*Passkey) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o PasskeySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Passkeys.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Passkey:
+				o.copyMatchingRows(retrieved)
+			case []*Passkey:
+				o.copyMatchingRows(retrieved...)
+			case PasskeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Passkey or a slice of Passkey
+				// then run the AfterUpdateHooks on the slice
+				_, err = Passkeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o PasskeySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Passkeys.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Passkey:
+				o.copyMatchingRows(retrieved)
+			case []*Passkey:
+				o.copyMatchingRows(retrieved...)
+			case PasskeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Passkey or a slice of Passkey
+				// then run the AfterDeleteHooks on the slice
+				_, err = Passkeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o PasskeySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals PasskeySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Passkeys.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o PasskeySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Passkeys.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o PasskeySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Passkeys.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *Passkey) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os PasskeySlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "uuid[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachPasskeyUser0(ctx context.Context, exec bob.Executor, count int, passkey0 *Passkey, user1 *User) (*Passkey, error) {
+	setter := &PasskeySetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := passkey0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachPasskeyUser0: %w", err)
+	}
+
+	return passkey0, nil
+}
+
+func (passkey0 *Passkey) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachPasskeyUser0(ctx, exec, 1, passkey0, user1)
+	if err != nil {
+		return err
+	}
+
+	passkey0.R.User = user1
+
+	user1.R.Passkeys = append(user1.R.Passkeys, passkey0)
+
+	return nil
+}
+
+func (passkey0 *Passkey) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachPasskeyUser0(ctx, exec, 1, passkey0, user1)
+	if err != nil {
+		return err
+	}
+
+	passkey0.R.User = user1
+
+	user1.R.Passkeys = append(user1.R.Passkeys, passkey0)
+
+	return nil
+}
+
+type passkeyWhere[Q psql.Filterable] struct {
+	ID              psql.WhereMod[Q, uuid.UUID]
+	UserID          psql.WhereMod[Q, uuid.UUID]
+	Name            psql.WhereMod[Q, string]
+	CredentialID    psql.WhereMod[Q, []byte]
+	PublicKey       psql.WhereMod[Q, []byte]
+	AttestationType psql.WhereMod[Q, string]
+	Aaguid          psql.WhereMod[Q, []byte]
+	SignCount       psql.WhereMod[Q, int64]
+	Transports      psql.WhereMod[Q, string]
+	BackupEligible  psql.WhereMod[Q, bool]
+	BackupState     psql.WhereMod[Q, bool]
+	LastUsedAt      psql.WhereNullMod[Q, time.Time]
+	CreatedAt       psql.WhereMod[Q, time.Time]
+}
+
+func (passkeyWhere[Q]) AliasedAs(alias string) passkeyWhere[Q] {
+	return buildPasskeyWhere[Q](buildPasskeyColumns(alias))
+}
+
+func buildPasskeyWhere[Q psql.Filterable](cols passkeyColumns) passkeyWhere[Q] {
+	return passkeyWhere[Q]{
+		ID:              psql.Where[Q, uuid.UUID](cols.ID),
+		UserID:          psql.Where[Q, uuid.UUID](cols.UserID),
+		Name:            psql.Where[Q, string](cols.Name),
+		CredentialID:    psql.Where[Q, []byte](cols.CredentialID),
+		PublicKey:       psql.Where[Q, []byte](cols.PublicKey),
+		AttestationType: psql.Where[Q, string](cols.AttestationType),
+		Aaguid:          psql.Where[Q, []byte](cols.Aaguid),
+		SignCount:       psql.Where[Q, int64](cols.SignCount),
+		Transports:      psql.Where[Q, string](cols.Transports),
+		BackupEligible:  psql.Where[Q, bool](cols.BackupEligible),
+		BackupState:     psql.Where[Q, bool](cols.BackupState),
+		LastUsedAt:      psql.WhereNull[Q, time.Time](cols.LastUsedAt),
+		CreatedAt:       psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Passkey) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("passkey cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Passkeys = PasskeySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("passkey has no relationship %q", name)
+	}
+}
+
+type passkeyPreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildPasskeyPreloader() passkeyPreloader {
+	return passkeyPreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Passkeys,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type passkeyThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildPasskeyThenLoader[Q orm.Loadable]() passkeyThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return passkeyThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the passkey's User into the .R struct
+func (o *Passkey) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Passkeys = PasskeySlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the passkey's User into the .R struct
+func (os PasskeySlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Passkeys = append(rel.R.Passkeys, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type passkeyJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j passkeyJoins[Q]) aliasedAs(alias string) passkeyJoins[Q] {
+	return buildPasskeyJoins[Q](buildPasskeyColumns(alias), j.typ)
+}
+
+func buildPasskeyJoins[Q dialect.Joinable](cols passkeyColumns, typ string) passkeyJoins[Q] {
+	return passkeyJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
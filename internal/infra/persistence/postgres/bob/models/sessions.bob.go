@@ -0,0 +1,1302 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Session is an object representing the database table.
+type Session struct {
+	ID         uuid.UUID           `db:"id,pk" `
+	UserID     uuid.UUID           `db:"user_id" `
+	UserAgent  string              `db:"user_agent" `
+	IP         string              `db:"ip" `
+	CreatedAt  time.Time           `db:"created_at" `
+	LastSeenAt time.Time           `db:"last_seen_at" `
+	RevokedAt  null.Val[time.Time] `db:"revoked_at" `
+
+	R sessionR `db:"-" `
+}
+
+// SessionSlice is an alias for a slice of pointers to Session.
+// This should almost always be used instead of []*Session.
+type SessionSlice []*Session
+
+// Sessions contains methods to work with the sessions table
+var Sessions = psql.NewTablex[*Session, SessionSlice, *SessionSetter]("", "sessions", buildSessionColumns("sessions"))
+
+// SessionsQuery is a query on the sessions table
+type SessionsQuery = *psql.ViewQuery[*Session, SessionSlice]
+
+// sessionR is where relationships are stored.
+type sessionR struct {
+	OauthAuthorizationCodes OauthAuthorizationCodeSlice // oauth_authorization_codes_session_id_fkey
+	OauthGrant              *OauthGrant                 // oauth_grants_session_id_fkey
+	RefreshTokens           RefreshTokenSlice           // refresh_tokens_session_id_fkey
+	User                    *User                       // sessions_user_id_fkey
+}
+
+func buildSessionColumns(alias string) sessionColumns {
+	return sessionColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "user_agent", "ip", "created_at", "last_seen_at", "revoked_at",
+		).WithParent("sessions"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		UserID:     psql.Quote(alias, "user_id"),
+		UserAgent:  psql.Quote(alias, "user_agent"),
+		IP:         psql.Quote(alias, "ip"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+		LastSeenAt: psql.Quote(alias, "last_seen_at"),
+		RevokedAt:  psql.Quote(alias, "revoked_at"),
+	}
+}
+
+type sessionColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	UserID     psql.Expression
+	UserAgent  psql.Expression
+	IP         psql.Expression
+	CreatedAt  psql.Expression
+	LastSeenAt psql.Expression
+	RevokedAt  psql.Expression
+}
+
+func (c sessionColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (sessionColumns) AliasedAs(alias string) sessionColumns {
+	return buildSessionColumns(alias)
+}
+
+// SessionSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type SessionSetter struct {
+	ID         omit.Val[uuid.UUID]     `db:"id,pk" `
+	UserID     omit.Val[uuid.UUID]     `db:"user_id" `
+	UserAgent  omit.Val[string]        `db:"user_agent" `
+	IP         omit.Val[string]        `db:"ip" `
+	CreatedAt  omit.Val[time.Time]     `db:"created_at" `
+	LastSeenAt omit.Val[time.Time]     `db:"last_seen_at" `
+	RevokedAt  omitnull.Val[time.Time] `db:"revoked_at" `
+}
+
+func (s SessionSetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.UserAgent.IsValue() {
+		vals = append(vals, "user_agent")
+	}
+	if s.IP.IsValue() {
+		vals = append(vals, "ip")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.LastSeenAt.IsValue() {
+		vals = append(vals, "last_seen_at")
+	}
+	if !s.RevokedAt.IsUnset() {
+		vals = append(vals, "revoked_at")
+	}
+	return vals
+}
+
+func (s SessionSetter) Overwrite(t *Session) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.UserAgent.IsValue() {
+		t.UserAgent = s.UserAgent.MustGet()
+	}
+	if s.IP.IsValue() {
+		t.IP = s.IP.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.LastSeenAt.IsValue() {
+		t.LastSeenAt = s.LastSeenAt.MustGet()
+	}
+	if !s.RevokedAt.IsUnset() {
+		t.RevokedAt = s.RevokedAt.MustGetNull()
+	}
+}
+
+func (s *SessionSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Sessions.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 7)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserAgent.IsValue() {
+			vals[2] = psql.Arg(s.UserAgent.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.IP.IsValue() {
+			vals[3] = psql.Arg(s.IP.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[4] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.LastSeenAt.IsValue() {
+			vals[5] = psql.Arg(s.LastSeenAt.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if !s.RevokedAt.IsUnset() {
+			vals[6] = psql.Arg(s.RevokedAt.MustGetNull())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s SessionSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s SessionSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.UserAgent.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_agent")...),
+			psql.Arg(s.UserAgent),
+		}})
+	}
+
+	if s.IP.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ip")...),
+			psql.Arg(s.IP),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.LastSeenAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_seen_at")...),
+			psql.Arg(s.LastSeenAt),
+		}})
+	}
+
+	if !s.RevokedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "revoked_at")...),
+			psql.Arg(s.RevokedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindSession retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindSession(ctx context.Context, exec bob.Executor, IDPK uuid.UUID, cols ...string) (*Session, error) {
+	if len(cols) == 0 {
+		return Sessions.Query(
+			sm.Where(Sessions.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Sessions.Query(
+		sm.Where(Sessions.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Sessions.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// SessionExists checks the presence of a single record by primary key
+func SessionExists(ctx context.Context, exec bob.Executor, IDPK uuid.UUID) (bool, error) {
+	return Sessions.Query(
+		sm.Where(Sessions.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Session is retrieved from the database
+func (o *Session) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Sessions.AfterSelectHooks.RunHooks(ctx, exec, SessionSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Sessions.AfterInsertHooks.RunHooks(ctx, exec, SessionSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Sessions.AfterUpdateHooks.RunHooks(ctx, exec, SessionSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Sessions.AfterDeleteHooks.RunHooks(ctx, exec, SessionSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Session
+func (o *Session) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Session) pkEQ() dialect.Expression {
+	return psql.Quote("sessions", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Session
+func (o *Session) Update(ctx context.Context, exec bob.Executor, s *SessionSetter) error {
+	v, err := Sessions.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Session record with an executor
+func (o *Session) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Sessions.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Session using the executor
+func (o *Session) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Sessions.Query(
+		sm.Where(Sessions.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after SessionSlice is retrieved from the database
+func (o SessionSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Sessions.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Sessions.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Sessions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Sessions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o SessionSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("sessions", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o SessionSlice) copyMatchingRows(from ...*Session) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o SessionSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Sessions.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Session:
+				o.copyMatchingRows(retrieved)
+			case []*Session:
+				o.copyMatchingRows(retrieved...)
+			case SessionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Session or a slice of Session
+				// then run the AfterUpdateHooks on the slice
+				_, err = Sessions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o SessionSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Sessions.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Session:
+				o.copyMatchingRows(retrieved)
+			case []*Session:
+				o.copyMatchingRows(retrieved...)
+			case SessionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Session or a slice of Session
+				// then run the AfterDeleteHooks on the slice
+				_, err = Sessions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o SessionSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals SessionSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Sessions.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o SessionSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Sessions.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o SessionSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Sessions.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// OauthAuthorizationCodes starts a query for related objects on oauth_authorization_codes
+func (o *Session) OauthAuthorizationCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthAuthorizationCodesQuery {
+	return OauthAuthorizationCodes.Query(append(mods,
+		sm.Where(OauthAuthorizationCodes.Columns.SessionID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os SessionSlice) OauthAuthorizationCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthAuthorizationCodesQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return OauthAuthorizationCodes.Query(append(mods,
+		sm.Where(psql.Group(OauthAuthorizationCodes.Columns.SessionID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// OauthGrant starts a query for related objects on oauth_grants
+func (o *Session) OauthGrant(mods ...bob.Mod[*dialect.SelectQuery]) OauthGrantsQuery {
+	return OauthGrants.Query(append(mods,
+		sm.Where(OauthGrants.Columns.SessionID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os SessionSlice) OauthGrant(mods ...bob.Mod[*dialect.SelectQuery]) OauthGrantsQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return OauthGrants.Query(append(mods,
+		sm.Where(psql.Group(OauthGrants.Columns.SessionID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// RefreshTokens starts a query for related objects on refresh_tokens
+func (o *Session) RefreshTokens(mods ...bob.Mod[*dialect.SelectQuery]) RefreshTokensQuery {
+	return RefreshTokens.Query(append(mods,
+		sm.Where(RefreshTokens.Columns.SessionID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os SessionSlice) RefreshTokens(mods ...bob.Mod[*dialect.SelectQuery]) RefreshTokensQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return RefreshTokens.Query(append(mods,
+		sm.Where(psql.Group(RefreshTokens.Columns.SessionID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *Session) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os SessionSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "uuid[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertSessionOauthAuthorizationCodes0(ctx context.Context, exec bob.Executor, oauthAuthorizationCodes1 []*OauthAuthorizationCodeSetter, session0 *Session) (OauthAuthorizationCodeSlice, error) {
+	for i := range oauthAuthorizationCodes1 {
+		oauthAuthorizationCodes1[i].SessionID = omitnull.From(session0.ID)
+	}
+
+	ret, err := OauthAuthorizationCodes.Insert(bob.ToMods(oauthAuthorizationCodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertSessionOauthAuthorizationCodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachSessionOauthAuthorizationCodes0(ctx context.Context, exec bob.Executor, count int, oauthAuthorizationCodes1 OauthAuthorizationCodeSlice, session0 *Session) (OauthAuthorizationCodeSlice, error) {
+	setter := &OauthAuthorizationCodeSetter{
+		SessionID: omitnull.From(session0.ID),
+	}
+
+	err := oauthAuthorizationCodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachSessionOauthAuthorizationCodes0: %w", err)
+	}
+
+	return oauthAuthorizationCodes1, nil
+}
+
+func (session0 *Session) InsertOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, related ...*OauthAuthorizationCodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthAuthorizationCodes1, err := insertSessionOauthAuthorizationCodes0(ctx, exec, related, session0)
+	if err != nil {
+		return err
+	}
+
+	session0.R.OauthAuthorizationCodes = append(session0.R.OauthAuthorizationCodes, oauthAuthorizationCodes1...)
+
+	for _, rel := range oauthAuthorizationCodes1 {
+		rel.R.Session = session0
+	}
+	return nil
+}
+
+func (session0 *Session) AttachOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, related ...*OauthAuthorizationCode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthAuthorizationCodes1 := OauthAuthorizationCodeSlice(related)
+
+	_, err = attachSessionOauthAuthorizationCodes0(ctx, exec, len(related), oauthAuthorizationCodes1, session0)
+	if err != nil {
+		return err
+	}
+
+	session0.R.OauthAuthorizationCodes = append(session0.R.OauthAuthorizationCodes, oauthAuthorizationCodes1...)
+
+	for _, rel := range related {
+		rel.R.Session = session0
+	}
+
+	return nil
+}
+
+func insertSessionOauthGrant0(ctx context.Context, exec bob.Executor, oauthGrant1 *OauthGrantSetter, session0 *Session) (*OauthGrant, error) {
+	oauthGrant1.SessionID = omit.From(session0.ID)
+
+	ret, err := OauthGrants.Insert(oauthGrant1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertSessionOauthGrant0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachSessionOauthGrant0(ctx context.Context, exec bob.Executor, count int, oauthGrant1 *OauthGrant, session0 *Session) (*OauthGrant, error) {
+	setter := &OauthGrantSetter{
+		SessionID: omit.From(session0.ID),
+	}
+
+	err := oauthGrant1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachSessionOauthGrant0: %w", err)
+	}
+
+	return oauthGrant1, nil
+}
+
+func (session0 *Session) InsertOauthGrant(ctx context.Context, exec bob.Executor, related *OauthGrantSetter) error {
+	var err error
+
+	oauthGrant1, err := insertSessionOauthGrant0(ctx, exec, related, session0)
+	if err != nil {
+		return err
+	}
+
+	session0.R.OauthGrant = oauthGrant1
+
+	oauthGrant1.R.Session = session0
+
+	return nil
+}
+
+func (session0 *Session) AttachOauthGrant(ctx context.Context, exec bob.Executor, oauthGrant1 *OauthGrant) error {
+	var err error
+
+	_, err = attachSessionOauthGrant0(ctx, exec, 1, oauthGrant1, session0)
+	if err != nil {
+		return err
+	}
+
+	session0.R.OauthGrant = oauthGrant1
+
+	oauthGrant1.R.Session = session0
+
+	return nil
+}
+
+func insertSessionRefreshTokens0(ctx context.Context, exec bob.Executor, refreshTokens1 []*RefreshTokenSetter, session0 *Session) (RefreshTokenSlice, error) {
+	for i := range refreshTokens1 {
+		refreshTokens1[i].SessionID = omit.From(session0.ID)
+	}
+
+	ret, err := RefreshTokens.Insert(bob.ToMods(refreshTokens1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertSessionRefreshTokens0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachSessionRefreshTokens0(ctx context.Context, exec bob.Executor, count int, refreshTokens1 RefreshTokenSlice, session0 *Session) (RefreshTokenSlice, error) {
+	setter := &RefreshTokenSetter{
+		SessionID: omit.From(session0.ID),
+	}
+
+	err := refreshTokens1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachSessionRefreshTokens0: %w", err)
+	}
+
+	return refreshTokens1, nil
+}
+
+func (session0 *Session) InsertRefreshTokens(ctx context.Context, exec bob.Executor, related ...*RefreshTokenSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	refreshTokens1, err := insertSessionRefreshTokens0(ctx, exec, related, session0)
+	if err != nil {
+		return err
+	}
+
+	session0.R.RefreshTokens = append(session0.R.RefreshTokens, refreshTokens1...)
+
+	for _, rel := range refreshTokens1 {
+		rel.R.Session = session0
+	}
+	return nil
+}
+
+func (session0 *Session) AttachRefreshTokens(ctx context.Context, exec bob.Executor, related ...*RefreshToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	refreshTokens1 := RefreshTokenSlice(related)
+
+	_, err = attachSessionRefreshTokens0(ctx, exec, len(related), refreshTokens1, session0)
+	if err != nil {
+		return err
+	}
+
+	session0.R.RefreshTokens = append(session0.R.RefreshTokens, refreshTokens1...)
+
+	for _, rel := range related {
+		rel.R.Session = session0
+	}
+
+	return nil
+}
+
+func attachSessionUser0(ctx context.Context, exec bob.Executor, count int, session0 *Session, user1 *User) (*Session, error) {
+	setter := &SessionSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := session0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachSessionUser0: %w", err)
+	}
+
+	return session0, nil
+}
+
+func (session0 *Session) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachSessionUser0(ctx, exec, 1, session0, user1)
+	if err != nil {
+		return err
+	}
+
+	session0.R.User = user1
+
+	user1.R.Sessions = append(user1.R.Sessions, session0)
+
+	return nil
+}
+
+func (session0 *Session) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachSessionUser0(ctx, exec, 1, session0, user1)
+	if err != nil {
+		return err
+	}
+
+	session0.R.User = user1
+
+	user1.R.Sessions = append(user1.R.Sessions, session0)
+
+	return nil
+}
+
+type sessionWhere[Q psql.Filterable] struct {
+	ID         psql.WhereMod[Q, uuid.UUID]
+	UserID     psql.WhereMod[Q, uuid.UUID]
+	UserAgent  psql.WhereMod[Q, string]
+	IP         psql.WhereMod[Q, string]
+	CreatedAt  psql.WhereMod[Q, time.Time]
+	LastSeenAt psql.WhereMod[Q, time.Time]
+	RevokedAt  psql.WhereNullMod[Q, time.Time]
+}
+
+func (sessionWhere[Q]) AliasedAs(alias string) sessionWhere[Q] {
+	return buildSessionWhere[Q](buildSessionColumns(alias))
+}
+
+func buildSessionWhere[Q psql.Filterable](cols sessionColumns) sessionWhere[Q] {
+	return sessionWhere[Q]{
+		ID:         psql.Where[Q, uuid.UUID](cols.ID),
+		UserID:     psql.Where[Q, uuid.UUID](cols.UserID),
+		UserAgent:  psql.Where[Q, string](cols.UserAgent),
+		IP:         psql.Where[Q, string](cols.IP),
+		CreatedAt:  psql.Where[Q, time.Time](cols.CreatedAt),
+		LastSeenAt: psql.Where[Q, time.Time](cols.LastSeenAt),
+		RevokedAt:  psql.WhereNull[Q, time.Time](cols.RevokedAt),
+	}
+}
+
+func (o *Session) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "OauthAuthorizationCodes":
+		rels, ok := retrieved.(OauthAuthorizationCodeSlice)
+		if !ok {
+			return fmt.Errorf("session cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OauthAuthorizationCodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Session = o
+			}
+		}
+		return nil
+	case "OauthGrant":
+		rel, ok := retrieved.(*OauthGrant)
+		if !ok {
+			return fmt.Errorf("session cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OauthGrant = rel
+
+		if rel != nil {
+			rel.R.Session = o
+		}
+		return nil
+	case "RefreshTokens":
+		rels, ok := retrieved.(RefreshTokenSlice)
+		if !ok {
+			return fmt.Errorf("session cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.RefreshTokens = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Session = o
+			}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("session cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Sessions = SessionSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("session has no relationship %q", name)
+	}
+}
+
+type sessionPreloader struct {
+	OauthGrant func(...psql.PreloadOption) psql.Preloader
+	User       func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildSessionPreloader() sessionPreloader {
+	return sessionPreloader{
+		OauthGrant: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*OauthGrant, OauthGrantSlice](psql.PreloadRel{
+				Name: "OauthGrant",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Sessions,
+						To:          OauthGrants,
+						FromColumns: []string{"id"},
+						ToColumns:   []string{"session_id"},
+					},
+				},
+			}, OauthGrants.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Sessions,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type sessionThenLoader[Q orm.Loadable] struct {
+	OauthAuthorizationCodes func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OauthGrant              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	RefreshTokens           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User                    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildSessionThenLoader[Q orm.Loadable]() sessionThenLoader[Q] {
+	type OauthAuthorizationCodesLoadInterface interface {
+		LoadOauthAuthorizationCodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OauthGrantLoadInterface interface {
+		LoadOauthGrant(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type RefreshTokensLoadInterface interface {
+		LoadRefreshTokens(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return sessionThenLoader[Q]{
+		OauthAuthorizationCodes: thenLoadBuilder[Q](
+			"OauthAuthorizationCodes",
+			func(ctx context.Context, exec bob.Executor, retrieved OauthAuthorizationCodesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOauthAuthorizationCodes(ctx, exec, mods...)
+			},
+		),
+		OauthGrant: thenLoadBuilder[Q](
+			"OauthGrant",
+			func(ctx context.Context, exec bob.Executor, retrieved OauthGrantLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOauthGrant(ctx, exec, mods...)
+			},
+		),
+		RefreshTokens: thenLoadBuilder[Q](
+			"RefreshTokens",
+			func(ctx context.Context, exec bob.Executor, retrieved RefreshTokensLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadRefreshTokens(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadOauthAuthorizationCodes loads the session's OauthAuthorizationCodes into the .R struct
+func (o *Session) LoadOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OauthAuthorizationCodes = nil
+
+	related, err := o.OauthAuthorizationCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Session = o
+	}
+
+	o.R.OauthAuthorizationCodes = related
+	return nil
+}
+
+// LoadOauthAuthorizationCodes loads the session's OauthAuthorizationCodes into the .R struct
+func (os SessionSlice) LoadOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthAuthorizationCodes, err := os.OauthAuthorizationCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.OauthAuthorizationCodes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthAuthorizationCodes {
+
+			if !rel.SessionID.IsValue() {
+				continue
+			}
+			if !(rel.SessionID.IsValue() && o.ID == rel.SessionID.MustGet()) {
+				continue
+			}
+
+			rel.R.Session = o
+
+			o.R.OauthAuthorizationCodes = append(o.R.OauthAuthorizationCodes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadOauthGrant loads the session's OauthGrant into the .R struct
+func (o *Session) LoadOauthGrant(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OauthGrant = nil
+
+	related, err := o.OauthGrant(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Session = o
+
+	o.R.OauthGrant = related
+	return nil
+}
+
+// LoadOauthGrant loads the session's OauthGrant into the .R struct
+func (os SessionSlice) LoadOauthGrant(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthGrants, err := os.OauthGrant(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthGrants {
+
+			if !(o.ID == rel.SessionID) {
+				continue
+			}
+
+			rel.R.Session = o
+
+			o.R.OauthGrant = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens loads the session's RefreshTokens into the .R struct
+func (o *Session) LoadRefreshTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.RefreshTokens = nil
+
+	related, err := o.RefreshTokens(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Session = o
+	}
+
+	o.R.RefreshTokens = related
+	return nil
+}
+
+// LoadRefreshTokens loads the session's RefreshTokens into the .R struct
+func (os SessionSlice) LoadRefreshTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	refreshTokens, err := os.RefreshTokens(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.RefreshTokens = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range refreshTokens {
+
+			if !(o.ID == rel.SessionID) {
+				continue
+			}
+
+			rel.R.Session = o
+
+			o.R.RefreshTokens = append(o.R.RefreshTokens, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the session's User into the .R struct
+func (o *Session) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Sessions = SessionSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the session's User into the .R struct
+func (os SessionSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Sessions = append(rel.R.Sessions, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type sessionJoins[Q dialect.Joinable] struct {
+	typ                     string
+	OauthAuthorizationCodes modAs[Q, oauthAuthorizationCodeColumns]
+	OauthGrant              modAs[Q, oauthGrantColumns]
+	RefreshTokens           modAs[Q, refreshTokenColumns]
+	User                    modAs[Q, userColumns]
+}
+
+func (j sessionJoins[Q]) aliasedAs(alias string) sessionJoins[Q] {
+	return buildSessionJoins[Q](buildSessionColumns(alias), j.typ)
+}
+
+func buildSessionJoins[Q dialect.Joinable](cols sessionColumns, typ string) sessionJoins[Q] {
+	return sessionJoins[Q]{
+		typ: typ,
+		OauthAuthorizationCodes: modAs[Q, oauthAuthorizationCodeColumns]{
+			c: OauthAuthorizationCodes.Columns,
+			f: func(to oauthAuthorizationCodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthAuthorizationCodes.Name().As(to.Alias())).On(
+						to.SessionID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OauthGrant: modAs[Q, oauthGrantColumns]{
+			c: OauthGrants.Columns,
+			f: func(to oauthGrantColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthGrants.Name().As(to.Alias())).On(
+						to.SessionID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		RefreshTokens: modAs[Q, refreshTokenColumns]{
+			c: RefreshTokens.Columns,
+			f: func(to refreshTokenColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, RefreshTokens.Name().As(to.Alias())).On(
+						to.SessionID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
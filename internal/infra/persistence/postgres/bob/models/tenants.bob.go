@@ -0,0 +1,400 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// Tenant is an object representing the database table.
+type Tenant struct {
+	ID        uuid.UUID `db:"id,pk" `
+	Name      string    `db:"name" `
+	CreatedAt time.Time `db:"created_at" `
+}
+
+// TenantSlice is an alias for a slice of pointers to Tenant.
+// This should almost always be used instead of []*Tenant.
+type TenantSlice []*Tenant
+
+// Tenants contains methods to work with the tenants table
+var Tenants = psql.NewTablex[*Tenant, TenantSlice, *TenantSetter]("", "tenants", buildTenantColumns("tenants"))
+
+// TenantsQuery is a query on the tenants table
+type TenantsQuery = *psql.ViewQuery[*Tenant, TenantSlice]
+
+func buildTenantColumns(alias string) tenantColumns {
+	return tenantColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "name", "created_at",
+		).WithParent("tenants"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		Name:       psql.Quote(alias, "name"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type tenantColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	Name       psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c tenantColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (tenantColumns) AliasedAs(alias string) tenantColumns {
+	return buildTenantColumns(alias)
+}
+
+// TenantSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type TenantSetter struct {
+	ID        omit.Val[uuid.UUID] `db:"id,pk" `
+	Name      omit.Val[string]    `db:"name" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+}
+
+func (s TenantSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s TenantSetter) Overwrite(t *Tenant) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *TenantSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Tenants.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name.IsValue() {
+			vals[1] = psql.Arg(s.Name.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s TenantSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s TenantSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindTenant retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindTenant(ctx context.Context, exec bob.Executor, IDPK uuid.UUID, cols ...string) (*Tenant, error) {
+	if len(cols) == 0 {
+		return Tenants.Query(
+			sm.Where(Tenants.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Tenants.Query(
+		sm.Where(Tenants.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Tenants.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// TenantExists checks the presence of a single record by primary key
+func TenantExists(ctx context.Context, exec bob.Executor, IDPK uuid.UUID) (bool, error) {
+	return Tenants.Query(
+		sm.Where(Tenants.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Tenant is retrieved from the database
+func (o *Tenant) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Tenants.AfterSelectHooks.RunHooks(ctx, exec, TenantSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Tenants.AfterInsertHooks.RunHooks(ctx, exec, TenantSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Tenants.AfterUpdateHooks.RunHooks(ctx, exec, TenantSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Tenants.AfterDeleteHooks.RunHooks(ctx, exec, TenantSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Tenant
+func (o *Tenant) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Tenant) pkEQ() dialect.Expression {
+	return psql.Quote("tenants", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Tenant
+func (o *Tenant) Update(ctx context.Context, exec bob.Executor, s *TenantSetter) error {
+	v, err := Tenants.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Tenant record with an executor
+func (o *Tenant) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Tenants.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Tenant using the executor
+func (o *Tenant) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Tenants.Query(
+		sm.Where(Tenants.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after TenantSlice is retrieved from the database
+func (o TenantSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Tenants.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Tenants.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Tenants.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Tenants.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o TenantSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("tenants", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o TenantSlice) copyMatchingRows(from ...*Tenant) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o TenantSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Tenants.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Tenant:
+				o.copyMatchingRows(retrieved)
+			case []*Tenant:
+				o.copyMatchingRows(retrieved...)
+			case TenantSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Tenant or a slice of Tenant
+				// then run the AfterUpdateHooks on the slice
+				_, err = Tenants.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o TenantSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Tenants.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Tenant:
+				o.copyMatchingRows(retrieved)
+			case []*Tenant:
+				o.copyMatchingRows(retrieved...)
+			case TenantSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Tenant or a slice of Tenant
+				// then run the AfterDeleteHooks on the slice
+				_, err = Tenants.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o TenantSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals TenantSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Tenants.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o TenantSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Tenants.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o TenantSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Tenants.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type tenantWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, uuid.UUID]
+	Name      psql.WhereMod[Q, string]
+	CreatedAt psql.WhereMod[Q, time.Time]
+}
+
+func (tenantWhere[Q]) AliasedAs(alias string) tenantWhere[Q] {
+	return buildTenantWhere[Q](buildTenantColumns(alias))
+}
+
+func buildTenantWhere[Q psql.Filterable](cols tenantColumns) tenantWhere[Q] {
+	return tenantWhere[Q]{
+		ID:        psql.Where[Q, uuid.UUID](cols.ID),
+		Name:      psql.Where[Q, string](cols.Name),
+		CreatedAt: psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
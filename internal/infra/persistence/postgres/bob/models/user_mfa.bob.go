@@ -0,0 +1,681 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// UserMfa is an object representing the database table.
+type UserMfa struct {
+	UserID       uuid.UUID           `db:"user_id,pk" `
+	Secret       string              `db:"secret" `
+	LastUsedStep int64               `db:"last_used_step" `
+	EnabledAt    null.Val[time.Time] `db:"enabled_at" `
+	CreatedAt    time.Time           `db:"created_at" `
+
+	R userMfaR `db:"-" `
+}
+
+// UserMfaSlice is an alias for a slice of pointers to UserMfa.
+// This should almost always be used instead of []*UserMfa.
+type UserMfaSlice []*UserMfa
+
+// UserMfas contains methods to work with the user_mfa table
+var UserMfas = psql.NewTablex[*UserMfa, UserMfaSlice, *UserMfaSetter]("", "user_mfa", buildUserMfaColumns("user_mfa"))
+
+// UserMfasQuery is a query on the user_mfa table
+type UserMfasQuery = *psql.ViewQuery[*UserMfa, UserMfaSlice]
+
+// userMfaR is where relationships are stored.
+type userMfaR struct {
+	User *User // user_mfa_user_id_fkey
+}
+
+func buildUserMfaColumns(alias string) userMfaColumns {
+	return userMfaColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"user_id", "secret", "last_used_step", "enabled_at", "created_at",
+		).WithParent("user_mfa"),
+		tableAlias:   alias,
+		UserID:       psql.Quote(alias, "user_id"),
+		Secret:       psql.Quote(alias, "secret"),
+		LastUsedStep: psql.Quote(alias, "last_used_step"),
+		EnabledAt:    psql.Quote(alias, "enabled_at"),
+		CreatedAt:    psql.Quote(alias, "created_at"),
+	}
+}
+
+type userMfaColumns struct {
+	expr.ColumnsExpr
+	tableAlias   string
+	UserID       psql.Expression
+	Secret       psql.Expression
+	LastUsedStep psql.Expression
+	EnabledAt    psql.Expression
+	CreatedAt    psql.Expression
+}
+
+func (c userMfaColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (userMfaColumns) AliasedAs(alias string) userMfaColumns {
+	return buildUserMfaColumns(alias)
+}
+
+// UserMfaSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type UserMfaSetter struct {
+	UserID       omit.Val[uuid.UUID]     `db:"user_id,pk" `
+	Secret       omit.Val[string]        `db:"secret" `
+	LastUsedStep omit.Val[int64]         `db:"last_used_step" `
+	EnabledAt    omitnull.Val[time.Time] `db:"enabled_at" `
+	CreatedAt    omit.Val[time.Time]     `db:"created_at" `
+}
+
+func (s UserMfaSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Secret.IsValue() {
+		vals = append(vals, "secret")
+	}
+	if s.LastUsedStep.IsValue() {
+		vals = append(vals, "last_used_step")
+	}
+	if !s.EnabledAt.IsUnset() {
+		vals = append(vals, "enabled_at")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s UserMfaSetter) Overwrite(t *UserMfa) {
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Secret.IsValue() {
+		t.Secret = s.Secret.MustGet()
+	}
+	if s.LastUsedStep.IsValue() {
+		t.LastUsedStep = s.LastUsedStep.MustGet()
+	}
+	if !s.EnabledAt.IsUnset() {
+		t.EnabledAt = s.EnabledAt.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *UserMfaSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return UserMfas.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 5)
+		if s.UserID.IsValue() {
+			vals[0] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Secret.IsValue() {
+			vals[1] = psql.Arg(s.Secret.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.LastUsedStep.IsValue() {
+			vals[2] = psql.Arg(s.LastUsedStep.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.EnabledAt.IsUnset() {
+			vals[3] = psql.Arg(s.EnabledAt.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[4] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s UserMfaSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s UserMfaSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Secret.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "secret")...),
+			psql.Arg(s.Secret),
+		}})
+	}
+
+	if s.LastUsedStep.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_used_step")...),
+			psql.Arg(s.LastUsedStep),
+		}})
+	}
+
+	if !s.EnabledAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "enabled_at")...),
+			psql.Arg(s.EnabledAt),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindUserMfa retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindUserMfa(ctx context.Context, exec bob.Executor, UserIDPK uuid.UUID, cols ...string) (*UserMfa, error) {
+	if len(cols) == 0 {
+		return UserMfas.Query(
+			sm.Where(UserMfas.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+		).One(ctx, exec)
+	}
+
+	return UserMfas.Query(
+		sm.Where(UserMfas.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+		sm.Columns(UserMfas.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// UserMfaExists checks the presence of a single record by primary key
+func UserMfaExists(ctx context.Context, exec bob.Executor, UserIDPK uuid.UUID) (bool, error) {
+	return UserMfas.Query(
+		sm.Where(UserMfas.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after UserMfa is retrieved from the database
+func (o *UserMfa) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = UserMfas.AfterSelectHooks.RunHooks(ctx, exec, UserMfaSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = UserMfas.AfterInsertHooks.RunHooks(ctx, exec, UserMfaSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = UserMfas.AfterUpdateHooks.RunHooks(ctx, exec, UserMfaSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = UserMfas.AfterDeleteHooks.RunHooks(ctx, exec, UserMfaSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the UserMfa
+func (o *UserMfa) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.UserID)
+}
+
+func (o *UserMfa) pkEQ() dialect.Expression {
+	return psql.Quote("user_mfa", "user_id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the UserMfa
+func (o *UserMfa) Update(ctx context.Context, exec bob.Executor, s *UserMfaSetter) error {
+	v, err := UserMfas.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single UserMfa record with an executor
+func (o *UserMfa) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := UserMfas.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the UserMfa using the executor
+func (o *UserMfa) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := UserMfas.Query(
+		sm.Where(UserMfas.Columns.UserID.EQ(psql.Arg(o.UserID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after UserMfaSlice is retrieved from the database
+func (o UserMfaSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = UserMfas.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = UserMfas.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = UserMfas.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = UserMfas.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o UserMfaSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("user_mfa", "user_id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o UserMfaSlice) copyMatchingRows(from ...*UserMfa) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.UserID != old.UserID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o UserMfaSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return UserMfas.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *UserMfa:
+				o.copyMatchingRows(retrieved)
+			case []*UserMfa:
+				o.copyMatchingRows(retrieved...)
+			case UserMfaSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a UserMfa or a slice of UserMfa
+				// then run the AfterUpdateHooks on the slice
+				_, err = UserMfas.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o UserMfaSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return UserMfas.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *UserMfa:
+				o.copyMatchingRows(retrieved)
+			case []*UserMfa:
+				o.copyMatchingRows(retrieved...)
+			case UserMfaSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a UserMfa or a slice of UserMfa
+				// then run the AfterDeleteHooks on the slice
+				_, err = UserMfas.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o UserMfaSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals UserMfaSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := UserMfas.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o UserMfaSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := UserMfas.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o UserMfaSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := UserMfas.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *UserMfa) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os UserMfaSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "uuid[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachUserMfaUser0(ctx context.Context, exec bob.Executor, count int, userMfa0 *UserMfa, user1 *User) (*UserMfa, error) {
+	setter := &UserMfaSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := userMfa0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserMfaUser0: %w", err)
+	}
+
+	return userMfa0, nil
+}
+
+func (userMfa0 *UserMfa) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachUserMfaUser0(ctx, exec, 1, userMfa0, user1)
+	if err != nil {
+		return err
+	}
+
+	userMfa0.R.User = user1
+
+	user1.R.UserMfa = userMfa0
+
+	return nil
+}
+
+func (userMfa0 *UserMfa) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachUserMfaUser0(ctx, exec, 1, userMfa0, user1)
+	if err != nil {
+		return err
+	}
+
+	userMfa0.R.User = user1
+
+	user1.R.UserMfa = userMfa0
+
+	return nil
+}
+
+type userMfaWhere[Q psql.Filterable] struct {
+	UserID       psql.WhereMod[Q, uuid.UUID]
+	Secret       psql.WhereMod[Q, string]
+	LastUsedStep psql.WhereMod[Q, int64]
+	EnabledAt    psql.WhereNullMod[Q, time.Time]
+	CreatedAt    psql.WhereMod[Q, time.Time]
+}
+
+func (userMfaWhere[Q]) AliasedAs(alias string) userMfaWhere[Q] {
+	return buildUserMfaWhere[Q](buildUserMfaColumns(alias))
+}
+
+func buildUserMfaWhere[Q psql.Filterable](cols userMfaColumns) userMfaWhere[Q] {
+	return userMfaWhere[Q]{
+		UserID:       psql.Where[Q, uuid.UUID](cols.UserID),
+		Secret:       psql.Where[Q, string](cols.Secret),
+		LastUsedStep: psql.Where[Q, int64](cols.LastUsedStep),
+		EnabledAt:    psql.WhereNull[Q, time.Time](cols.EnabledAt),
+		CreatedAt:    psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *UserMfa) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("userMfa cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.UserMfa = o
+		}
+		return nil
+	default:
+		return fmt.Errorf("userMfa has no relationship %q", name)
+	}
+}
+
+type userMfaPreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildUserMfaPreloader() userMfaPreloader {
+	return userMfaPreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        UserMfas,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type userMfaThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserMfaThenLoader[Q orm.Loadable]() userMfaThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userMfaThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the userMfa's User into the .R struct
+func (o *UserMfa) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.UserMfa = o
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the userMfa's User into the .R struct
+func (os UserMfaSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.UserMfa = o
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type userMfaJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j userMfaJoins[Q]) aliasedAs(alias string) userMfaJoins[Q] {
+	return buildUserMfaJoins[Q](buildUserMfaColumns(alias), j.typ)
+}
+
+func buildUserMfaJoins[Q dialect.Joinable](cols userMfaColumns, typ string) userMfaJoins[Q] {
+	return userMfaJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,756 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// UserToken is an object representing the database table.
+type UserToken struct {
+	ID        uuid.UUID           `db:"id,pk" `
+	UserID    uuid.UUID           `db:"user_id" `
+	Purpose   string              `db:"purpose" `
+	TokenHash string              `db:"token_hash" `
+	ExpiresAt time.Time           `db:"expires_at" `
+	UsedAt    null.Val[time.Time] `db:"used_at" `
+	CreatedAt time.Time           `db:"created_at" `
+	Email     null.Val[string]    `db:"email" `
+
+	R userTokenR `db:"-" `
+}
+
+// UserTokenSlice is an alias for a slice of pointers to UserToken.
+// This should almost always be used instead of []*UserToken.
+type UserTokenSlice []*UserToken
+
+// UserTokens contains methods to work with the user_tokens table
+var UserTokens = psql.NewTablex[*UserToken, UserTokenSlice, *UserTokenSetter]("", "user_tokens", buildUserTokenColumns("user_tokens"))
+
+// UserTokensQuery is a query on the user_tokens table
+type UserTokensQuery = *psql.ViewQuery[*UserToken, UserTokenSlice]
+
+// userTokenR is where relationships are stored.
+type userTokenR struct {
+	User *User // user_tokens_user_id_fkey
+}
+
+func buildUserTokenColumns(alias string) userTokenColumns {
+	return userTokenColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "purpose", "token_hash", "expires_at", "used_at", "created_at", "email",
+		).WithParent("user_tokens"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		UserID:     psql.Quote(alias, "user_id"),
+		Purpose:    psql.Quote(alias, "purpose"),
+		TokenHash:  psql.Quote(alias, "token_hash"),
+		ExpiresAt:  psql.Quote(alias, "expires_at"),
+		UsedAt:     psql.Quote(alias, "used_at"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+		Email:      psql.Quote(alias, "email"),
+	}
+}
+
+type userTokenColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	UserID     psql.Expression
+	Purpose    psql.Expression
+	TokenHash  psql.Expression
+	ExpiresAt  psql.Expression
+	UsedAt     psql.Expression
+	CreatedAt  psql.Expression
+	Email      psql.Expression
+}
+
+func (c userTokenColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (userTokenColumns) AliasedAs(alias string) userTokenColumns {
+	return buildUserTokenColumns(alias)
+}
+
+// UserTokenSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type UserTokenSetter struct {
+	ID        omit.Val[uuid.UUID]     `db:"id,pk" `
+	UserID    omit.Val[uuid.UUID]     `db:"user_id" `
+	Purpose   omit.Val[string]        `db:"purpose" `
+	TokenHash omit.Val[string]        `db:"token_hash" `
+	ExpiresAt omit.Val[time.Time]     `db:"expires_at" `
+	UsedAt    omitnull.Val[time.Time] `db:"used_at" `
+	CreatedAt omit.Val[time.Time]     `db:"created_at" `
+	Email     omitnull.Val[string]    `db:"email" `
+}
+
+func (s UserTokenSetter) SetColumns() []string {
+	vals := make([]string, 0, 8)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Purpose.IsValue() {
+		vals = append(vals, "purpose")
+	}
+	if s.TokenHash.IsValue() {
+		vals = append(vals, "token_hash")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if !s.UsedAt.IsUnset() {
+		vals = append(vals, "used_at")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if !s.Email.IsUnset() {
+		vals = append(vals, "email")
+	}
+	return vals
+}
+
+func (s UserTokenSetter) Overwrite(t *UserToken) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Purpose.IsValue() {
+		t.Purpose = s.Purpose.MustGet()
+	}
+	if s.TokenHash.IsValue() {
+		t.TokenHash = s.TokenHash.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if !s.UsedAt.IsUnset() {
+		t.UsedAt = s.UsedAt.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if !s.Email.IsUnset() {
+		t.Email = s.Email.MustGetNull()
+	}
+}
+
+func (s *UserTokenSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return UserTokens.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 8)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Purpose.IsValue() {
+			vals[2] = psql.Arg(s.Purpose.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.TokenHash.IsValue() {
+			vals[3] = psql.Arg(s.TokenHash.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals[4] = psql.Arg(s.ExpiresAt.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UsedAt.IsUnset() {
+			vals[5] = psql.Arg(s.UsedAt.MustGetNull())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[6] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.Email.IsUnset() {
+			vals[7] = psql.Arg(s.Email.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s UserTokenSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s UserTokenSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 8)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Purpose.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "purpose")...),
+			psql.Arg(s.Purpose),
+		}})
+	}
+
+	if s.TokenHash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "token_hash")...),
+			psql.Arg(s.TokenHash),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "expires_at")...),
+			psql.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if !s.UsedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "used_at")...),
+			psql.Arg(s.UsedAt),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.Email.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "email")...),
+			psql.Arg(s.Email),
+		}})
+	}
+
+	return exprs
+}
+
+// FindUserToken retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindUserToken(ctx context.Context, exec bob.Executor, IDPK uuid.UUID, cols ...string) (*UserToken, error) {
+	if len(cols) == 0 {
+		return UserTokens.Query(
+			sm.Where(UserTokens.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return UserTokens.Query(
+		sm.Where(UserTokens.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(UserTokens.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// UserTokenExists checks the presence of a single record by primary key
+func UserTokenExists(ctx context.Context, exec bob.Executor, IDPK uuid.UUID) (bool, error) {
+	return UserTokens.Query(
+		sm.Where(UserTokens.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after UserToken is retrieved from the database
+func (o *UserToken) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = UserTokens.AfterSelectHooks.RunHooks(ctx, exec, UserTokenSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = UserTokens.AfterInsertHooks.RunHooks(ctx, exec, UserTokenSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = UserTokens.AfterUpdateHooks.RunHooks(ctx, exec, UserTokenSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = UserTokens.AfterDeleteHooks.RunHooks(ctx, exec, UserTokenSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the UserToken
+func (o *UserToken) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *UserToken) pkEQ() dialect.Expression {
+	return psql.Quote("user_tokens", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the UserToken
+func (o *UserToken) Update(ctx context.Context, exec bob.Executor, s *UserTokenSetter) error {
+	v, err := UserTokens.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single UserToken record with an executor
+func (o *UserToken) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := UserTokens.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the UserToken using the executor
+func (o *UserToken) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := UserTokens.Query(
+		sm.Where(UserTokens.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after UserTokenSlice is retrieved from the database
+func (o UserTokenSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = UserTokens.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = UserTokens.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = UserTokens.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = UserTokens.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o UserTokenSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("user_tokens", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o UserTokenSlice) copyMatchingRows(from ...*UserToken) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o UserTokenSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return UserTokens.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *UserToken:
+				o.copyMatchingRows(retrieved)
+			case []*UserToken:
+				o.copyMatchingRows(retrieved...)
+			case UserTokenSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a UserToken or a slice of UserToken
+				// then run the AfterUpdateHooks on the slice
+				_, err = UserTokens.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o UserTokenSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return UserTokens.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *UserToken:
+				o.copyMatchingRows(retrieved)
+			case []*UserToken:
+				o.copyMatchingRows(retrieved...)
+			case UserTokenSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a UserToken or a slice of UserToken
+				// then run the AfterDeleteHooks on the slice
+				_, err = UserTokens.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o UserTokenSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals UserTokenSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := UserTokens.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o UserTokenSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := UserTokens.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o UserTokenSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := UserTokens.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *UserToken) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os UserTokenSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "uuid[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachUserTokenUser0(ctx context.Context, exec bob.Executor, count int, userToken0 *UserToken, user1 *User) (*UserToken, error) {
+	setter := &UserTokenSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := userToken0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTokenUser0: %w", err)
+	}
+
+	return userToken0, nil
+}
+
+func (userToken0 *UserToken) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachUserTokenUser0(ctx, exec, 1, userToken0, user1)
+	if err != nil {
+		return err
+	}
+
+	userToken0.R.User = user1
+
+	user1.R.UserTokens = append(user1.R.UserTokens, userToken0)
+
+	return nil
+}
+
+func (userToken0 *UserToken) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachUserTokenUser0(ctx, exec, 1, userToken0, user1)
+	if err != nil {
+		return err
+	}
+
+	userToken0.R.User = user1
+
+	user1.R.UserTokens = append(user1.R.UserTokens, userToken0)
+
+	return nil
+}
+
+type userTokenWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, uuid.UUID]
+	UserID    psql.WhereMod[Q, uuid.UUID]
+	Purpose   psql.WhereMod[Q, string]
+	TokenHash psql.WhereMod[Q, string]
+	ExpiresAt psql.WhereMod[Q, time.Time]
+	UsedAt    psql.WhereNullMod[Q, time.Time]
+	CreatedAt psql.WhereMod[Q, time.Time]
+	Email     psql.WhereNullMod[Q, string]
+}
+
+func (userTokenWhere[Q]) AliasedAs(alias string) userTokenWhere[Q] {
+	return buildUserTokenWhere[Q](buildUserTokenColumns(alias))
+}
+
+func buildUserTokenWhere[Q psql.Filterable](cols userTokenColumns) userTokenWhere[Q] {
+	return userTokenWhere[Q]{
+		ID:        psql.Where[Q, uuid.UUID](cols.ID),
+		UserID:    psql.Where[Q, uuid.UUID](cols.UserID),
+		Purpose:   psql.Where[Q, string](cols.Purpose),
+		TokenHash: psql.Where[Q, string](cols.TokenHash),
+		ExpiresAt: psql.Where[Q, time.Time](cols.ExpiresAt),
+		UsedAt:    psql.WhereNull[Q, time.Time](cols.UsedAt),
+		CreatedAt: psql.Where[Q, time.Time](cols.CreatedAt),
+		Email:     psql.WhereNull[Q, string](cols.Email),
+	}
+}
+
+func (o *UserToken) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("userToken cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.UserTokens = UserTokenSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("userToken has no relationship %q", name)
+	}
+}
+
+type userTokenPreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildUserTokenPreloader() userTokenPreloader {
+	return userTokenPreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        UserTokens,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type userTokenThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserTokenThenLoader[Q orm.Loadable]() userTokenThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userTokenThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the userToken's User into the .R struct
+func (o *UserToken) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.UserTokens = UserTokenSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the userToken's User into the .R struct
+func (os UserTokenSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.UserTokens = append(rel.R.UserTokens, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type userTokenJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j userTokenJoins[Q]) aliasedAs(alias string) userTokenJoins[Q] {
+	return buildUserTokenJoins[Q](buildUserTokenColumns(alias), j.typ)
+}
+
+func buildUserTokenJoins[Q dialect.Joinable](cols userTokenColumns, typ string) userTokenJoins[Q] {
+	return userTokenJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
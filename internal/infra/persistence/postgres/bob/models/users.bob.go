@@ -5,10 +5,13 @@ package models
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
+	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/google/uuid"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
@@ -17,16 +20,22 @@ import (
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
 )
 
 // User is an object representing the database table.
 type User struct {
-	ID           uuid.UUID `db:"id,pk" `
-	Name         string    `db:"name" `
-	Email        string    `db:"email" `
-	PasswordHash string    `db:"password_hash" `
-	CreatedAt    time.Time `db:"created_at" `
-	UpdatedAt    time.Time `db:"updated_at" `
+	ID              uuid.UUID           `db:"id,pk" `
+	Name            string              `db:"name" `
+	Email           string              `db:"email" `
+	PasswordHash    string              `db:"password_hash" `
+	CreatedAt       time.Time           `db:"created_at" `
+	UpdatedAt       time.Time           `db:"updated_at" `
+	EmailVerifiedAt null.Val[time.Time] `db:"email_verified_at" `
+
+	R userR `db:"-" `
 }
 
 // UserSlice is an alias for a slice of pointers to User.
@@ -39,30 +48,47 @@ var Users = psql.NewTablex[*User, UserSlice, *UserSetter]("", "users", buildUser
 // UsersQuery is a query on the users table
 type UsersQuery = *psql.ViewQuery[*User, UserSlice]
 
+// userR is where relationships are stored.
+type userR struct {
+	APIKeys                 APIKeySlice                 // api_keys_user_id_fkey
+	ExternalIdentities      ExternalIdentitySlice       // external_identities_user_id_fkey
+	MfaRecoveryCodes        MfaRecoveryCodeSlice        // mfa_recovery_codes_user_id_fkey
+	OauthAuthorizationCodes OauthAuthorizationCodeSlice // oauth_authorization_codes_user_id_fkey
+	Passkeys                PasskeySlice                // passkeys_user_id_fkey
+	PasswordHistories       PasswordHistorySlice        // password_history_user_id_fkey
+	RefreshTokens           RefreshTokenSlice           // refresh_tokens_user_id_fkey
+	Sessions                SessionSlice                // sessions_user_id_fkey
+	UserMfa                 *UserMfa                    // user_mfa_user_id_fkey
+	UserTokens              UserTokenSlice              // user_tokens_user_id_fkey
+	WebauthnSessions        WebauthnSessionSlice        // webauthn_sessions_user_id_fkey
+}
+
 func buildUserColumns(alias string) userColumns {
 	return userColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "name", "email", "password_hash", "created_at", "updated_at",
+			"id", "name", "email", "password_hash", "created_at", "updated_at", "email_verified_at",
 		).WithParent("users"),
-		tableAlias:   alias,
-		ID:           psql.Quote(alias, "id"),
-		Name:         psql.Quote(alias, "name"),
-		Email:        psql.Quote(alias, "email"),
-		PasswordHash: psql.Quote(alias, "password_hash"),
-		CreatedAt:    psql.Quote(alias, "created_at"),
-		UpdatedAt:    psql.Quote(alias, "updated_at"),
+		tableAlias:      alias,
+		ID:              psql.Quote(alias, "id"),
+		Name:            psql.Quote(alias, "name"),
+		Email:           psql.Quote(alias, "email"),
+		PasswordHash:    psql.Quote(alias, "password_hash"),
+		CreatedAt:       psql.Quote(alias, "created_at"),
+		UpdatedAt:       psql.Quote(alias, "updated_at"),
+		EmailVerifiedAt: psql.Quote(alias, "email_verified_at"),
 	}
 }
 
 type userColumns struct {
 	expr.ColumnsExpr
-	tableAlias   string
-	ID           psql.Expression
-	Name         psql.Expression
-	Email        psql.Expression
-	PasswordHash psql.Expression
-	CreatedAt    psql.Expression
-	UpdatedAt    psql.Expression
+	tableAlias      string
+	ID              psql.Expression
+	Name            psql.Expression
+	Email           psql.Expression
+	PasswordHash    psql.Expression
+	CreatedAt       psql.Expression
+	UpdatedAt       psql.Expression
+	EmailVerifiedAt psql.Expression
 }
 
 func (c userColumns) Alias() string {
@@ -77,16 +103,17 @@ func (userColumns) AliasedAs(alias string) userColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type UserSetter struct {
-	ID           omit.Val[uuid.UUID] `db:"id,pk" `
-	Name         omit.Val[string]    `db:"name" `
-	Email        omit.Val[string]    `db:"email" `
-	PasswordHash omit.Val[string]    `db:"password_hash" `
-	CreatedAt    omit.Val[time.Time] `db:"created_at" `
-	UpdatedAt    omit.Val[time.Time] `db:"updated_at" `
+	ID              omit.Val[uuid.UUID]     `db:"id,pk" `
+	Name            omit.Val[string]        `db:"name" `
+	Email           omit.Val[string]        `db:"email" `
+	PasswordHash    omit.Val[string]        `db:"password_hash" `
+	CreatedAt       omit.Val[time.Time]     `db:"created_at" `
+	UpdatedAt       omit.Val[time.Time]     `db:"updated_at" `
+	EmailVerifiedAt omitnull.Val[time.Time] `db:"email_verified_at" `
 }
 
 func (s UserSetter) SetColumns() []string {
-	vals := make([]string, 0, 6)
+	vals := make([]string, 0, 7)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -105,6 +132,9 @@ func (s UserSetter) SetColumns() []string {
 	if s.UpdatedAt.IsValue() {
 		vals = append(vals, "updated_at")
 	}
+	if !s.EmailVerifiedAt.IsUnset() {
+		vals = append(vals, "email_verified_at")
+	}
 	return vals
 }
 
@@ -127,6 +157,9 @@ func (s UserSetter) Overwrite(t *User) {
 	if s.UpdatedAt.IsValue() {
 		t.UpdatedAt = s.UpdatedAt.MustGet()
 	}
+	if !s.EmailVerifiedAt.IsUnset() {
+		t.EmailVerifiedAt = s.EmailVerifiedAt.MustGetNull()
+	}
 }
 
 func (s *UserSetter) Apply(q *dialect.InsertQuery) {
@@ -135,7 +168,7 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 6)
+		vals := make([]bob.Expression, 7)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -172,6 +205,12 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 			vals[5] = psql.Raw("DEFAULT")
 		}
 
+		if !s.EmailVerifiedAt.IsUnset() {
+			vals[6] = psql.Arg(s.EmailVerifiedAt.MustGetNull())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -181,7 +220,7 @@ func (s UserSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 6)
+	exprs := make([]bob.Expression, 0, 7)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -225,6 +264,13 @@ func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.EmailVerifiedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "email_verified_at")...),
+			psql.Arg(s.EmailVerifiedAt),
+		}})
+	}
+
 	return exprs
 }
 
@@ -286,6 +332,7 @@ func (o *User) Update(ctx context.Context, exec bob.Executor, s *UserSetter) err
 		return err
 	}
 
+	o.R = v.R
 	*o = *v
 
 	return nil
@@ -305,7 +352,7 @@ func (o *User) Reload(ctx context.Context, exec bob.Executor) error {
 	if err != nil {
 		return err
 	}
-
+	o2.R = o.R
 	*o = *o2
 
 	return nil
@@ -352,7 +399,7 @@ func (o UserSlice) copyMatchingRows(from ...*User) {
 			if new.ID != old.ID {
 				continue
 			}
-
+			new.R = old.R
 			o[i] = new
 			break
 		}
@@ -450,26 +497,2174 @@ func (o UserSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
-type userWhere[Q psql.Filterable] struct {
-	ID           psql.WhereMod[Q, uuid.UUID]
-	Name         psql.WhereMod[Q, string]
-	Email        psql.WhereMod[Q, string]
-	PasswordHash psql.WhereMod[Q, string]
-	CreatedAt    psql.WhereMod[Q, time.Time]
-	UpdatedAt    psql.WhereMod[Q, time.Time]
+// APIKeys starts a query for related objects on api_keys
+func (o *User) APIKeys(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	return APIKeys.Query(append(mods,
+		sm.Where(APIKeys.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
-	return buildUserWhere[Q](buildUserColumns(alias))
+func (os UserSlice) APIKeys(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return APIKeys.Query(append(mods,
+		sm.Where(psql.Group(APIKeys.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func buildUserWhere[Q psql.Filterable](cols userColumns) userWhere[Q] {
-	return userWhere[Q]{
-		ID:           psql.Where[Q, uuid.UUID](cols.ID),
-		Name:         psql.Where[Q, string](cols.Name),
-		Email:        psql.Where[Q, string](cols.Email),
-		PasswordHash: psql.Where[Q, string](cols.PasswordHash),
-		CreatedAt:    psql.Where[Q, time.Time](cols.CreatedAt),
-		UpdatedAt:    psql.Where[Q, time.Time](cols.UpdatedAt),
+// ExternalIdentities starts a query for related objects on external_identities
+func (o *User) ExternalIdentities(mods ...bob.Mod[*dialect.SelectQuery]) ExternalIdentitiesQuery {
+	return ExternalIdentities.Query(append(mods,
+		sm.Where(ExternalIdentities.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ExternalIdentities(mods ...bob.Mod[*dialect.SelectQuery]) ExternalIdentitiesQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return ExternalIdentities.Query(append(mods,
+		sm.Where(psql.Group(ExternalIdentities.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// MfaRecoveryCodes starts a query for related objects on mfa_recovery_codes
+func (o *User) MfaRecoveryCodes(mods ...bob.Mod[*dialect.SelectQuery]) MfaRecoveryCodesQuery {
+	return MfaRecoveryCodes.Query(append(mods,
+		sm.Where(MfaRecoveryCodes.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) MfaRecoveryCodes(mods ...bob.Mod[*dialect.SelectQuery]) MfaRecoveryCodesQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return MfaRecoveryCodes.Query(append(mods,
+		sm.Where(psql.Group(MfaRecoveryCodes.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// OauthAuthorizationCodes starts a query for related objects on oauth_authorization_codes
+func (o *User) OauthAuthorizationCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthAuthorizationCodesQuery {
+	return OauthAuthorizationCodes.Query(append(mods,
+		sm.Where(OauthAuthorizationCodes.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) OauthAuthorizationCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthAuthorizationCodesQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return OauthAuthorizationCodes.Query(append(mods,
+		sm.Where(psql.Group(OauthAuthorizationCodes.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Passkeys starts a query for related objects on passkeys
+func (o *User) Passkeys(mods ...bob.Mod[*dialect.SelectQuery]) PasskeysQuery {
+	return Passkeys.Query(append(mods,
+		sm.Where(Passkeys.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Passkeys(mods ...bob.Mod[*dialect.SelectQuery]) PasskeysQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return Passkeys.Query(append(mods,
+		sm.Where(psql.Group(Passkeys.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// PasswordHistories starts a query for related objects on password_history
+func (o *User) PasswordHistories(mods ...bob.Mod[*dialect.SelectQuery]) PasswordHistoriesQuery {
+	return PasswordHistories.Query(append(mods,
+		sm.Where(PasswordHistories.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) PasswordHistories(mods ...bob.Mod[*dialect.SelectQuery]) PasswordHistoriesQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return PasswordHistories.Query(append(mods,
+		sm.Where(psql.Group(PasswordHistories.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// RefreshTokens starts a query for related objects on refresh_tokens
+func (o *User) RefreshTokens(mods ...bob.Mod[*dialect.SelectQuery]) RefreshTokensQuery {
+	return RefreshTokens.Query(append(mods,
+		sm.Where(RefreshTokens.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) RefreshTokens(mods ...bob.Mod[*dialect.SelectQuery]) RefreshTokensQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return RefreshTokens.Query(append(mods,
+		sm.Where(psql.Group(RefreshTokens.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Sessions starts a query for related objects on sessions
+func (o *User) Sessions(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	return Sessions.Query(append(mods,
+		sm.Where(Sessions.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Sessions(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return Sessions.Query(append(mods,
+		sm.Where(psql.Group(Sessions.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// UserMfa starts a query for related objects on user_mfa
+func (o *User) UserMfa(mods ...bob.Mod[*dialect.SelectQuery]) UserMfasQuery {
+	return UserMfas.Query(append(mods,
+		sm.Where(UserMfas.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) UserMfa(mods ...bob.Mod[*dialect.SelectQuery]) UserMfasQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return UserMfas.Query(append(mods,
+		sm.Where(psql.Group(UserMfas.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// UserTokens starts a query for related objects on user_tokens
+func (o *User) UserTokens(mods ...bob.Mod[*dialect.SelectQuery]) UserTokensQuery {
+	return UserTokens.Query(append(mods,
+		sm.Where(UserTokens.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) UserTokens(mods ...bob.Mod[*dialect.SelectQuery]) UserTokensQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return UserTokens.Query(append(mods,
+		sm.Where(psql.Group(UserTokens.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// WebauthnSessions starts a query for related objects on webauthn_sessions
+func (o *User) WebauthnSessions(mods ...bob.Mod[*dialect.SelectQuery]) WebauthnSessionsQuery {
+	return WebauthnSessions.Query(append(mods,
+		sm.Where(WebauthnSessions.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) WebauthnSessions(mods ...bob.Mod[*dialect.SelectQuery]) WebauthnSessionsQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return WebauthnSessions.Query(append(mods,
+		sm.Where(psql.Group(WebauthnSessions.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertUserAPIKeys0(ctx context.Context, exec bob.Executor, apiKeys1 []*APIKeySetter, user0 *User) (APIKeySlice, error) {
+	for i := range apiKeys1 {
+		apiKeys1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := APIKeys.Insert(bob.ToMods(apiKeys1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAPIKeys0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAPIKeys0(ctx context.Context, exec bob.Executor, count int, apiKeys1 APIKeySlice, user0 *User) (APIKeySlice, error) {
+	setter := &APIKeySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := apiKeys1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAPIKeys0: %w", err)
+	}
+
+	return apiKeys1, nil
+}
+
+func (user0 *User) InsertAPIKeys(ctx context.Context, exec bob.Executor, related ...*APIKeySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	apiKeys1, err := insertUserAPIKeys0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.APIKeys = append(user0.R.APIKeys, apiKeys1...)
+
+	for _, rel := range apiKeys1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAPIKeys(ctx context.Context, exec bob.Executor, related ...*APIKey) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	apiKeys1 := APIKeySlice(related)
+
+	_, err = attachUserAPIKeys0(ctx, exec, len(related), apiKeys1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.APIKeys = append(user0.R.APIKeys, apiKeys1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserExternalIdentities0(ctx context.Context, exec bob.Executor, externalIdentities1 []*ExternalIdentitySetter, user0 *User) (ExternalIdentitySlice, error) {
+	for i := range externalIdentities1 {
+		externalIdentities1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := ExternalIdentities.Insert(bob.ToMods(externalIdentities1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserExternalIdentities0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserExternalIdentities0(ctx context.Context, exec bob.Executor, count int, externalIdentities1 ExternalIdentitySlice, user0 *User) (ExternalIdentitySlice, error) {
+	setter := &ExternalIdentitySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := externalIdentities1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserExternalIdentities0: %w", err)
+	}
+
+	return externalIdentities1, nil
+}
+
+func (user0 *User) InsertExternalIdentities(ctx context.Context, exec bob.Executor, related ...*ExternalIdentitySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	externalIdentities1, err := insertUserExternalIdentities0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ExternalIdentities = append(user0.R.ExternalIdentities, externalIdentities1...)
+
+	for _, rel := range externalIdentities1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachExternalIdentities(ctx context.Context, exec bob.Executor, related ...*ExternalIdentity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	externalIdentities1 := ExternalIdentitySlice(related)
+
+	_, err = attachUserExternalIdentities0(ctx, exec, len(related), externalIdentities1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ExternalIdentities = append(user0.R.ExternalIdentities, externalIdentities1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserMfaRecoveryCodes0(ctx context.Context, exec bob.Executor, mfaRecoveryCodes1 []*MfaRecoveryCodeSetter, user0 *User) (MfaRecoveryCodeSlice, error) {
+	for i := range mfaRecoveryCodes1 {
+		mfaRecoveryCodes1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := MfaRecoveryCodes.Insert(bob.ToMods(mfaRecoveryCodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserMfaRecoveryCodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserMfaRecoveryCodes0(ctx context.Context, exec bob.Executor, count int, mfaRecoveryCodes1 MfaRecoveryCodeSlice, user0 *User) (MfaRecoveryCodeSlice, error) {
+	setter := &MfaRecoveryCodeSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := mfaRecoveryCodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserMfaRecoveryCodes0: %w", err)
+	}
+
+	return mfaRecoveryCodes1, nil
+}
+
+func (user0 *User) InsertMfaRecoveryCodes(ctx context.Context, exec bob.Executor, related ...*MfaRecoveryCodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	mfaRecoveryCodes1, err := insertUserMfaRecoveryCodes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.MfaRecoveryCodes = append(user0.R.MfaRecoveryCodes, mfaRecoveryCodes1...)
+
+	for _, rel := range mfaRecoveryCodes1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachMfaRecoveryCodes(ctx context.Context, exec bob.Executor, related ...*MfaRecoveryCode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	mfaRecoveryCodes1 := MfaRecoveryCodeSlice(related)
+
+	_, err = attachUserMfaRecoveryCodes0(ctx, exec, len(related), mfaRecoveryCodes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.MfaRecoveryCodes = append(user0.R.MfaRecoveryCodes, mfaRecoveryCodes1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserOauthAuthorizationCodes0(ctx context.Context, exec bob.Executor, oauthAuthorizationCodes1 []*OauthAuthorizationCodeSetter, user0 *User) (OauthAuthorizationCodeSlice, error) {
+	for i := range oauthAuthorizationCodes1 {
+		oauthAuthorizationCodes1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := OauthAuthorizationCodes.Insert(bob.ToMods(oauthAuthorizationCodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserOauthAuthorizationCodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserOauthAuthorizationCodes0(ctx context.Context, exec bob.Executor, count int, oauthAuthorizationCodes1 OauthAuthorizationCodeSlice, user0 *User) (OauthAuthorizationCodeSlice, error) {
+	setter := &OauthAuthorizationCodeSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := oauthAuthorizationCodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserOauthAuthorizationCodes0: %w", err)
+	}
+
+	return oauthAuthorizationCodes1, nil
+}
+
+func (user0 *User) InsertOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, related ...*OauthAuthorizationCodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthAuthorizationCodes1, err := insertUserOauthAuthorizationCodes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OauthAuthorizationCodes = append(user0.R.OauthAuthorizationCodes, oauthAuthorizationCodes1...)
+
+	for _, rel := range oauthAuthorizationCodes1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, related ...*OauthAuthorizationCode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthAuthorizationCodes1 := OauthAuthorizationCodeSlice(related)
+
+	_, err = attachUserOauthAuthorizationCodes0(ctx, exec, len(related), oauthAuthorizationCodes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OauthAuthorizationCodes = append(user0.R.OauthAuthorizationCodes, oauthAuthorizationCodes1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserPasskeys0(ctx context.Context, exec bob.Executor, passkeys1 []*PasskeySetter, user0 *User) (PasskeySlice, error) {
+	for i := range passkeys1 {
+		passkeys1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Passkeys.Insert(bob.ToMods(passkeys1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserPasskeys0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserPasskeys0(ctx context.Context, exec bob.Executor, count int, passkeys1 PasskeySlice, user0 *User) (PasskeySlice, error) {
+	setter := &PasskeySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := passkeys1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserPasskeys0: %w", err)
+	}
+
+	return passkeys1, nil
+}
+
+func (user0 *User) InsertPasskeys(ctx context.Context, exec bob.Executor, related ...*PasskeySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	passkeys1, err := insertUserPasskeys0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Passkeys = append(user0.R.Passkeys, passkeys1...)
+
+	for _, rel := range passkeys1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachPasskeys(ctx context.Context, exec bob.Executor, related ...*Passkey) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	passkeys1 := PasskeySlice(related)
+
+	_, err = attachUserPasskeys0(ctx, exec, len(related), passkeys1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Passkeys = append(user0.R.Passkeys, passkeys1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserPasswordHistories0(ctx context.Context, exec bob.Executor, passwordHistories1 []*PasswordHistorySetter, user0 *User) (PasswordHistorySlice, error) {
+	for i := range passwordHistories1 {
+		passwordHistories1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := PasswordHistories.Insert(bob.ToMods(passwordHistories1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserPasswordHistories0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserPasswordHistories0(ctx context.Context, exec bob.Executor, count int, passwordHistories1 PasswordHistorySlice, user0 *User) (PasswordHistorySlice, error) {
+	setter := &PasswordHistorySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := passwordHistories1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserPasswordHistories0: %w", err)
+	}
+
+	return passwordHistories1, nil
+}
+
+func (user0 *User) InsertPasswordHistories(ctx context.Context, exec bob.Executor, related ...*PasswordHistorySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	passwordHistories1, err := insertUserPasswordHistories0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.PasswordHistories = append(user0.R.PasswordHistories, passwordHistories1...)
+
+	for _, rel := range passwordHistories1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachPasswordHistories(ctx context.Context, exec bob.Executor, related ...*PasswordHistory) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	passwordHistories1 := PasswordHistorySlice(related)
+
+	_, err = attachUserPasswordHistories0(ctx, exec, len(related), passwordHistories1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.PasswordHistories = append(user0.R.PasswordHistories, passwordHistories1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserRefreshTokens0(ctx context.Context, exec bob.Executor, refreshTokens1 []*RefreshTokenSetter, user0 *User) (RefreshTokenSlice, error) {
+	for i := range refreshTokens1 {
+		refreshTokens1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := RefreshTokens.Insert(bob.ToMods(refreshTokens1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserRefreshTokens0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserRefreshTokens0(ctx context.Context, exec bob.Executor, count int, refreshTokens1 RefreshTokenSlice, user0 *User) (RefreshTokenSlice, error) {
+	setter := &RefreshTokenSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := refreshTokens1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserRefreshTokens0: %w", err)
+	}
+
+	return refreshTokens1, nil
+}
+
+func (user0 *User) InsertRefreshTokens(ctx context.Context, exec bob.Executor, related ...*RefreshTokenSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	refreshTokens1, err := insertUserRefreshTokens0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.RefreshTokens = append(user0.R.RefreshTokens, refreshTokens1...)
+
+	for _, rel := range refreshTokens1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachRefreshTokens(ctx context.Context, exec bob.Executor, related ...*RefreshToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	refreshTokens1 := RefreshTokenSlice(related)
+
+	_, err = attachUserRefreshTokens0(ctx, exec, len(related), refreshTokens1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.RefreshTokens = append(user0.R.RefreshTokens, refreshTokens1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserSessions0(ctx context.Context, exec bob.Executor, sessions1 []*SessionSetter, user0 *User) (SessionSlice, error) {
+	for i := range sessions1 {
+		sessions1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Sessions.Insert(bob.ToMods(sessions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserSessions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserSessions0(ctx context.Context, exec bob.Executor, count int, sessions1 SessionSlice, user0 *User) (SessionSlice, error) {
+	setter := &SessionSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := sessions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserSessions0: %w", err)
+	}
+
+	return sessions1, nil
+}
+
+func (user0 *User) InsertSessions(ctx context.Context, exec bob.Executor, related ...*SessionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	sessions1, err := insertUserSessions0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Sessions = append(user0.R.Sessions, sessions1...)
+
+	for _, rel := range sessions1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachSessions(ctx context.Context, exec bob.Executor, related ...*Session) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	sessions1 := SessionSlice(related)
+
+	_, err = attachUserSessions0(ctx, exec, len(related), sessions1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Sessions = append(user0.R.Sessions, sessions1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserUserMfa0(ctx context.Context, exec bob.Executor, userMfa1 *UserMfaSetter, user0 *User) (*UserMfa, error) {
+	userMfa1.UserID = omit.From(user0.ID)
+
+	ret, err := UserMfas.Insert(userMfa1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUserMfa0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUserMfa0(ctx context.Context, exec bob.Executor, count int, userMfa1 *UserMfa, user0 *User) (*UserMfa, error) {
+	setter := &UserMfaSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := userMfa1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUserMfa0: %w", err)
+	}
+
+	return userMfa1, nil
+}
+
+func (user0 *User) InsertUserMfa(ctx context.Context, exec bob.Executor, related *UserMfaSetter) error {
+	var err error
+
+	userMfa1, err := insertUserUserMfa0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserMfa = userMfa1
+
+	userMfa1.R.User = user0
+
+	return nil
+}
+
+func (user0 *User) AttachUserMfa(ctx context.Context, exec bob.Executor, userMfa1 *UserMfa) error {
+	var err error
+
+	_, err = attachUserUserMfa0(ctx, exec, 1, userMfa1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserMfa = userMfa1
+
+	userMfa1.R.User = user0
+
+	return nil
+}
+
+func insertUserUserTokens0(ctx context.Context, exec bob.Executor, userTokens1 []*UserTokenSetter, user0 *User) (UserTokenSlice, error) {
+	for i := range userTokens1 {
+		userTokens1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := UserTokens.Insert(bob.ToMods(userTokens1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUserTokens0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUserTokens0(ctx context.Context, exec bob.Executor, count int, userTokens1 UserTokenSlice, user0 *User) (UserTokenSlice, error) {
+	setter := &UserTokenSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := userTokens1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUserTokens0: %w", err)
+	}
+
+	return userTokens1, nil
+}
+
+func (user0 *User) InsertUserTokens(ctx context.Context, exec bob.Executor, related ...*UserTokenSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	userTokens1, err := insertUserUserTokens0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserTokens = append(user0.R.UserTokens, userTokens1...)
+
+	for _, rel := range userTokens1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUserTokens(ctx context.Context, exec bob.Executor, related ...*UserToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	userTokens1 := UserTokenSlice(related)
+
+	_, err = attachUserUserTokens0(ctx, exec, len(related), userTokens1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserTokens = append(user0.R.UserTokens, userTokens1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserWebauthnSessions0(ctx context.Context, exec bob.Executor, webauthnSessions1 []*WebauthnSessionSetter, user0 *User) (WebauthnSessionSlice, error) {
+	for i := range webauthnSessions1 {
+		webauthnSessions1[i].UserID = omitnull.From(user0.ID)
+	}
+
+	ret, err := WebauthnSessions.Insert(bob.ToMods(webauthnSessions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserWebauthnSessions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserWebauthnSessions0(ctx context.Context, exec bob.Executor, count int, webauthnSessions1 WebauthnSessionSlice, user0 *User) (WebauthnSessionSlice, error) {
+	setter := &WebauthnSessionSetter{
+		UserID: omitnull.From(user0.ID),
+	}
+
+	err := webauthnSessions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserWebauthnSessions0: %w", err)
+	}
+
+	return webauthnSessions1, nil
+}
+
+func (user0 *User) InsertWebauthnSessions(ctx context.Context, exec bob.Executor, related ...*WebauthnSessionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	webauthnSessions1, err := insertUserWebauthnSessions0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.WebauthnSessions = append(user0.R.WebauthnSessions, webauthnSessions1...)
+
+	for _, rel := range webauthnSessions1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachWebauthnSessions(ctx context.Context, exec bob.Executor, related ...*WebauthnSession) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	webauthnSessions1 := WebauthnSessionSlice(related)
+
+	_, err = attachUserWebauthnSessions0(ctx, exec, len(related), webauthnSessions1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.WebauthnSessions = append(user0.R.WebauthnSessions, webauthnSessions1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+type userWhere[Q psql.Filterable] struct {
+	ID              psql.WhereMod[Q, uuid.UUID]
+	Name            psql.WhereMod[Q, string]
+	Email           psql.WhereMod[Q, string]
+	PasswordHash    psql.WhereMod[Q, string]
+	CreatedAt       psql.WhereMod[Q, time.Time]
+	UpdatedAt       psql.WhereMod[Q, time.Time]
+	EmailVerifiedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
+	return buildUserWhere[Q](buildUserColumns(alias))
+}
+
+func buildUserWhere[Q psql.Filterable](cols userColumns) userWhere[Q] {
+	return userWhere[Q]{
+		ID:              psql.Where[Q, uuid.UUID](cols.ID),
+		Name:            psql.Where[Q, string](cols.Name),
+		Email:           psql.Where[Q, string](cols.Email),
+		PasswordHash:    psql.Where[Q, string](cols.PasswordHash),
+		CreatedAt:       psql.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:       psql.Where[Q, time.Time](cols.UpdatedAt),
+		EmailVerifiedAt: psql.WhereNull[Q, time.Time](cols.EmailVerifiedAt),
+	}
+}
+
+func (o *User) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "APIKeys":
+		rels, ok := retrieved.(APIKeySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.APIKeys = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ExternalIdentities":
+		rels, ok := retrieved.(ExternalIdentitySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ExternalIdentities = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "MfaRecoveryCodes":
+		rels, ok := retrieved.(MfaRecoveryCodeSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.MfaRecoveryCodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "OauthAuthorizationCodes":
+		rels, ok := retrieved.(OauthAuthorizationCodeSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OauthAuthorizationCodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Passkeys":
+		rels, ok := retrieved.(PasskeySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Passkeys = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "PasswordHistories":
+		rels, ok := retrieved.(PasswordHistorySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.PasswordHistories = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "RefreshTokens":
+		rels, ok := retrieved.(RefreshTokenSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.RefreshTokens = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Sessions":
+		rels, ok := retrieved.(SessionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Sessions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "UserMfa":
+		rel, ok := retrieved.(*UserMfa)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UserMfa = rel
+
+		if rel != nil {
+			rel.R.User = o
+		}
+		return nil
+	case "UserTokens":
+		rels, ok := retrieved.(UserTokenSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UserTokens = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "WebauthnSessions":
+		rels, ok := retrieved.(WebauthnSessionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.WebauthnSessions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("user has no relationship %q", name)
+	}
+}
+
+type userPreloader struct {
+	UserMfa func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildUserPreloader() userPreloader {
+	return userPreloader{
+		UserMfa: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*UserMfa, UserMfaSlice](psql.PreloadRel{
+				Name: "UserMfa",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Users,
+						To:          UserMfas,
+						FromColumns: []string{"id"},
+						ToColumns:   []string{"user_id"},
+					},
+				},
+			}, UserMfas.Columns.Names(), opts...)
+		},
+	}
+}
+
+type userThenLoader[Q orm.Loadable] struct {
+	APIKeys                 func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ExternalIdentities      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	MfaRecoveryCodes        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OauthAuthorizationCodes func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Passkeys                func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	PasswordHistories       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	RefreshTokens           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Sessions                func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	UserMfa                 func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	UserTokens              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	WebauthnSessions        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
+	type APIKeysLoadInterface interface {
+		LoadAPIKeys(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ExternalIdentitiesLoadInterface interface {
+		LoadExternalIdentities(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type MfaRecoveryCodesLoadInterface interface {
+		LoadMfaRecoveryCodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OauthAuthorizationCodesLoadInterface interface {
+		LoadOauthAuthorizationCodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type PasskeysLoadInterface interface {
+		LoadPasskeys(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type PasswordHistoriesLoadInterface interface {
+		LoadPasswordHistories(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type RefreshTokensLoadInterface interface {
+		LoadRefreshTokens(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type SessionsLoadInterface interface {
+		LoadSessions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserMfaLoadInterface interface {
+		LoadUserMfa(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserTokensLoadInterface interface {
+		LoadUserTokens(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type WebauthnSessionsLoadInterface interface {
+		LoadWebauthnSessions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userThenLoader[Q]{
+		APIKeys: thenLoadBuilder[Q](
+			"APIKeys",
+			func(ctx context.Context, exec bob.Executor, retrieved APIKeysLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAPIKeys(ctx, exec, mods...)
+			},
+		),
+		ExternalIdentities: thenLoadBuilder[Q](
+			"ExternalIdentities",
+			func(ctx context.Context, exec bob.Executor, retrieved ExternalIdentitiesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadExternalIdentities(ctx, exec, mods...)
+			},
+		),
+		MfaRecoveryCodes: thenLoadBuilder[Q](
+			"MfaRecoveryCodes",
+			func(ctx context.Context, exec bob.Executor, retrieved MfaRecoveryCodesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMfaRecoveryCodes(ctx, exec, mods...)
+			},
+		),
+		OauthAuthorizationCodes: thenLoadBuilder[Q](
+			"OauthAuthorizationCodes",
+			func(ctx context.Context, exec bob.Executor, retrieved OauthAuthorizationCodesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOauthAuthorizationCodes(ctx, exec, mods...)
+			},
+		),
+		Passkeys: thenLoadBuilder[Q](
+			"Passkeys",
+			func(ctx context.Context, exec bob.Executor, retrieved PasskeysLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadPasskeys(ctx, exec, mods...)
+			},
+		),
+		PasswordHistories: thenLoadBuilder[Q](
+			"PasswordHistories",
+			func(ctx context.Context, exec bob.Executor, retrieved PasswordHistoriesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadPasswordHistories(ctx, exec, mods...)
+			},
+		),
+		RefreshTokens: thenLoadBuilder[Q](
+			"RefreshTokens",
+			func(ctx context.Context, exec bob.Executor, retrieved RefreshTokensLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadRefreshTokens(ctx, exec, mods...)
+			},
+		),
+		Sessions: thenLoadBuilder[Q](
+			"Sessions",
+			func(ctx context.Context, exec bob.Executor, retrieved SessionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadSessions(ctx, exec, mods...)
+			},
+		),
+		UserMfa: thenLoadBuilder[Q](
+			"UserMfa",
+			func(ctx context.Context, exec bob.Executor, retrieved UserMfaLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUserMfa(ctx, exec, mods...)
+			},
+		),
+		UserTokens: thenLoadBuilder[Q](
+			"UserTokens",
+			func(ctx context.Context, exec bob.Executor, retrieved UserTokensLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUserTokens(ctx, exec, mods...)
+			},
+		),
+		WebauthnSessions: thenLoadBuilder[Q](
+			"WebauthnSessions",
+			func(ctx context.Context, exec bob.Executor, retrieved WebauthnSessionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadWebauthnSessions(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAPIKeys loads the user's APIKeys into the .R struct
+func (o *User) LoadAPIKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.APIKeys = nil
+
+	related, err := o.APIKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.APIKeys = related
+	return nil
+}
+
+// LoadAPIKeys loads the user's APIKeys into the .R struct
+func (os UserSlice) LoadAPIKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	apiKeys, err := os.APIKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.APIKeys = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range apiKeys {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.APIKeys = append(o.R.APIKeys, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadExternalIdentities loads the user's ExternalIdentities into the .R struct
+func (o *User) LoadExternalIdentities(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ExternalIdentities = nil
+
+	related, err := o.ExternalIdentities(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.ExternalIdentities = related
+	return nil
+}
+
+// LoadExternalIdentities loads the user's ExternalIdentities into the .R struct
+func (os UserSlice) LoadExternalIdentities(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	externalIdentities, err := os.ExternalIdentities(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ExternalIdentities = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range externalIdentities {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.ExternalIdentities = append(o.R.ExternalIdentities, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadMfaRecoveryCodes loads the user's MfaRecoveryCodes into the .R struct
+func (o *User) LoadMfaRecoveryCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.MfaRecoveryCodes = nil
+
+	related, err := o.MfaRecoveryCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.MfaRecoveryCodes = related
+	return nil
+}
+
+// LoadMfaRecoveryCodes loads the user's MfaRecoveryCodes into the .R struct
+func (os UserSlice) LoadMfaRecoveryCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	mfaRecoveryCodes, err := os.MfaRecoveryCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.MfaRecoveryCodes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range mfaRecoveryCodes {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.MfaRecoveryCodes = append(o.R.MfaRecoveryCodes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadOauthAuthorizationCodes loads the user's OauthAuthorizationCodes into the .R struct
+func (o *User) LoadOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.OauthAuthorizationCodes = nil
+
+	related, err := o.OauthAuthorizationCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.OauthAuthorizationCodes = related
+	return nil
+}
+
+// LoadOauthAuthorizationCodes loads the user's OauthAuthorizationCodes into the .R struct
+func (os UserSlice) LoadOauthAuthorizationCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthAuthorizationCodes, err := os.OauthAuthorizationCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.OauthAuthorizationCodes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthAuthorizationCodes {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.OauthAuthorizationCodes = append(o.R.OauthAuthorizationCodes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadPasskeys loads the user's Passkeys into the .R struct
+func (o *User) LoadPasskeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Passkeys = nil
+
+	related, err := o.Passkeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Passkeys = related
+	return nil
+}
+
+// LoadPasskeys loads the user's Passkeys into the .R struct
+func (os UserSlice) LoadPasskeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	passkeys, err := os.Passkeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Passkeys = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range passkeys {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Passkeys = append(o.R.Passkeys, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordHistories loads the user's PasswordHistories into the .R struct
+func (o *User) LoadPasswordHistories(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.PasswordHistories = nil
+
+	related, err := o.PasswordHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.PasswordHistories = related
+	return nil
+}
+
+// LoadPasswordHistories loads the user's PasswordHistories into the .R struct
+func (os UserSlice) LoadPasswordHistories(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	passwordHistories, err := os.PasswordHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.PasswordHistories = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range passwordHistories {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.PasswordHistories = append(o.R.PasswordHistories, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens loads the user's RefreshTokens into the .R struct
+func (o *User) LoadRefreshTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.RefreshTokens = nil
+
+	related, err := o.RefreshTokens(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.RefreshTokens = related
+	return nil
+}
+
+// LoadRefreshTokens loads the user's RefreshTokens into the .R struct
+func (os UserSlice) LoadRefreshTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	refreshTokens, err := os.RefreshTokens(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.RefreshTokens = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range refreshTokens {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.RefreshTokens = append(o.R.RefreshTokens, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadSessions loads the user's Sessions into the .R struct
+func (o *User) LoadSessions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Sessions = nil
+
+	related, err := o.Sessions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Sessions = related
+	return nil
+}
+
+// LoadSessions loads the user's Sessions into the .R struct
+func (os UserSlice) LoadSessions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	sessions, err := os.Sessions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Sessions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range sessions {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Sessions = append(o.R.Sessions, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUserMfa loads the user's UserMfa into the .R struct
+func (o *User) LoadUserMfa(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UserMfa = nil
+
+	related, err := o.UserMfa(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.User = o
+
+	o.R.UserMfa = related
+	return nil
+}
+
+// LoadUserMfa loads the user's UserMfa into the .R struct
+func (os UserSlice) LoadUserMfa(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	userMfas, err := os.UserMfa(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range userMfas {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.UserMfa = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUserTokens loads the user's UserTokens into the .R struct
+func (o *User) LoadUserTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UserTokens = nil
+
+	related, err := o.UserTokens(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.UserTokens = related
+	return nil
+}
+
+// LoadUserTokens loads the user's UserTokens into the .R struct
+func (os UserSlice) LoadUserTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	userTokens, err := os.UserTokens(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.UserTokens = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range userTokens {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.UserTokens = append(o.R.UserTokens, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnSessions loads the user's WebauthnSessions into the .R struct
+func (o *User) LoadWebauthnSessions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.WebauthnSessions = nil
+
+	related, err := o.WebauthnSessions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.WebauthnSessions = related
+	return nil
+}
+
+// LoadWebauthnSessions loads the user's WebauthnSessions into the .R struct
+func (os UserSlice) LoadWebauthnSessions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	webauthnSessions, err := os.WebauthnSessions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.WebauthnSessions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range webauthnSessions {
+
+			if !rel.UserID.IsValue() {
+				continue
+			}
+			if !(rel.UserID.IsValue() && o.ID == rel.UserID.MustGet()) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.WebauthnSessions = append(o.R.WebauthnSessions, rel)
+		}
+	}
+
+	return nil
+}
+
+type userJoins[Q dialect.Joinable] struct {
+	typ                     string
+	APIKeys                 modAs[Q, apiKeyColumns]
+	ExternalIdentities      modAs[Q, externalIdentityColumns]
+	MfaRecoveryCodes        modAs[Q, mfaRecoveryCodeColumns]
+	OauthAuthorizationCodes modAs[Q, oauthAuthorizationCodeColumns]
+	Passkeys                modAs[Q, passkeyColumns]
+	PasswordHistories       modAs[Q, passwordHistoryColumns]
+	RefreshTokens           modAs[Q, refreshTokenColumns]
+	Sessions                modAs[Q, sessionColumns]
+	UserMfa                 modAs[Q, userMfaColumns]
+	UserTokens              modAs[Q, userTokenColumns]
+	WebauthnSessions        modAs[Q, webauthnSessionColumns]
+}
+
+func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
+	return buildUserJoins[Q](buildUserColumns(alias), j.typ)
+}
+
+func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[Q] {
+	return userJoins[Q]{
+		typ: typ,
+		APIKeys: modAs[Q, apiKeyColumns]{
+			c: APIKeys.Columns,
+			f: func(to apiKeyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, APIKeys.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ExternalIdentities: modAs[Q, externalIdentityColumns]{
+			c: ExternalIdentities.Columns,
+			f: func(to externalIdentityColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ExternalIdentities.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		MfaRecoveryCodes: modAs[Q, mfaRecoveryCodeColumns]{
+			c: MfaRecoveryCodes.Columns,
+			f: func(to mfaRecoveryCodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, MfaRecoveryCodes.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OauthAuthorizationCodes: modAs[Q, oauthAuthorizationCodeColumns]{
+			c: OauthAuthorizationCodes.Columns,
+			f: func(to oauthAuthorizationCodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthAuthorizationCodes.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Passkeys: modAs[Q, passkeyColumns]{
+			c: Passkeys.Columns,
+			f: func(to passkeyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Passkeys.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		PasswordHistories: modAs[Q, passwordHistoryColumns]{
+			c: PasswordHistories.Columns,
+			f: func(to passwordHistoryColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, PasswordHistories.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		RefreshTokens: modAs[Q, refreshTokenColumns]{
+			c: RefreshTokens.Columns,
+			f: func(to refreshTokenColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, RefreshTokens.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Sessions: modAs[Q, sessionColumns]{
+			c: Sessions.Columns,
+			f: func(to sessionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Sessions.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		UserMfa: modAs[Q, userMfaColumns]{
+			c: UserMfas.Columns,
+			f: func(to userMfaColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, UserMfas.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		UserTokens: modAs[Q, userTokenColumns]{
+			c: UserTokens.Columns,
+			f: func(to userTokenColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, UserTokens.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		WebauthnSessions: modAs[Q, webauthnSessionColumns]{
+			c: WebauthnSessions.Columns,
+			f: func(to webauthnSessionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, WebauthnSessions.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 	}
 }
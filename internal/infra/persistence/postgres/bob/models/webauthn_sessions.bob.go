@@ -0,0 +1,709 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// WebauthnSession is an object representing the database table.
+type WebauthnSession struct {
+	ID          uuid.UUID           `db:"id,pk" `
+	UserID      null.Val[uuid.UUID] `db:"user_id" `
+	Ceremony    string              `db:"ceremony" `
+	SessionData []byte              `db:"session_data" `
+	ExpiresAt   time.Time           `db:"expires_at" `
+	CreatedAt   time.Time           `db:"created_at" `
+
+	R webauthnSessionR `db:"-" `
+}
+
+// WebauthnSessionSlice is an alias for a slice of pointers to WebauthnSession.
+// This should almost always be used instead of []*WebauthnSession.
+type WebauthnSessionSlice []*WebauthnSession
+
+// WebauthnSessions contains methods to work with the webauthn_sessions table
+var WebauthnSessions = psql.NewTablex[*WebauthnSession, WebauthnSessionSlice, *WebauthnSessionSetter]("", "webauthn_sessions", buildWebauthnSessionColumns("webauthn_sessions"))
+
+// WebauthnSessionsQuery is a query on the webauthn_sessions table
+type WebauthnSessionsQuery = *psql.ViewQuery[*WebauthnSession, WebauthnSessionSlice]
+
+// webauthnSessionR is where relationships are stored.
+type webauthnSessionR struct {
+	User *User // webauthn_sessions_user_id_fkey
+}
+
+func buildWebauthnSessionColumns(alias string) webauthnSessionColumns {
+	return webauthnSessionColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "ceremony", "session_data", "expires_at", "created_at",
+		).WithParent("webauthn_sessions"),
+		tableAlias:  alias,
+		ID:          psql.Quote(alias, "id"),
+		UserID:      psql.Quote(alias, "user_id"),
+		Ceremony:    psql.Quote(alias, "ceremony"),
+		SessionData: psql.Quote(alias, "session_data"),
+		ExpiresAt:   psql.Quote(alias, "expires_at"),
+		CreatedAt:   psql.Quote(alias, "created_at"),
+	}
+}
+
+type webauthnSessionColumns struct {
+	expr.ColumnsExpr
+	tableAlias  string
+	ID          psql.Expression
+	UserID      psql.Expression
+	Ceremony    psql.Expression
+	SessionData psql.Expression
+	ExpiresAt   psql.Expression
+	CreatedAt   psql.Expression
+}
+
+func (c webauthnSessionColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (webauthnSessionColumns) AliasedAs(alias string) webauthnSessionColumns {
+	return buildWebauthnSessionColumns(alias)
+}
+
+// WebauthnSessionSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type WebauthnSessionSetter struct {
+	ID          omit.Val[uuid.UUID]     `db:"id,pk" `
+	UserID      omitnull.Val[uuid.UUID] `db:"user_id" `
+	Ceremony    omit.Val[string]        `db:"ceremony" `
+	SessionData omit.Val[[]byte]        `db:"session_data" `
+	ExpiresAt   omit.Val[time.Time]     `db:"expires_at" `
+	CreatedAt   omit.Val[time.Time]     `db:"created_at" `
+}
+
+func (s WebauthnSessionSetter) SetColumns() []string {
+	vals := make([]string, 0, 6)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if !s.UserID.IsUnset() {
+		vals = append(vals, "user_id")
+	}
+	if s.Ceremony.IsValue() {
+		vals = append(vals, "ceremony")
+	}
+	if s.SessionData.IsValue() {
+		vals = append(vals, "session_data")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s WebauthnSessionSetter) Overwrite(t *WebauthnSession) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if !s.UserID.IsUnset() {
+		t.UserID = s.UserID.MustGetNull()
+	}
+	if s.Ceremony.IsValue() {
+		t.Ceremony = s.Ceremony.MustGet()
+	}
+	if s.SessionData.IsValue() {
+		t.SessionData = s.SessionData.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *WebauthnSessionSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return WebauthnSessions.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 6)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if !s.UserID.IsUnset() {
+			vals[1] = psql.Arg(s.UserID.MustGetNull())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Ceremony.IsValue() {
+			vals[2] = psql.Arg(s.Ceremony.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.SessionData.IsValue() {
+			vals[3] = psql.Arg(s.SessionData.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals[4] = psql.Arg(s.ExpiresAt.MustGet())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals[5] = psql.Arg(s.CreatedAt.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s WebauthnSessionSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s WebauthnSessionSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 6)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.UserID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.Ceremony.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ceremony")...),
+			psql.Arg(s.Ceremony),
+		}})
+	}
+
+	if s.SessionData.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "session_data")...),
+			psql.Arg(s.SessionData),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "expires_at")...),
+			psql.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindWebauthnSession retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindWebauthnSession(ctx context.Context, exec bob.Executor, IDPK uuid.UUID, cols ...string) (*WebauthnSession, error) {
+	if len(cols) == 0 {
+		return WebauthnSessions.Query(
+			sm.Where(WebauthnSessions.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return WebauthnSessions.Query(
+		sm.Where(WebauthnSessions.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(WebauthnSessions.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// WebauthnSessionExists checks the presence of a single record by primary key
+func WebauthnSessionExists(ctx context.Context, exec bob.Executor, IDPK uuid.UUID) (bool, error) {
+	return WebauthnSessions.Query(
+		sm.Where(WebauthnSessions.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after WebauthnSession is retrieved from the database
+func (o *WebauthnSession) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = WebauthnSessions.AfterSelectHooks.RunHooks(ctx, exec, WebauthnSessionSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = WebauthnSessions.AfterInsertHooks.RunHooks(ctx, exec, WebauthnSessionSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = WebauthnSessions.AfterUpdateHooks.RunHooks(ctx, exec, WebauthnSessionSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = WebauthnSessions.AfterDeleteHooks.RunHooks(ctx, exec, WebauthnSessionSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the WebauthnSession
+func (o *WebauthnSession) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *WebauthnSession) pkEQ() dialect.Expression {
+	return psql.Quote("webauthn_sessions", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the WebauthnSession
+func (o *WebauthnSession) Update(ctx context.Context, exec bob.Executor, s *WebauthnSessionSetter) error {
+	v, err := WebauthnSessions.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single WebauthnSession record with an executor
+func (o *WebauthnSession) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := WebauthnSessions.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the WebauthnSession using the executor
+func (o *WebauthnSession) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := WebauthnSessions.Query(
+		sm.Where(WebauthnSessions.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after WebauthnSessionSlice is retrieved from the database
+func (o WebauthnSessionSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = WebauthnSessions.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = WebauthnSessions.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = WebauthnSessions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = WebauthnSessions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o WebauthnSessionSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("webauthn_sessions", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o WebauthnSessionSlice) copyMatchingRows(from ...*WebauthnSession) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o WebauthnSessionSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return WebauthnSessions.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *WebauthnSession:
+				o.copyMatchingRows(retrieved)
+			case []*WebauthnSession:
+				o.copyMatchingRows(retrieved...)
+			case WebauthnSessionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a WebauthnSession or a slice of WebauthnSession
+				// then run the AfterUpdateHooks on the slice
+				_, err = WebauthnSessions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o WebauthnSessionSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return WebauthnSessions.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *WebauthnSession:
+				o.copyMatchingRows(retrieved)
+			case []*WebauthnSession:
+				o.copyMatchingRows(retrieved...)
+			case WebauthnSessionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a WebauthnSession or a slice of WebauthnSession
+				// then run the AfterDeleteHooks on the slice
+				_, err = WebauthnSessions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o WebauthnSessionSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals WebauthnSessionSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := WebauthnSessions.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o WebauthnSessionSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := WebauthnSessions.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o WebauthnSessionSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := WebauthnSessions.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *WebauthnSession) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os WebauthnSessionSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[null.Val[uuid.UUID]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "uuid[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachWebauthnSessionUser0(ctx context.Context, exec bob.Executor, count int, webauthnSession0 *WebauthnSession, user1 *User) (*WebauthnSession, error) {
+	setter := &WebauthnSessionSetter{
+		UserID: omitnull.From(user1.ID),
+	}
+
+	err := webauthnSession0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachWebauthnSessionUser0: %w", err)
+	}
+
+	return webauthnSession0, nil
+}
+
+func (webauthnSession0 *WebauthnSession) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachWebauthnSessionUser0(ctx, exec, 1, webauthnSession0, user1)
+	if err != nil {
+		return err
+	}
+
+	webauthnSession0.R.User = user1
+
+	user1.R.WebauthnSessions = append(user1.R.WebauthnSessions, webauthnSession0)
+
+	return nil
+}
+
+func (webauthnSession0 *WebauthnSession) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachWebauthnSessionUser0(ctx, exec, 1, webauthnSession0, user1)
+	if err != nil {
+		return err
+	}
+
+	webauthnSession0.R.User = user1
+
+	user1.R.WebauthnSessions = append(user1.R.WebauthnSessions, webauthnSession0)
+
+	return nil
+}
+
+type webauthnSessionWhere[Q psql.Filterable] struct {
+	ID          psql.WhereMod[Q, uuid.UUID]
+	UserID      psql.WhereNullMod[Q, uuid.UUID]
+	Ceremony    psql.WhereMod[Q, string]
+	SessionData psql.WhereMod[Q, []byte]
+	ExpiresAt   psql.WhereMod[Q, time.Time]
+	CreatedAt   psql.WhereMod[Q, time.Time]
+}
+
+func (webauthnSessionWhere[Q]) AliasedAs(alias string) webauthnSessionWhere[Q] {
+	return buildWebauthnSessionWhere[Q](buildWebauthnSessionColumns(alias))
+}
+
+func buildWebauthnSessionWhere[Q psql.Filterable](cols webauthnSessionColumns) webauthnSessionWhere[Q] {
+	return webauthnSessionWhere[Q]{
+		ID:          psql.Where[Q, uuid.UUID](cols.ID),
+		UserID:      psql.WhereNull[Q, uuid.UUID](cols.UserID),
+		Ceremony:    psql.Where[Q, string](cols.Ceremony),
+		SessionData: psql.Where[Q, []byte](cols.SessionData),
+		ExpiresAt:   psql.Where[Q, time.Time](cols.ExpiresAt),
+		CreatedAt:   psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *WebauthnSession) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("webauthnSession cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.WebauthnSessions = WebauthnSessionSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("webauthnSession has no relationship %q", name)
+	}
+}
+
+type webauthnSessionPreloader struct {
+	User func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildWebauthnSessionPreloader() webauthnSessionPreloader {
+	return webauthnSessionPreloader{
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        WebauthnSessions,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type webauthnSessionThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildWebauthnSessionThenLoader[Q orm.Loadable]() webauthnSessionThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return webauthnSessionThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the webauthnSession's User into the .R struct
+func (o *WebauthnSession) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.WebauthnSessions = WebauthnSessionSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the webauthnSession's User into the .R struct
+func (os WebauthnSessionSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+			if !o.UserID.IsValue() {
+				continue
+			}
+
+			if !(o.UserID.IsValue() && o.UserID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.WebauthnSessions = append(rel.R.WebauthnSessions, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type webauthnSessionJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j webauthnSessionJoins[Q]) aliasedAs(alias string) webauthnSessionJoins[Q] {
+	return buildWebauthnSessionJoins[Q](buildWebauthnSessionColumns(alias), j.typ)
+}
+
+func buildWebauthnSessionJoins[Q dialect.Joinable](cols webauthnSessionColumns, typ string) webauthnSessionJoins[Q] {
+	return webauthnSessionJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
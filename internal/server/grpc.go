@@ -11,9 +11,11 @@ import (
 
 	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
 	authzv1 "github.com/tencat-dev/go-base/api/authz/v1"
+	oauthv1 "github.com/tencat-dev/go-base/api/oauth/v1"
 	userv1 "github.com/tencat-dev/go-base/api/user/v1"
 	"github.com/tencat-dev/go-base/internal/authz"
 	"github.com/tencat-dev/go-base/internal/conf"
+	"github.com/tencat-dev/go-base/internal/infra/certs"
 )
 
 type GrpcServer transport.Server
@@ -24,9 +26,11 @@ func NewGRPCServer(
 	userService userv1.UserServiceServer,
 	authService authv1.AuthServiceServer,
 	authzService authzv1.AuthzServiceServer,
+	oauthService oauthv1.OAuthServiceServer,
+	oauthClientService oauthv1.OAuthClientServiceServer,
 	logger log.Logger,
 	authzMiddleware authz.AuthzMiddleware,
-) GrpcServer {
+) (GrpcServer, error) {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
@@ -44,9 +48,18 @@ func NewGRPCServer(
 	if c.Timeout != nil {
 		opts = append(opts, grpc.Timeout(c.Timeout.AsDuration()))
 	}
+	tlsConf, err := certs.NewServerTLSConfig(c.GetTls(), log.NewHelper(logger))
+	if err != nil {
+		return nil, err
+	}
+	if tlsConf != nil {
+		opts = append(opts, grpc.TLSConfig(tlsConf))
+	}
 	srv := grpc.NewServer(opts...)
 	userv1.RegisterUserServiceServer(srv, userService)
 	authv1.RegisterAuthServiceServer(srv, authService)
 	authzv1.RegisterAuthzServiceServer(srv, authzService)
-	return srv
+	oauthv1.RegisterOAuthServiceServer(srv, oauthService)
+	oauthv1.RegisterOAuthClientServiceServer(srv, oauthClientService)
+	return srv, nil
 }
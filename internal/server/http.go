@@ -19,9 +19,13 @@ import (
 
 	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
 	authzv1 "github.com/tencat-dev/go-base/api/authz/v1"
+	oauthv1 "github.com/tencat-dev/go-base/api/oauth/v1"
 	userv1 "github.com/tencat-dev/go-base/api/user/v1"
 	"github.com/tencat-dev/go-base/internal/authz"
 	"github.com/tencat-dev/go-base/internal/conf"
+	"github.com/tencat-dev/go-base/internal/infra/auth"
+	"github.com/tencat-dev/go-base/internal/infra/certs"
+	"github.com/tencat-dev/go-base/internal/service"
 )
 
 type HttpServer transport.Server
@@ -32,9 +36,14 @@ func NewHTTPServer(
 	userService userv1.UserServiceServer,
 	authService authv1.AuthServiceServer,
 	authzService authzv1.AuthzServiceServer,
+	oauthService oauthv1.OAuthServiceServer,
+	oauthClientService oauthv1.OAuthClientServiceServer,
 	logger log.Logger,
 	authzMiddleware authz.AuthzMiddleware,
-) HttpServer {
+	keys *auth.KeySet,
+	oauthHandler *service.OAuthHandler,
+	federationHandler *service.FederationHandler,
+) (HttpServer, error) {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
@@ -57,11 +66,27 @@ func NewHTTPServer(
 	if c.Timeout != nil {
 		opts = append(opts, http.Timeout(c.Timeout.AsDuration()))
 	}
+	tlsConf, err := certs.NewServerTLSConfig(c.GetTls(), log.NewHelper(logger))
+	if err != nil {
+		return nil, err
+	}
+	if tlsConf != nil {
+		opts = append(opts, http.TLSConfig(tlsConf))
+	}
 	srv := http.NewServer(opts...)
 	userv1.RegisterUserServiceHTTPServer(srv, userService)
 	authv1.RegisterAuthServiceHTTPServer(srv, authService)
 	authzv1.RegisterAuthzServiceHTTPServer(srv, authzService)
-	return srv
+	oauthv1.RegisterOAuthServiceHTTPServer(srv, oauthService)
+	oauthv1.RegisterOAuthClientServiceHTTPServer(srv, oauthClientService)
+	srv.Handle("/.well-known/jwks.json", auth.NewJWKSHandler(keys))
+	srv.HandleFunc("/.well-known/openid-configuration", oauthHandler.Discovery)
+	srv.HandleFunc("/oauth/authorize", oauthHandler.Authorize)
+	srv.HandleFunc("/oauth/token", oauthHandler.Token)
+	srv.HandleFunc("/oauth/userinfo", oauthHandler.UserInfo)
+	srv.HandleFunc("/auth/oidc/{provider}/begin", federationHandler.Begin)
+	srv.HandleFunc("/auth/oidc/{provider}/callback", federationHandler.Callback)
+	return srv, nil
 }
 
 // Name is the name registered for the json codec.
@@ -5,18 +5,20 @@ import (
 
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/tencat-dev/go-base/internal/conf"
 )
 
 type PprofServer transport.Server
 
-// NewPprofServer new a Pprof server.
+// NewPprofServer new a Pprof server. It also serves the metrics at /metrics.
 func NewPprofServer(c *conf.PprofServer) PprofServer {
 	var opts []http.ServerOption
 	if c.Addr != "" {
 		opts = append(opts, http.Address(c.Addr))
 	}
 	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", promhttp.Handler())
 	return srv
 }
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+func (s *AuthService) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &biz.APIKey{
+		UserID: userID,
+		Name:   req.GetName(),
+	}
+	if req.GetExpiresAt() != nil {
+		expiresAt := req.GetExpiresAt().AsTime()
+		k.ExpiresAt = &expiresAt
+	}
+	for _, scope := range req.GetScopes() {
+		k.Scopes = append(k.Scopes, biz.APIKeyScope{
+			Object: scope.GetObject(),
+			Action: scope.GetAction(),
+		})
+	}
+
+	created, key, err := s.apiKeyBiz.Create(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAPIKeyReply{
+		Data: toPbAPIKey(created),
+		Key:  key,
+	}, nil
+}
+
+func (s *AuthService) ListAPIKeys(ctx context.Context, _ *pb.ListAPIKeysRequest) (*pb.ListAPIKeysReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.apiKeyBiz.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pb.APIKey, 0, len(keys))
+	for _, k := range keys {
+		data = append(data, toPbAPIKey(k))
+	}
+
+	return &pb.ListAPIKeysReply{Data: data}, nil
+}
+
+func (s *AuthService) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.apiKeyBiz.Revoke(ctx, userID, uuid.MustParse(req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeAPIKeyReply{}, nil
+}
+
+func toPbAPIKey(k *biz.APIKey) *pb.APIKey {
+	scopes := make([]*pb.APIKeyScope, 0, len(k.Scopes))
+	for _, s := range k.Scopes {
+		scopes = append(scopes, &pb.APIKeyScope{
+			Object: s.Object,
+			Action: s.Action,
+		})
+	}
+
+	return &pb.APIKey{
+		Id:         k.ID.String(),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  timestampOrNil(k.ExpiresAt),
+		LastUsedAt: timestampOrNil(k.LastUsedAt),
+		CreatedAt:  timestamppb.New(k.CreatedAt),
+	}
+}
+
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
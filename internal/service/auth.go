@@ -3,51 +3,269 @@ package service
 import (
 	"context"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/uuid"
 
 	pb "github.com/tencat-dev/go-base/api/auth/v1"
 	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/auth"
 )
 
 type AuthService struct {
 	pb.UnimplementedAuthServiceServer
 
-	authBiz    *biz.AuthBiz
-	tokenMaker biz.TokenMaker
+	authBiz         *biz.AuthBiz
+	sessionBiz      *biz.SessionBiz
+	mfaBiz          *biz.MFABiz
+	passwordBiz     *biz.PasswordBiz
+	verificationBiz *biz.EmailVerificationBiz
+	apiKeyBiz       *biz.APIKeyBiz
+	federationBiz   *biz.FederationBiz
+	introspectBiz   *biz.IntrospectionBiz
+	passkeyBiz      *biz.PasskeyBiz
+	magicLinkBiz    *biz.MagicLinkBiz
 }
 
-func NewAuthService(authBiz *biz.AuthBiz, tokenMaker biz.TokenMaker) pb.AuthServiceServer {
+func NewAuthService(
+	authBiz *biz.AuthBiz,
+	sessionBiz *biz.SessionBiz,
+	mfaBiz *biz.MFABiz,
+	passwordBiz *biz.PasswordBiz,
+	verificationBiz *biz.EmailVerificationBiz,
+	apiKeyBiz *biz.APIKeyBiz,
+	federationBiz *biz.FederationBiz,
+	introspectBiz *biz.IntrospectionBiz,
+	passkeyBiz *biz.PasskeyBiz,
+	magicLinkBiz *biz.MagicLinkBiz,
+) pb.AuthServiceServer {
 	return &AuthService{
-		authBiz:    authBiz,
-		tokenMaker: tokenMaker,
+		authBiz:         authBiz,
+		sessionBiz:      sessionBiz,
+		mfaBiz:          mfaBiz,
+		passwordBiz:     passwordBiz,
+		verificationBiz: verificationBiz,
+		apiKeyBiz:       apiKeyBiz,
+		federationBiz:   federationBiz,
+		introspectBiz:   introspectBiz,
+		passkeyBiz:      passkeyBiz,
+		magicLinkBiz:    magicLinkBiz,
 	}
 }
 
 func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginReply, error) {
+	_, ip := clientInfo(ctx)
 	user, err := s.authBiz.Login(ctx, &biz.AuthLogin{
 		Email:    req.GetEmail(),
 		Password: req.GetPassword(),
+		IP:       ip,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	sessionId := uuid.Must(uuid.NewV7())
+	return s.signIn(ctx, user)
+}
 
-	accessToken, err := s.tokenMaker.CreateAccessToken(biz.AccessPayload{
-		UserID:    user.ID,
-		SessionID: sessionId,
-		TTL:       biz.AccessTokenTTL,
-	})
+func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenReply, error) {
+	tokens, err := s.authBiz.RefreshToken(ctx, req.GetRefreshToken())
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.tokenMaker.CreateRefreshToken(biz.RefreshPayload{
-		UserID:    user.ID,
-		SessionID: sessionId,
-		TTL:       biz.RefreshTokenTTL,
-	})
+	return &pb.RefreshTokenReply{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (s *AuthService) SwitchTenant(ctx context.Context, req *pb.SwitchTenantRequest) (*pb.SwitchTenantReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := currentSessionID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.authBiz.SwitchTenant(ctx, userID, sessionID, req.GetTenant())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SwitchTenantReply{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (s *AuthService) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest) (*pb.VerifyMFAReply, error) {
+	_, ip := clientInfo(ctx)
+	userID, err := s.mfaBiz.VerifyChallenge(ctx, req.GetMfaToken(), req.GetCode(), ip)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.startSession(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VerifyMFAReply{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (s *AuthService) EnrollMFA(ctx context.Context, _ *pb.EnrollMFARequest) (*pb.EnrollMFAReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.mfaBiz.Enroll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EnrollMFAReply{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	}, nil
+}
+
+func (s *AuthService) ConfirmMFA(ctx context.Context, req *pb.ConfirmMFARequest) (*pb.ConfirmMFAReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := s.mfaBiz.Confirm(ctx, userID, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConfirmMFAReply{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s *AuthService) DisableMFA(ctx context.Context, req *pb.DisableMFARequest) (*pb.DisableMFAReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.mfaBiz.Disable(ctx, userID, req.GetCode()); err != nil {
+		return nil, err
+	}
+
+	return &pb.DisableMFAReply{}, nil
+}
+
+func (s *AuthService) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetReply, error) {
+	_, ip := clientInfo(ctx)
+	if err := s.passwordBiz.RequestPasswordReset(ctx, req.GetEmail(), ip); err != nil {
+		return nil, err
+	}
+
+	return &pb.RequestPasswordResetReply{}, nil
+}
+
+func (s *AuthService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordReply, error) {
+	if err := s.passwordBiz.ResetPassword(ctx, req.GetToken(), req.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetPasswordReply{}, nil
+}
+
+func (s *AuthService) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := currentSessionID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, ip := clientInfo(ctx)
+	if err := s.passwordBiz.ChangePassword(ctx, userID, sessionID, req.GetCurrentPassword(), req.GetNewPassword(), ip); err != nil {
+		return nil, err
+	}
+
+	return &pb.ChangePasswordReply{}, nil
+}
+
+func (s *AuthService) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailReply, error) {
+	if err := s.verificationBiz.VerifyEmail(ctx, req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	return &pb.VerifyEmailReply{}, nil
+}
+
+func (s *AuthService) ResendVerification(ctx context.Context, req *pb.ResendVerificationRequest) (*pb.ResendVerificationReply, error) {
+	_, ip := clientInfo(ctx)
+	if err := s.verificationBiz.ResendVerification(ctx, req.GetEmail(), ip); err != nil {
+		return nil, err
+	}
+
+	return &pb.ResendVerificationReply{}, nil
+}
+
+func (s *AuthService) Logout(ctx context.Context, _ *pb.LogoutRequest) (*pb.LogoutReply, error) {
+	sessionID, err := currentSessionID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionBiz.Logout(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	return &pb.LogoutReply{}, nil
+}
+
+func (s *AuthService) LogoutAll(ctx context.Context, _ *pb.LogoutAllRequest) (*pb.LogoutAllReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionBiz.LogoutAll(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &pb.LogoutAllReply{}, nil
+}
+
+// signIn starts a session for an authenticated user, or an MFA challenge if
+// the user has MFA enabled.
+func (s *AuthService) signIn(ctx context.Context, user *biz.Auth) (*pb.LoginReply, error) {
+	mfaRequired, err := s.mfaBiz.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaRequired {
+		mfaToken, err := s.mfaBiz.CreateChallenge(user.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.LoginReply{
+			Id:          user.ID.String(),
+			Email:       user.Email,
+			Name:        user.Name,
+			MfaRequired: true,
+			MfaToken:    mfaToken,
+		}, nil
+	}
+
+	tokens, err := s.startSession(ctx, user.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +274,71 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 		Id:           user.ID.String(),
 		Email:        user.Email,
 		Name:         user.Name,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
+
+// startSession records a new session for the caller and issues its tokens.
+func (s *AuthService) startSession(ctx context.Context, userID uuid.UUID) (*biz.TokenPair, error) {
+	userAgent, ip := clientInfo(ctx)
+	session, err := s.sessionBiz.Create(ctx, &biz.Session{
+		UserID:    userID,
+		UserAgent: userAgent,
+		IP:        ip,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authBiz.IssueTokens(ctx, userID, session.ID)
+}
+
+// currentActorID returns the impersonator named by the act claim of the
+// verified access token, or uuid.Nil.
+func currentActorID(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errors.Unauthorized("NO_USER", "no user")
+	}
+	if claims.Actor == nil {
+		return uuid.Nil, nil
+	}
+
+	actor, err := uuid.Parse(claims.Actor.Subject)
+	if err != nil {
+		return uuid.Nil, errors.Unauthorized("INVALID_TOKEN", "invalid actor")
+	}
+
+	return actor, nil
+}
+
+// currentUserID returns the subject of the verified access token.
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errors.Unauthorized("NO_USER", "no user")
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, errors.Unauthorized("INVALID_TOKEN", "invalid subject")
+	}
+
+	return userID, nil
+}
+
+// currentSessionID returns the session of the caller's access token.
+func currentSessionID(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errors.Unauthorized("NO_USER", "no user")
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return uuid.Nil, errors.Unauthorized("INVALID_TOKEN", "invalid session id")
+	}
+
+	return sessionID, nil
+}
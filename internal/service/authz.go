@@ -3,11 +3,11 @@ package service
 import (
 	"context"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/tencat-dev/go-base/api/authz/v1"
+	"github.com/tencat-dev/go-base/internal/authz"
 	"github.com/tencat-dev/go-base/internal/biz"
 )
 
@@ -23,30 +23,169 @@ func NewAuthzService(authzBiz *biz.AuthzBiz) pb.AuthzServiceServer {
 	}
 }
 
-func (s *AuthzService) GrantRole(_ context.Context, req *pb.GrantRoleRequest) (*emptypb.Empty, error) {
-	if err := s.authzBiz.GrantRole(req.Id, req.Role); err != nil {
-		return nil, status.Errorf(codes.Internal, "grant role failed: %v", err)
+func (s *AuthzService) GrantRole(ctx context.Context, req *pb.GrantRoleRequest) (*emptypb.Empty, error) {
+	if err := s.authzBiz.GrantRole(ctx, req.Id, req.Role, req.Tenant); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (s *AuthzService) RevokeRole(_ context.Context, req *pb.RevokeRoleRequest) (*emptypb.Empty, error) {
-	if err := s.authzBiz.RevokeRole(req.Id, req.Role); err != nil {
-		return nil, status.Errorf(codes.Internal, "revoke role failed: %v", err)
+func (s *AuthzService) RevokeRole(ctx context.Context, req *pb.RevokeRoleRequest) (*emptypb.Empty, error) {
+	if err := s.authzBiz.RevokeRole(ctx, req.Id, req.Role, req.Tenant); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (s *AuthzService) GrantPermission(_ context.Context, req *pb.GrantPermissionRequest) (*emptypb.Empty, error) {
+func (s *AuthzService) GrantPermission(ctx context.Context, req *pb.GrantPermissionRequest) (*emptypb.Empty, error) {
 	if err := s.authzBiz.GrantPermission(
+		ctx,
 		req.Subject,
 		req.Object,
 		req.Action,
+		req.Tenant,
 	); err != nil {
-		return nil, status.Errorf(codes.Internal, "grant permission failed: %v", err)
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *AuthzService) RevokePermission(ctx context.Context, req *pb.RevokePermissionRequest) (*emptypb.Empty, error) {
+	if err := s.authzBiz.RevokePermission(
+		ctx,
+		req.Subject,
+		req.Object,
+		req.Action,
+		req.Tenant,
+	); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthzService) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesReply, error) {
+	roles, next, err := s.authzBiz.ListRoles(ctx, req.GetTenant(), biz.Page{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListRolesReply{Roles: roles, NextPageToken: next}, nil
+}
+
+func (s *AuthzService) GetRolesForUser(ctx context.Context, req *pb.GetRolesForUserRequest) (*pb.ListRolesReply, error) {
+	roles, next, err := s.authzBiz.RolesForUser(ctx, req.GetUserId(), req.GetTenant(), biz.Page{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListRolesReply{Roles: roles, NextPageToken: next}, nil
+}
+
+func (s *AuthzService) GetUsersForRole(ctx context.Context, req *pb.GetUsersForRoleRequest) (*pb.GetUsersForRoleReply, error) {
+	users, next, err := s.authzBiz.UsersForRole(ctx, req.GetRole(), req.GetTenant(), biz.Page{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUsersForRoleReply{UserIds: users, NextPageToken: next}, nil
+}
+
+func (s *AuthzService) ListPermissionsForRole(ctx context.Context, req *pb.ListPermissionsForRoleRequest) (*pb.ListPermissionsReply, error) {
+	permissions, next, err := s.authzBiz.PermissionsForRole(ctx, req.GetRole(), req.GetTenant(), biz.Page{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbPermissions(permissions, next), nil
+}
+
+func (s *AuthzService) GetImplicitPermissionsForUser(ctx context.Context, req *pb.GetImplicitPermissionsForUserRequest) (*pb.ListPermissionsReply, error) {
+	permissions, next, err := s.authzBiz.ImplicitPermissionsForUser(ctx, req.GetUserId(), req.GetTenant(), biz.Page{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbPermissions(permissions, next), nil
+}
+
+func (s *AuthzService) CreateTenant(ctx context.Context, req *pb.CreateTenantRequest) (*pb.Tenant, error) {
+	t, err := s.authzBiz.CreateTenant(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbTenant(t), nil
+}
+
+func (s *AuthzService) GetTenant(ctx context.Context, _ *pb.GetTenantRequest) (*pb.Tenant, error) {
+	tenant, ok := authz.TenantFromContext(ctx)
+	if !ok {
+		return nil, pb.ErrorTenantRequired("no tenant")
+	}
+
+	t, err := s.authzBiz.GetTenant(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbTenant(t), nil
+}
+
+func (s *AuthzService) ListTenants(ctx context.Context, _ *pb.ListTenantsRequest) (*pb.ListTenantsReply, error) {
+	tenants, err := s.authzBiz.ListTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pb.Tenant, 0, len(tenants))
+	for _, t := range tenants {
+		data = append(data, toPbTenant(t))
+	}
+
+	return &pb.ListTenantsReply{Data: data}, nil
+}
+
+func toPbTenant(t *biz.Tenant) *pb.Tenant {
+	return &pb.Tenant{
+		Id:        t.ID.String(),
+		Name:      t.Name,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+}
+
+func toPbPermissions(permissions []*biz.Permission, next string) *pb.ListPermissionsReply {
+	data := make([]*pb.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		tenant := p.Domain
+		if tenant == biz.GlobalDomain {
+			tenant = ""
+		}
+		data = append(data, &pb.Permission{
+			Subject: p.Subject,
+			Object:  p.Object,
+			Action:  p.Action,
+			Tenant:  tenant,
+		})
+	}
+
+	return &pb.ListPermissionsReply{Permissions: data, NextPageToken: next}
+}
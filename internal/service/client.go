@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// clientInfo returns the user agent and remote IP of the caller.
+func clientInfo(ctx context.Context) (userAgent, ip string) {
+	tr, ok := transport.FromServerContext(ctx)
+	if ok {
+		userAgent = tr.RequestHeader().Get("User-Agent")
+	}
+
+	var addr string
+	if ht, ok := tr.(http.Transporter); ok {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+
+	ip, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		ip = addr
+	}
+
+	return userAgent, ip
+}
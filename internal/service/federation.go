@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/tencat-dev/go-base/api/auth/v1"
+)
+
+func (s *AuthService) ListIdentityProviders(_ context.Context, _ *pb.ListIdentityProvidersRequest) (*pb.ListIdentityProvidersReply, error) {
+	providers := s.federationBiz.Providers()
+	data := make([]*pb.IdentityProvider, 0, len(providers))
+	for _, p := range providers {
+		c := p.Config()
+		name := c.GetName()
+		if name == "" {
+			name = c.GetId()
+		}
+		data = append(data, &pb.IdentityProvider{
+			Id:       c.GetId(),
+			Name:     name,
+			LoginUrl: s.federationBiz.LoginURL(c.GetId()),
+		})
+	}
+
+	return &pb.ListIdentityProvidersReply{Data: data}, nil
+}
+
+func (s *AuthService) CompleteFederatedLogin(ctx context.Context, req *pb.CompleteFederatedLoginRequest) (*pb.LoginReply, error) {
+	user, err := s.federationBiz.CompleteLogin(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signIn(ctx, user)
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/gorilla/mux"
+
+	authv1 "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+const (
+	federationCookie    = "oidc_state"
+	federationCookieTTL = 10 * time.Minute
+)
+
+// FederationHandler serves the browser redirects of a login through an
+// upstream OpenID Connect provider.
+type FederationHandler struct {
+	federationBiz *biz.FederationBiz
+	log           *log.Helper
+}
+
+func NewFederationHandler(federationBiz *biz.FederationBiz, logger *log.Helper) *FederationHandler {
+	return &FederationHandler{
+		federationBiz: federationBiz,
+		log:           logger,
+	}
+}
+
+// Begin serves /auth/oidc/{provider}/begin. It keeps the login state in a
+// cookie and sends the browser to the provider.
+func (h *FederationHandler) Begin(w http.ResponseWriter, r *http.Request) {
+	providerID := mux.Vars(r)["provider"]
+
+	state, authURL, err := h.federationBiz.Begin(r.Context(), providerID)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     federationCookie,
+		Value:    strings.Join([]string{state.State, state.Nonce, state.Verifier}, "."),
+		Path:     "/auth/oidc/" + providerID + "/",
+		MaxAge:   int(federationCookieTTL.Seconds()),
+		Secure:   h.federationBiz.Secure(),
+		HttpOnly: true,
+		// Lax, so the cookie is sent on the provider's top-level redirect.
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// Callback serves /auth/oidc/{provider}/callback and returns the browser to
+// the web app with a login code.
+func (h *FederationHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	providerID := mux.Vars(r)["provider"]
+
+	var state *biz.FederationState
+	if c, err := r.Cookie(federationCookie); err == nil {
+		if parts := strings.Split(c.Value, "."); len(parts) == 3 {
+			state = &biz.FederationState{State: parts[0], Nonce: parts[1], Verifier: parts[2]}
+		}
+	}
+	// The state is single use.
+	http.SetCookie(w, &http.Cookie{
+		Name:     federationCookie,
+		Path:     "/auth/oidc/" + providerID + "/",
+		MaxAge:   -1,
+		Secure:   h.federationBiz.Secure(),
+		HttpOnly: true,
+	})
+
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		h.log.WithContext(r.Context()).Warnf("federation: %s returned %s: %s", providerID, e, q.Get("error_description"))
+		h.fail(w, r, authv1.ErrorFederatedLoginFailed("identity provider returned %s", e))
+		return
+	}
+
+	code, err := h.federationBiz.Callback(r.Context(), providerID, q.Get("code"), q.Get("state"), state)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	http.Redirect(w, r, h.federationBiz.ReturnURL(code, ""), http.StatusFound)
+}
+
+// fail returns the browser to the web app with the reason of err.
+func (h *FederationHandler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	e := kerrors.FromError(err)
+	reason := e.Reason
+	if e.Code >= http.StatusInternalServerError {
+		h.log.WithContext(r.Context()).Errorf("federation: %v", err)
+		reason = authv1.ErrorReason_FEDERATED_LOGIN_FAILED.String()
+	}
+
+	http.Redirect(w, r, h.federationBiz.ReturnURL("", reason), http.StatusFound)
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/tencat-dev/go-base/api/auth/v1"
+)
+
+func (s *AuthService) Impersonate(ctx context.Context, req *pb.ImpersonateRequest) (*pb.ImpersonateReply, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := currentSessionID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := s.authBiz.Impersonate(ctx, actorID, sessionID, uuid.MustParse(req.GetUserId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ImpersonateReply{
+		AccessToken: token,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/infra/auth"
+)
+
+func (s *AuthService) Introspect(ctx context.Context, req *pb.IntrospectRequest) (*pb.IntrospectReply, error) {
+	result, err := s.introspectBiz.Introspect(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	if !result.Active {
+		return &pb.IntrospectReply{}, nil
+	}
+
+	reply := &pb.IntrospectReply{
+		Active:    true,
+		Sub:       result.UserID.String(),
+		Sid:       result.SessionID.String(),
+		TokenType: string(result.Type),
+		Exp:       result.ExpiresAt.Unix(),
+		Iat:       result.IssuedAt.Unix(),
+		ClientId:  result.ClientID,
+		Scope:     strings.Join(result.Scopes, " "),
+		Roles:     result.Roles,
+		Tenant:    result.Tenant,
+	}
+	if result.Actor != uuid.Nil {
+		reply.Act = result.Actor.String()
+	}
+
+	return reply, nil
+}
+
+func (s *AuthService) UserInfo(ctx context.Context, _ *pb.UserInfoRequest) (*pb.UserInfoReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("NO_USER", "no user")
+	}
+
+	profile, err := s.introspectBiz.UserInfo(ctx, userID, claims.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UserInfoReply{
+		Id:            profile.ID.String(),
+		Name:          profile.Name,
+		Email:         profile.Email,
+		EmailVerified: profile.EmailVerifiedAt != nil,
+		Roles:         profile.Roles,
+		CreatedAt:     timestamppb.New(profile.CreatedAt),
+		Tenant:        claims.Tenant,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/tencat-dev/go-base/api/auth/v1"
+)
+
+func (s *AuthService) RequestMagicLink(ctx context.Context, req *pb.RequestMagicLinkRequest) (*pb.RequestMagicLinkReply, error) {
+	if err := s.magicLinkBiz.RequestMagicLink(ctx, req.GetEmail()); err != nil {
+		return nil, err
+	}
+
+	return &pb.RequestMagicLinkReply{}, nil
+}
+
+func (s *AuthService) ConsumeMagicLink(ctx context.Context, req *pb.ConsumeMagicLinkRequest) (*pb.LoginReply, error) {
+	user, err := s.magicLinkBiz.ConsumeMagicLink(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signIn(ctx, user)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/tencat-dev/go-base/api/oauth/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+type OAuthService struct {
+	pb.UnimplementedOAuthServiceServer
+
+	oauthBiz *biz.OAuthBiz
+}
+
+func NewOAuthService(oauthBiz *biz.OAuthBiz) pb.OAuthServiceServer {
+	return &OAuthService{
+		oauthBiz: oauthBiz,
+	}
+}
+
+func (s *OAuthService) Authorize(ctx context.Context, req *pb.AuthorizeRequest) (*pb.AuthorizeReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := currentSessionID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := currentActorID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURI, err := s.oauthBiz.Authorize(ctx, userID, sessionID, actor, &biz.AuthorizeRequest{
+		ResponseType:        req.GetResponseType(),
+		ClientID:            req.GetClientId(),
+		RedirectURI:         req.GetRedirectUri(),
+		Scope:               req.GetScope(),
+		State:               req.GetState(),
+		CodeChallenge:       req.GetCodeChallenge(),
+		CodeChallengeMethod: req.GetCodeChallengeMethod(),
+		Nonce:               req.GetNonce(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AuthorizeReply{RedirectUri: redirectURI}, nil
+}
+
+type OAuthClientService struct {
+	pb.UnimplementedOAuthClientServiceServer
+
+	oauthBiz *biz.OAuthBiz
+}
+
+func NewOAuthClientService(oauthBiz *biz.OAuthBiz) pb.OAuthClientServiceServer {
+	return &OAuthClientService{
+		oauthBiz: oauthBiz,
+	}
+}
+
+func (s *OAuthClientService) CreateClient(ctx context.Context, req *pb.CreateClientRequest) (*pb.CreateClientReply, error) {
+	client, secret, err := s.oauthBiz.CreateClient(ctx, &biz.OAuthClient{
+		Name:         req.GetName(),
+		RedirectURIs: req.GetRedirectUris(),
+		Scopes:       req.GetScopes(),
+	}, req.GetConfidential())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateClientReply{
+		Data:         toPbOAuthClient(client),
+		ClientSecret: secret,
+	}, nil
+}
+
+func (s *OAuthClientService) UpdateClient(ctx context.Context, req *pb.UpdateClientRequest) (*pb.UpdateClientReply, error) {
+	client, err := s.oauthBiz.UpdateClient(ctx, &biz.OAuthClient{
+		ID:           req.GetId(),
+		Name:         req.GetName(),
+		RedirectURIs: req.GetRedirectUris(),
+		Scopes:       req.GetScopes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateClientReply{Data: toPbOAuthClient(client)}, nil
+}
+
+func (s *OAuthClientService) RotateClientSecret(ctx context.Context, req *pb.RotateClientSecretRequest) (*pb.RotateClientSecretReply, error) {
+	secret, err := s.oauthBiz.RotateClientSecret(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RotateClientSecretReply{ClientSecret: secret}, nil
+}
+
+func (s *OAuthClientService) DeleteClient(ctx context.Context, req *pb.DeleteClientRequest) (*pb.DeleteClientReply, error) {
+	if err := s.oauthBiz.DeleteClient(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteClientReply{}, nil
+}
+
+func (s *OAuthClientService) GetClient(ctx context.Context, req *pb.GetClientRequest) (*pb.GetClientReply, error) {
+	client, err := s.oauthBiz.GetClient(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetClientReply{Data: toPbOAuthClient(client)}, nil
+}
+
+func (s *OAuthClientService) ListClients(ctx context.Context, _ *pb.ListClientsRequest) (*pb.ListClientsReply, error) {
+	clients, err := s.oauthBiz.ListClients(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pb.OAuthClient, 0, len(clients))
+	for _, c := range clients {
+		data = append(data, toPbOAuthClient(c))
+	}
+
+	return &pb.ListClientsReply{Data: data}, nil
+}
+
+func toPbOAuthClient(c *biz.OAuthClient) *pb.OAuthClient {
+	return &pb.OAuthClient{
+		Id:           c.ID,
+		Name:         c.Name,
+		Confidential: c.Confidential(),
+		RedirectUris: c.RedirectURIs,
+		Scopes:       c.Scopes,
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+		UpdatedAt:    timestamppb.New(c.UpdatedAt),
+	}
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/tencat-dev/go-base/internal/biz"
+	"github.com/tencat-dev/go-base/internal/infra/auth"
+)
+
+// OAuthHandler serves the OAuth2 / OpenID Connect endpoints that have to
+// follow the formats of the specs rather than the API's.
+type OAuthHandler struct {
+	oauthBiz *biz.OAuthBiz
+	keys     *auth.KeySet
+	log      *log.Helper
+}
+
+// NewOAuthHandler fails when the provider is configured while tokens are
+// signed with the shared secret, as clients could not verify ID tokens.
+func NewOAuthHandler(oauthBiz *biz.OAuthBiz, keys *auth.KeySet, logger *log.Helper) (*OAuthHandler, error) {
+	if oauthBiz.Issuer() != "" && keys.Symmetric() {
+		return nil, errors.New("oauth: the provider requires asymmetric jwt keys")
+	}
+
+	return &OAuthHandler{
+		oauthBiz: oauthBiz,
+		keys:     keys,
+		log:      logger,
+	}, nil
+}
+
+// Discovery serves /.well-known/openid-configuration.
+func (h *OAuthHandler) Discovery(w http.ResponseWriter, _ *http.Request) {
+	issuer := h.oauthBiz.Issuer()
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/oauth/authorize",
+		"token_endpoint":                        issuer + "/oauth/token",
+		"userinfo_endpoint":                     issuer + "/oauth/userinfo",
+		"jwks_uri":                              issuer + "/.well-known/jwks.json",
+		"scopes_supported":                      biz.SupportedScopes,
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{biz.GrantTypeAuthorizationCode, biz.GrantTypeRefreshToken},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{h.keys.SigningAlgorithm()},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{biz.PKCEMethodS256},
+		"claims_supported": []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "name", "email", "email_verified",
+		},
+		"authorization_response_iss_parameter_supported": true,
+	})
+}
+
+// Authorize checks the client and redirect URI of an authorization request
+// and forwards the browser to the web app, which signs the user in and
+// calls OAuthService.Authorize with the same parameters.
+func (h *OAuthHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if _, err := h.oauthBiz.ValidateRedirect(r.Context(), q.Get("client_id"), q.Get("redirect_uri")); err != nil {
+		// The redirect URI cannot be trusted, so the error is shown here
+		// instead of being sent back to the client.
+		var oerr *biz.OAuthError
+		if e := kerrors.FromError(err); e.Code < http.StatusInternalServerError {
+			oerr = &biz.OAuthError{Code: biz.OAuthInvalidRequest, Description: e.Message}
+		}
+		h.writeError(w, oerr, err)
+		return
+	}
+
+	loginURL := h.oauthBiz.LoginURL()
+	sep := "?"
+	if strings.Contains(loginURL, "?") {
+		sep = "&"
+	}
+	http.Redirect(w, r, loginURL+sep+r.URL.RawQuery, http.StatusFound)
+}
+
+// Token serves the token endpoint (RFC 6749 section 3.2).
+func (h *OAuthHandler) Token(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, &biz.OAuthError{Code: biz.OAuthInvalidRequest, Description: "POST required"})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, &biz.OAuthError{Code: biz.OAuthInvalidRequest, Description: "malformed request body"})
+		return
+	}
+
+	clientID, clientSecret, basic := clientCredentials(r)
+	userAgent := r.UserAgent()
+	ip := remoteIP(r)
+
+	resp, err := h.oauthBiz.Token(r.Context(), &biz.TokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		UserAgent:    userAgent,
+		IP:           ip,
+	})
+	if err != nil {
+		var oerr *biz.OAuthError
+		if errors.As(err, &oerr) && oerr.Code == biz.OAuthInvalidClient && basic {
+			w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+		}
+		h.writeError(w, oerr, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// UserInfo serves the OpenID Connect userinfo endpoint.
+func (h *OAuthHandler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer`)
+		writeJSON(w, http.StatusUnauthorized, &biz.OAuthError{Code: biz.OAuthInvalidToken, Description: "bearer token required"})
+		return
+	}
+
+	info, err := h.oauthBiz.UserInfo(r.Context(), strings.TrimSpace(token))
+	if err != nil {
+		var oerr *biz.OAuthError
+		if errors.As(err, &oerr) {
+			w.Header().Set("WWW-Authenticate", `Bearer error="`+oerr.Code+`"`)
+		}
+		h.writeError(w, oerr, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+// writeError writes oerr if set and hides any other error behind
+// server_error.
+func (h *OAuthHandler) writeError(w http.ResponseWriter, oerr *biz.OAuthError, err error) {
+	if oerr == nil {
+		h.log.Errorf("oauth: %v", err)
+		writeJSON(w, http.StatusInternalServerError, &biz.OAuthError{Code: "server_error"})
+		return
+	}
+
+	status := http.StatusBadRequest
+	if oerr.Code == biz.OAuthInvalidClient || oerr.Code == biz.OAuthInvalidToken {
+		status = http.StatusUnauthorized
+	}
+	writeJSON(w, status, oerr)
+}
+
+// clientCredentials reads client_secret_basic or client_secret_post
+// credentials and reports whether HTTP Basic was used.
+func clientCredentials(r *http.Request) (string, string, bool) {
+	if id, secret, ok := r.BasicAuth(); ok {
+		// Both parts are form-encoded (RFC 6749 section 2.3.1).
+		if v, err := url.QueryUnescape(id); err == nil {
+			id = v
+		}
+		if v, err := url.QueryUnescape(secret); err == nil {
+			secret = v
+		}
+		return id, secret, true
+	}
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret"), false
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
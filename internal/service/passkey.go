@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+func (s *AuthService) BeginPasskeyRegistration(ctx context.Context, _ *pb.BeginPasskeyRegistrationRequest) (*pb.BeginPasskeyReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, creation, err := s.passkeyBiz.BeginRegistration(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return beginPasskeyReply(session, creation.Response)
+}
+
+func (s *AuthService) FinishPasskeyRegistration(ctx context.Context, req *pb.FinishPasskeyRegistrationRequest) (*pb.FinishPasskeyRegistrationReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := protojson.Marshal(req.GetCredential())
+	if err != nil {
+		return nil, err
+	}
+
+	passkey, err := s.passkeyBiz.FinishRegistration(ctx, userID, uuid.MustParse(req.GetSessionId()), req.GetName(), credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FinishPasskeyRegistrationReply{Data: toPasskey(passkey)}, nil
+}
+
+func (s *AuthService) ListPasskeys(ctx context.Context, _ *pb.ListPasskeysRequest) (*pb.ListPasskeysReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.passkeyBiz.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pb.Passkey, 0, len(passkeys))
+	for _, p := range passkeys {
+		data = append(data, toPasskey(p))
+	}
+
+	return &pb.ListPasskeysReply{Data: data}, nil
+}
+
+func (s *AuthService) DeletePasskey(ctx context.Context, req *pb.DeletePasskeyRequest) (*pb.DeletePasskeyReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.passkeyBiz.Delete(ctx, userID, uuid.MustParse(req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePasskeyReply{}, nil
+}
+
+func (s *AuthService) BeginPasskeyLogin(ctx context.Context, _ *pb.BeginPasskeyLoginRequest) (*pb.BeginPasskeyReply, error) {
+	session, assertion, err := s.passkeyBiz.BeginLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return beginPasskeyReply(session, assertion.Response)
+}
+
+// FinishPasskeyLogin signs the user in without asking for a TOTP code, as
+// a passkey already verifies both possession and the user.
+func (s *AuthService) FinishPasskeyLogin(ctx context.Context, req *pb.FinishPasskeyLoginRequest) (*pb.LoginReply, error) {
+	credential, err := protojson.Marshal(req.GetCredential())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.passkeyBiz.FinishLogin(ctx, uuid.MustParse(req.GetSessionId()), credential)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.startSession(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginReply{
+		Id:           user.ID.String(),
+		Email:        user.Email,
+		Name:         user.Name,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+// beginPasskeyReply returns the ceremony options as the JSON object the
+// browser API expects.
+func beginPasskeyReply(session *biz.WebAuthnSession, options any) (*pb.BeginPasskeyReply, error) {
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &structpb.Struct{}
+	if err := protojson.Unmarshal(raw, opts); err != nil {
+		return nil, err
+	}
+
+	return &pb.BeginPasskeyReply{
+		SessionId: session.ID.String(),
+		Options:   opts,
+	}, nil
+}
+
+func toPasskey(p *biz.Passkey) *pb.Passkey {
+	return &pb.Passkey{
+		Id:         p.ID.String(),
+		Name:       p.Name,
+		Transports: p.Transports,
+		BackedUp:   p.BackupState,
+		LastUsedAt: timestampOrNil(p.LastUsedAt),
+		CreatedAt:  timestamppb.New(p.CreatedAt),
+	}
+}
@@ -7,4 +7,8 @@ var ProviderSetService = wire.NewSet(
 	NewUserService,
 	NewAuthService,
 	NewAuthzService,
+	NewOAuthService,
+	NewOAuthClientService,
+	NewOAuthHandler,
+	NewFederationHandler,
 )
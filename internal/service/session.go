@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/tencat-dev/go-base/api/auth/v1"
+	"github.com/tencat-dev/go-base/internal/biz"
+)
+
+func (s *AuthService) ListMySessions(ctx context.Context, _ *pb.ListMySessionsRequest) (*pb.ListSessionsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listSessions(ctx, userID)
+}
+
+func (s *AuthService) RevokeMySession(ctx context.Context, req *pb.RevokeMySessionRequest) (*pb.RevokeSessionReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionBiz.Revoke(ctx, userID, uuid.MustParse(req.GetSessionId())); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeSessionReply{}, nil
+}
+
+func (s *AuthService) ListUserSessions(ctx context.Context, req *pb.ListUserSessionsRequest) (*pb.ListSessionsReply, error) {
+	return s.listSessions(ctx, uuid.MustParse(req.GetUserId()))
+}
+
+func (s *AuthService) RevokeUserSession(ctx context.Context, req *pb.RevokeUserSessionRequest) (*pb.RevokeSessionReply, error) {
+	err := s.sessionBiz.Revoke(ctx, uuid.MustParse(req.GetUserId()), uuid.MustParse(req.GetSessionId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeSessionReply{}, nil
+}
+
+func (s *AuthService) listSessions(ctx context.Context, userID uuid.UUID) (*pb.ListSessionsReply, error) {
+	sessions, err := s.sessionBiz.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// API keys have no session, so none of the sessions is current for them.
+	current, _ := currentSessionID(ctx)
+
+	data := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		data = append(data, toPbSession(session, current))
+	}
+
+	return &pb.ListSessionsReply{Data: data}, nil
+}
+
+func toPbSession(s *biz.Session, current uuid.UUID) *pb.Session {
+	return &pb.Session{
+		Id:         s.ID.String(),
+		UserAgent:  s.UserAgent,
+		Ip:         s.IP,
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+		LastSeenAt: timestamppb.New(s.LastSeenAt),
+		Current:    s.ID == current,
+	}
+}
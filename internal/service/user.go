@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,12 +13,14 @@ import (
 type UserService struct {
 	pb.UnimplementedUserServiceServer
 
-	userBiz *biz.UserBiz
+	userBiz         *biz.UserBiz
+	verificationBiz *biz.EmailVerificationBiz
 }
 
-func NewUserService(userBiz *biz.UserBiz) pb.UserServiceServer {
+func NewUserService(userBiz *biz.UserBiz, verificationBiz *biz.EmailVerificationBiz) pb.UserServiceServer {
 	return &UserService{
-		userBiz: userBiz,
+		userBiz:         userBiz,
+		verificationBiz: verificationBiz,
 	}
 }
 
@@ -31,16 +34,25 @@ func (s *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		return nil, err
 	}
 
+	if err := s.verificationBiz.SendVerification(ctx, newUser); err != nil {
+		return nil, err
+	}
+
 	return &pb.CreateUserReply{
 		Data: &pb.User{
-			Id:    newUser.ID.String(),
-			Name:  newUser.Name,
-			Email: newUser.Email,
+			Id:            newUser.ID.String(),
+			Name:          newUser.Name,
+			Email:         newUser.Email,
+			EmailVerified: newUser.EmailVerifiedAt != nil,
 		},
 	}, nil
 }
 func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserReply, error) {
-	updateUser, err := s.userBiz.UpdateUser(ctx, &biz.User{
+	// Callers without a user, such as services with a client certificate,
+	// are never the owner.
+	actor, _ := currentUserID(ctx)
+
+	updateUser, err := s.userBiz.UpdateUser(ctx, actor, &biz.User{
 		ID:    uuid.MustParse(req.GetId()),
 		Name:  req.GetName(),
 		Email: req.GetEmail(),
@@ -49,11 +61,18 @@ func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, err
 	}
 
+	if req.GetEmail() != "" && updateUser.EmailVerifiedAt == nil {
+		if err := s.verificationBiz.SendVerification(ctx, updateUser); err != nil {
+			return nil, err
+		}
+	}
+
 	return &pb.UpdateUserReply{
 		Data: &pb.User{
-			Id:    updateUser.ID.String(),
-			Name:  updateUser.Name,
-			Email: updateUser.Email,
+			Id:            updateUser.ID.String(),
+			Name:          updateUser.Name,
+			Email:         updateUser.Email,
+			EmailVerified: updateUser.EmailVerifiedAt != nil,
 		},
 	}, nil
 }
@@ -73,9 +92,10 @@ func (s *UserService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 
 	return &pb.GetUserReply{
 		Data: &pb.User{
-			Id:    user.ID.String(),
-			Name:  user.Name,
-			Email: user.Email,
+			Id:            user.ID.String(),
+			Name:          user.Name,
+			Email:         user.Email,
+			EmailVerified: user.EmailVerifiedAt != nil,
 		},
 	}, nil
 }
@@ -88,9 +108,10 @@ func (s *UserService) ListUser(ctx context.Context, req *pb.ListUserRequest) (*p
 	var users []*pb.User
 	for _, user := range userslice {
 		users = append(users, &pb.User{
-			Id:    user.ID.String(),
-			Name:  user.Name,
-			Email: user.Email,
+			Id:            user.ID.String(),
+			Name:          user.Name,
+			Email:         user.Email,
+			EmailVerified: user.EmailVerifiedAt != nil,
 		})
 	}
 
@@ -98,3 +119,47 @@ func (s *UserService) ListUser(ctx context.Context, req *pb.ListUserRequest) (*p
 		Data: users,
 	}, nil
 }
+
+func (s *UserService) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*pb.UnlockUserReply, error) {
+	err := s.userBiz.UnlockUser(ctx, uuid.MustParse(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnlockUserReply{}, nil
+}
+
+func (s *UserService) ImportUsers(ctx context.Context, req *pb.ImportUsersRequest) (*pb.ImportUsersReply, error) {
+	users := make([]*biz.User, 0, len(req.GetUsers()))
+	for _, u := range req.GetUsers() {
+		user := &biz.User{
+			Name:         u.GetName(),
+			Email:        u.GetEmail(),
+			PasswordHash: u.GetPasswordHash(),
+		}
+		if u.GetEmailVerified() {
+			now := time.Now().UTC()
+			user.EmailVerifiedAt = &now
+		}
+		users = append(users, user)
+	}
+
+	imported, failures, err := s.userBiz.ImportUsers(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ImportUsersReply{
+		Imported: int32(imported),
+		Failures: make([]*pb.ImportUsersReply_Failure, 0, len(failures)),
+	}
+	for _, f := range failures {
+		reply.Failures = append(reply.Failures, &pb.ImportUsersReply_Failure{
+			Index:  int32(f.Index),
+			Email:  f.Email,
+			Reason: f.Reason,
+		})
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_mfa
+(
+    user_id        UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+
+    secret         TEXT        NOT NULL,
+    last_used_step BIGINT      NOT NULL DEFAULT 0,
+
+    enabled_at     TIMESTAMPTZ,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id)
+);
+
+CREATE TABLE mfa_recovery_codes
+(
+    id         UUID        NOT NULL DEFAULT uuidv7(),
+
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash  TEXT        NOT NULL,
+
+    used_at    TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX mfa_recovery_codes_user_id_idx ON mfa_recovery_codes (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE mfa_recovery_codes;
+DROP TABLE user_mfa;
+-- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE oauth_clients
+(
+    id            TEXT        NOT NULL,
+
+    name          TEXT        NOT NULL,
+    -- NULL for public clients, which authenticate with PKCE only.
+    secret_hash   TEXT,
+    -- Space-delimited, as in the OAuth protocol itself.
+    redirect_uris TEXT        NOT NULL,
+    scopes        TEXT        NOT NULL,
+
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE oauth_authorization_codes
+(
+    code_hash             TEXT        NOT NULL,
+
+    client_id             TEXT        NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+    user_id               UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    redirect_uri          TEXT        NOT NULL,
+    scopes                TEXT        NOT NULL,
+    code_challenge        TEXT        NOT NULL,
+    nonce                 TEXT        NOT NULL DEFAULT '',
+    auth_time             TIMESTAMPTZ NOT NULL,
+
+    -- Set once the code is exchanged, so a replayed code can revoke the
+    -- tokens it produced.
+    session_id            UUID REFERENCES sessions (id) ON DELETE SET NULL,
+
+    expires_at            TIMESTAMPTZ NOT NULL,
+    used_at               TIMESTAMPTZ,
+    created_at            TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (code_hash)
+);
+
+CREATE TABLE oauth_grants
+(
+    session_id UUID        NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+
+    client_id  TEXT        NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+    scopes     TEXT        NOT NULL,
+    auth_time  TIMESTAMPTZ NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (session_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE oauth_grants;
+DROP TABLE oauth_authorization_codes;
+DROP TABLE oauth_clients;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE external_identities
+(
+    id            UUID        NOT NULL DEFAULT uuidv7(),
+
+    user_id       UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    -- id of the provider in the auth.federation config.
+    provider      TEXT        NOT NULL,
+    -- sub claim of the upstream ID token.
+    subject       TEXT        NOT NULL,
+    email         TEXT        NOT NULL DEFAULT '',
+
+    last_login_at TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id),
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX external_identities_user_id_idx ON external_identities (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE external_identities;
+-- +goose StatementEnd
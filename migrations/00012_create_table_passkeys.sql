@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE passkeys
+(
+    id               UUID        NOT NULL DEFAULT uuidv7(),
+
+    user_id          UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name             TEXT        NOT NULL DEFAULT '',
+    credential_id    BYTEA       NOT NULL UNIQUE,
+    -- COSE encoded public key.
+    public_key       BYTEA       NOT NULL,
+    attestation_type TEXT        NOT NULL DEFAULT '',
+    aaguid           BYTEA       NOT NULL,
+    sign_count       BIGINT      NOT NULL DEFAULT 0,
+    -- Space-delimited, e.g. "hybrid internal".
+    transports       TEXT        NOT NULL DEFAULT '',
+    backup_eligible  BOOLEAN     NOT NULL DEFAULT false,
+    backup_state     BOOLEAN     NOT NULL DEFAULT false,
+
+    last_used_at     TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX passkeys_user_id_idx ON passkeys (user_id);
+
+-- State kept between the begin and finish steps of a ceremony. Rows are
+-- deleted when the ceremony finishes.
+CREATE TABLE webauthn_sessions
+(
+    id           UUID        NOT NULL DEFAULT uuidv7(),
+
+    -- NULL for logins, where the user is only known once they finish.
+    user_id      UUID REFERENCES users (id) ON DELETE CASCADE,
+    -- registration or login.
+    ceremony     TEXT        NOT NULL,
+    -- JSON encoded session data of the webauthn library.
+    session_data BYTEA       NOT NULL,
+
+    expires_at   TIMESTAMPTZ NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webauthn_sessions;
+DROP TABLE passkeys;
+-- +goose StatementEnd
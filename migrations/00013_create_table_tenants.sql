@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenants
+(
+    id         UUID        NOT NULL DEFAULT uuidv7(),
+
+    name       TEXT        NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id)
+);
+
+-- Policies now carry a domain. Move the existing ones into the global
+-- domain, which applies in every tenant. The table is created by the
+-- Casbin adapter, so it is missing on a fresh database.
+DO
+$$
+    BEGIN
+        IF to_regclass('casbin_rules') IS NOT NULL THEN
+            UPDATE casbin_rules SET v3 = v2, v2 = v1, v1 = '*' WHERE ptype = 'p' AND v3 IS NULL;
+            UPDATE casbin_rules SET v2 = '*' WHERE ptype = 'g' AND v2 IS NULL;
+        END IF;
+    END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DO
+$$
+    BEGIN
+        IF to_regclass('casbin_rules') IS NOT NULL THEN
+            DELETE FROM casbin_rules WHERE ptype = 'p' AND v1 <> '*';
+            DELETE FROM casbin_rules WHERE ptype = 'g' AND v2 <> '*';
+            UPDATE casbin_rules SET v1 = v2, v2 = v3, v3 = NULL WHERE ptype = 'p';
+            UPDATE casbin_rules SET v2 = NULL WHERE ptype = 'g';
+        END IF;
+    END
+$$;
+
+DROP TABLE tenants;
+-- +goose StatementEnd
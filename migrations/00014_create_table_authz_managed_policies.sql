@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The casbin_rules policies that come from the authz.v1.permission
+-- annotations. Reconciling may only remove these.
+CREATE TABLE authz_managed_policies
+(
+    subject TEXT NOT NULL,
+    domain  TEXT NOT NULL,
+    object  TEXT NOT NULL,
+    action  TEXT NOT NULL,
+
+    PRIMARY KEY (subject, domain, object, action)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE authz_managed_policies;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Expired ceremonies are purged whenever a new one starts.
+CREATE INDEX webauthn_sessions_expires_at_idx ON webauthn_sessions (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX webauthn_sessions_expires_at_idx;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The address an email verification token was mailed to. The token only
+-- verifies the user's email while it is still this address.
+ALTER TABLE user_tokens
+    ADD COLUMN email TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_tokens
+    DROP COLUMN email;
+-- +goose StatementEnd